@@ -0,0 +1,271 @@
+// Package auth authorizes the calls to the LVM service against a policy
+// mapping caller identities to the methods and volume groups they may use.
+//
+// Callers are identified by the common name of their tls client
+// certificate as "cn:<name>", by the uid of their process on the unix
+// socket as "uid:<uid>" and by a bearer token as "token:<name>". A call is
+// allowed when any identity of the caller matches a rule allowing the
+// method on the volume groups of the request.
+package auth
+
+import (
+	"crypto/subtle"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"path"
+	"strconv"
+	"strings"
+
+	"github.com/zdnscloud/cement/configure"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/zdnscloud/lvmd/proto"
+	"github.com/zdnscloud/lvmd/unixsock"
+)
+
+// servicePrefix prefixes the full names of the methods of the LVM service,
+// other services like reflection aren't subject to the policy
+const servicePrefix = "/lvm.LVM/"
+
+// Token names a bearer token read from File, the token is the content of
+// the file without surrounding white space
+type Token struct {
+	Name string `yaml:"name" required:"true"`
+	File string `yaml:"file" required:"true"`
+}
+
+// Rule allows the callers with any of Identities to call Methods on
+// VolumeGroups, identities, methods and volume groups are patterns as
+// matched by path.Match. Methods are given by name like "RemoveLV".
+// Requests which don't name a volume group, like ListVG or Destory, are
+// matched as the empty name, which only the pattern "*" matches. Omitted
+// methods or volume groups match all of them
+type Rule struct {
+	Identities   []string `yaml:"identities"`
+	Methods      []string `yaml:"methods"`
+	VolumeGroups []string `yaml:"volume_groups"`
+}
+
+// Policy is the content of the policy file
+type Policy struct {
+	Tokens []Token `yaml:"tokens"`
+	Rules  []Rule  `yaml:"rules"`
+}
+
+// Authorizer checks calls against a policy
+type Authorizer struct {
+	rules []Rule
+	// tokens maps the bearer tokens to their names
+	tokens map[string]string
+}
+
+// Load reads the policy file and the tokens it references
+func Load(file string) (*Authorizer, error) {
+	var policy Policy
+	if err := configure.Load(&policy, file); err != nil {
+		return nil, fmt.Errorf("failed to load policy %s: %v", file, err)
+	}
+	return New(policy)
+}
+
+// New returns an authorizer for policy
+func New(policy Policy) (*Authorizer, error) {
+	a := &Authorizer{rules: policy.Rules, tokens: make(map[string]string)}
+	for _, t := range policy.Tokens {
+		data, err := ioutil.ReadFile(t.File)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read token %s: %v", t.Name, err)
+		}
+		token := strings.TrimSpace(string(data))
+		if token == "" {
+			return nil, fmt.Errorf("token %s is empty", t.Name)
+		}
+		a.tokens[token] = t.Name
+	}
+	for _, rule := range a.rules {
+		for _, patterns := range [][]string{rule.Identities, rule.Methods, rule.VolumeGroups} {
+			for _, p := range patterns {
+				if _, err := path.Match(p, ""); err != nil {
+					return nil, fmt.Errorf("invalid pattern %q", p)
+				}
+			}
+		}
+	}
+	return a, nil
+}
+
+// UnaryInterceptor rejects unary calls which aren't allowed
+func (a *Authorizer) UnaryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	if err := a.authorize(ctx, info.FullMethod, req); err != nil {
+		return nil, err
+	}
+	return handler(ctx, req)
+}
+
+// StreamInterceptor rejects streaming calls which aren't allowed, the
+// request of server streaming calls is checked when the handler receives it
+func (a *Authorizer) StreamInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	if !strings.HasPrefix(info.FullMethod, servicePrefix) {
+		return handler(srv, ss)
+	}
+	return handler(srv, &authorizedStream{ServerStream: ss, authorizer: a, method: info.FullMethod})
+}
+
+type authorizedStream struct {
+	grpc.ServerStream
+	authorizer *Authorizer
+	method     string
+}
+
+func (s *authorizedStream) RecvMsg(m interface{}) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+	return s.authorizer.authorize(s.Context(), s.method, m)
+}
+
+func (a *Authorizer) authorize(ctx context.Context, fullMethod string, req interface{}) error {
+	if !strings.HasPrefix(fullMethod, servicePrefix) {
+		return nil
+	}
+	method := strings.TrimPrefix(fullMethod, servicePrefix)
+
+	identities, err := a.identities(ctx)
+	if err != nil {
+		return err
+	}
+	vgs := volumeGroups(req)
+	for _, rule := range a.rules {
+		if rule.allows(identities, method, vgs) {
+			return nil
+		}
+	}
+	if len(identities) == 0 {
+		return status.Errorf(codes.PermissionDenied, "anonymous caller isn't allowed to call %s", method)
+	}
+	return status.Errorf(codes.PermissionDenied, "%s isn't allowed to call %s on volume group %q",
+		strings.Join(identities, ","), method, strings.Join(vgs, ","))
+}
+
+// identities returns the identities of the caller, a bearer token which
+// isn't in the policy or is sent over plain tcp fails the call rather than
+// making it anonymous
+func (a *Authorizer) identities(ctx context.Context) ([]string, error) {
+	var identities []string
+	// bearer tokens are only accepted where they can't be sniffed
+	secure := false
+	if p, ok := peer.FromContext(ctx); ok {
+		switch info := p.AuthInfo.(type) {
+		case credentials.TLSInfo:
+			if certs := info.State.PeerCertificates; len(certs) > 0 {
+				identities = append(identities, "cn:"+certs[0].Subject.CommonName)
+			}
+			secure = true
+		case unixsock.AuthInfo:
+			identities = append(identities, "uid:"+strconv.FormatUint(uint64(info.UID), 10))
+			secure = true
+		}
+	}
+
+	md, _ := metadata.FromIncomingContext(ctx)
+	for _, value := range md.Get("authorization") {
+		if !secure {
+			return nil, status.Error(codes.Unauthenticated, "bearer tokens require tls or the unix socket")
+		}
+		token, err := bearerToken(value)
+		if err != nil {
+			return nil, status.Error(codes.Unauthenticated, err.Error())
+		}
+		name, ok := a.lookupToken(token)
+		if !ok {
+			return nil, status.Error(codes.Unauthenticated, "unknown bearer token")
+		}
+		identities = append(identities, "token:"+name)
+	}
+	return identities, nil
+}
+
+func bearerToken(value string) (string, error) {
+	const prefix = "bearer "
+	if len(value) <= len(prefix) || !strings.EqualFold(value[:len(prefix)], prefix) {
+		return "", errors.New("authorization isn't a bearer token")
+	}
+	return strings.TrimSpace(value[len(prefix):]), nil
+}
+
+// lookupToken compares the token with every known token in constant time,
+// so the time taken doesn't reveal how much of a token was guessed right
+func (a *Authorizer) lookupToken(token string) (string, bool) {
+	var found string
+	for t, name := range a.tokens {
+		if subtle.ConstantTimeCompare([]byte(t), []byte(token)) == 1 {
+			found = name
+		}
+	}
+	return found, found != ""
+}
+
+func (r Rule) allows(identities []string, method string, vgs []string) bool {
+	matched := false
+	for _, id := range identities {
+		if matchAny(r.Identities, id) {
+			matched = true
+			break
+		}
+	}
+	if !matched {
+		return false
+	}
+	if len(r.Methods) != 0 && !matchAny(r.Methods, method) {
+		return false
+	}
+	if len(r.VolumeGroups) == 0 {
+		return true
+	}
+	for _, vg := range vgs {
+		if !matchAny(r.VolumeGroups, vg) {
+			return false
+		}
+	}
+	return true
+}
+
+func matchAny(patterns []string, name string) bool {
+	for _, p := range patterns {
+		if ok, _ := path.Match(p, name); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// volumeGroups returns the volume groups a request operates on, requests
+// without a volume group return the empty name
+func volumeGroups(req interface{}) []string {
+	switch r := req.(type) {
+	case *pb.CreateVGRequest:
+		return []string{r.Name}
+	case *pb.ExtendVGRequest:
+		return []string{r.Name}
+	case *pb.ListLVRequest:
+		// the volume group may be followed by the name of a volume
+		return []string{strings.SplitN(r.VolumeGroup, "/", 2)[0]}
+	case *pb.CloneLVRequest:
+		vgs := []string{r.VolumeGroup}
+		if r.DestVolumeGroup != "" {
+			vgs = append(vgs, r.DestVolumeGroup)
+		}
+		return vgs
+	case interface{ GetVolumeGroup() string }:
+		return []string{r.GetVolumeGroup()}
+	default:
+		return []string{""}
+	}
+}
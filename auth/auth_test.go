@@ -0,0 +1,167 @@
+package auth
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/zdnscloud/lvmd/proto"
+	"github.com/zdnscloud/lvmd/unixsock"
+)
+
+func TestAuth(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Auth Suite")
+}
+
+const policy = `
+tokens:
+  - name: monitoring
+    file: %DIR%/monitoring.token
+rules:
+  - identities: ["cn:csi-controller"]
+    volume_groups: ["k8s*"]
+  - identities: ["cn:csi-controller"]
+    methods: ["List*", "CreatePV", "Validate"]
+    volume_groups: ["*"]
+  - identities: ["uid:0"]
+  - identities: ["token:monitoring"]
+    methods: ["List*"]
+`
+
+type stream struct {
+	grpc.ServerStream
+	ctx context.Context
+	req proto.Message
+}
+
+func (s *stream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *stream) RecvMsg(m interface{}) error {
+	proto.Merge(m.(proto.Message), s.req)
+	return nil
+}
+
+var _ = Describe("Authorizer", func() {
+	var dir string
+	var authorizer *Authorizer
+
+	BeforeEach(func() {
+		var err error
+		dir, err = ioutil.TempDir("", "auth")
+		Expect(err).To(BeNil())
+		Expect(ioutil.WriteFile(filepath.Join(dir, "monitoring.token"), []byte("s3cret\n"), 0600)).To(Succeed())
+		file := filepath.Join(dir, "policy.yaml")
+		Expect(ioutil.WriteFile(file, []byte(strings.Replace(policy, "%DIR%", dir, -1)), 0600)).To(Succeed())
+		authorizer, err = Load(file)
+		Expect(err).To(BeNil())
+	})
+
+	AfterEach(func() {
+		os.RemoveAll(dir)
+	})
+
+	withCN := func(cn string) context.Context {
+		state := tls.ConnectionState{PeerCertificates: []*x509.Certificate{{Subject: pkix.Name{CommonName: cn}}}}
+		return peer.NewContext(context.Background(), &peer.Peer{AuthInfo: credentials.TLSInfo{State: state}})
+	}
+	withUID := func(uid uint32) context.Context {
+		return peer.NewContext(context.Background(), &peer.Peer{AuthInfo: unixsock.AuthInfo{UID: uid}})
+	}
+	withToken := func(token string) context.Context {
+		ctx := peer.NewContext(context.Background(), &peer.Peer{AuthInfo: credentials.TLSInfo{}})
+		return metadata.NewIncomingContext(ctx, metadata.Pairs("authorization", "Bearer "+token))
+	}
+
+	call := func(ctx context.Context, method string, req interface{}) codes.Code {
+		info := &grpc.UnaryServerInfo{FullMethod: "/lvm.LVM/" + method}
+		_, err := authorizer.UnaryInterceptor(ctx, req, info, func(context.Context, interface{}) (interface{}, error) {
+			return nil, nil
+		})
+		return status.Code(err)
+	}
+
+	It("should restrict identities to their volume groups", func() {
+		ctx := withCN("csi-controller")
+		Expect(call(ctx, "RemoveLV", &pb.RemoveLVRequest{VolumeGroup: "k8s"})).To(Equal(codes.OK))
+		Expect(call(ctx, "RemoveVG", &pb.CreateVGRequest{Name: "k8s-data"})).To(Equal(codes.OK))
+		Expect(call(ctx, "ListLV", &pb.ListLVRequest{VolumeGroup: "k8s/data"})).To(Equal(codes.OK))
+		Expect(call(ctx, "RemoveLV", &pb.RemoveLVRequest{VolumeGroup: "system"})).To(Equal(codes.PermissionDenied))
+		Expect(call(ctx, "CreateLV", &pb.CreateLVRequest{VolumeGroup: ""})).To(Equal(codes.PermissionDenied))
+	})
+
+	It("should only allow methods without a volume group to rules matching all of them", func() {
+		ctx := withCN("csi-controller")
+		Expect(call(ctx, "ListVG", &pb.ListVGRequest{})).To(Equal(codes.OK))
+		Expect(call(ctx, "CreatePV", &pb.CreatePVRequest{Block: "/dev/sdb"})).To(Equal(codes.OK))
+		Expect(call(ctx, "Destory", &pb.DestoryRequest{Block: "/dev/sdb"})).To(Equal(codes.PermissionDenied))
+		Expect(call(ctx, "RemovePV", &pb.RemovePVRequest{Block: "/dev/sdb"})).To(Equal(codes.PermissionDenied))
+	})
+
+	It("should identify callers on the unix socket by their uid", func() {
+		Expect(call(withUID(0), "Destory", &pb.DestoryRequest{Block: "/dev/sdb"})).To(Equal(codes.OK))
+		Expect(call(withUID(1000), "ListVG", &pb.ListVGRequest{})).To(Equal(codes.PermissionDenied))
+	})
+
+	It("should identify callers by bearer tokens", func() {
+		Expect(call(withToken("s3cret"), "ListPV", &pb.ListPVRequest{})).To(Equal(codes.OK))
+		Expect(call(withToken("s3cret"), "RemoveLV", &pb.RemoveLVRequest{VolumeGroup: "k8s"})).To(Equal(codes.PermissionDenied))
+		Expect(call(withToken("guess"), "ListPV", &pb.ListPVRequest{})).To(Equal(codes.Unauthenticated))
+	})
+
+	It("should refuse bearer tokens sent without tls", func() {
+		ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Bearer s3cret"))
+		Expect(call(ctx, "ListPV", &pb.ListPVRequest{})).To(Equal(codes.Unauthenticated))
+		ctx = metadata.NewIncomingContext(withUID(1000), metadata.Pairs("authorization", "Bearer s3cret"))
+		Expect(call(ctx, "ListPV", &pb.ListPVRequest{})).To(Equal(codes.OK))
+	})
+
+	It("should deny anonymous callers", func() {
+		Expect(call(context.Background(), "ListVG", &pb.ListVGRequest{})).To(Equal(codes.PermissionDenied))
+	})
+
+	It("should check both volume groups of a clone", func() {
+		check := func(req *pb.CloneLVRequest) codes.Code {
+			info := &grpc.StreamServerInfo{FullMethod: "/lvm.LVM/CloneLV", IsServerStream: true}
+			ss := &stream{ctx: withCN("csi-controller"), req: req}
+			return status.Code(authorizer.StreamInterceptor(nil, ss, info, func(srv interface{}, ss grpc.ServerStream) error {
+				return ss.RecvMsg(&pb.CloneLVRequest{})
+			}))
+		}
+		Expect(check(&pb.CloneLVRequest{VolumeGroup: "k8s", SourceName: "a", DestName: "b"})).To(Equal(codes.OK))
+		Expect(check(&pb.CloneLVRequest{VolumeGroup: "k8s", DestVolumeGroup: "system"})).To(Equal(codes.PermissionDenied))
+	})
+
+	It("should leave other services alone", func() {
+		info := &grpc.UnaryServerInfo{FullMethod: "/grpc.health.v1.Health/Check"}
+		_, err := authorizer.UnaryInterceptor(context.Background(), nil, info, func(context.Context, interface{}) (interface{}, error) {
+			return nil, nil
+		})
+		Expect(err).To(BeNil())
+	})
+
+	It("should reject invalid policies", func() {
+		_, err := New(Policy{Rules: []Rule{{Identities: []string{"cn:["}}}})
+		Expect(err).NotTo(BeNil())
+		_, err = New(Policy{Tokens: []Token{{Name: "missing", File: filepath.Join(dir, "missing")}}})
+		Expect(err).NotTo(BeNil())
+	})
+})
@@ -1,11 +1,19 @@
 package client
 
 import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"net"
+	"strings"
 	"time"
 
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
 
 	pb "github.com/zdnscloud/lvmd/proto"
+	"github.com/zdnscloud/lvmd/tlsutil"
+	"github.com/zdnscloud/lvmd/unixsock"
 )
 
 type Client struct {
@@ -13,11 +21,109 @@ type Client struct {
 	conn *grpc.ClientConn
 }
 
-func New(addr string, timeout time.Duration) (*Client, error) {
+type options struct {
+	tls        *tlsutil.Credentials
+	serverName string
+	token      string
+}
+
+// Option configures the connection made by New
+type Option func(*options) error
+
+// WithTLS connects over tls, the client presents the certificate in
+// certFile and keyFile when they are given and verifies the server against
+// the CAs in caFile, or the system CAs when caFile is empty. The files are
+// reloaded when they change
+func WithTLS(certFile, keyFile, caFile string) Option {
+	return func(o *options) error {
+		creds, err := tlsutil.Load(certFile, keyFile, caFile)
+		if err != nil {
+			return err
+		}
+		o.tls = creds
+		return nil
+	}
+}
+
+// WithServerName sets the name the server certificate is verified against,
+// it defaults to the host of the address
+func WithServerName(name string) Option {
+	return func(o *options) error {
+		o.serverName = name
+		return nil
+	}
+}
+
+// WithToken authenticates the calls with the bearer token in file, it
+// requires WithTLS unless lvmd is reached over a unix socket
+func WithToken(file string) Option {
+	return func(o *options) error {
+		data, err := ioutil.ReadFile(file)
+		if err != nil {
+			return err
+		}
+		o.token = strings.TrimSpace(string(data))
+		return nil
+	}
+}
+
+// tokenCredentials sends the bearer token with every call, insecure
+// allows sending it without tls on a unix socket
+type tokenCredentials struct {
+	token    string
+	insecure bool
+}
+
+func (t tokenCredentials) GetRequestMetadata(ctx context.Context, uri ...string) (map[string]string, error) {
+	return map[string]string{"authorization": "Bearer " + t.token}, nil
+}
+
+func (t tokenCredentials) RequireTransportSecurity() bool {
+	return !t.insecure
+}
+
+// New connects to lvmd at addr, which is a host:port or a unix socket
+// like unix:///run/lvmd.sock
+func New(addr string, timeout time.Duration, opts ...Option) (*Client, error) {
+	var o options
+	for _, opt := range opts {
+		if err := opt(&o); err != nil {
+			return nil, err
+		}
+	}
+
 	dialOptions := []grpc.DialOption{
-		grpc.WithInsecure(),
 		grpc.WithTimeout(timeout),
 	}
+	path, isUnix := unixsock.Path(addr)
+	if isUnix {
+		dialOptions = append(dialOptions, grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			var d net.Dialer
+			return d.DialContext(ctx, "unix", path)
+		}))
+	}
+	if o.tls != nil {
+		serverName := o.serverName
+		if serverName == "" && isUnix {
+			serverName = "localhost"
+		} else if serverName == "" {
+			host, _, err := net.SplitHostPort(addr)
+			if err != nil {
+				return nil, err
+			}
+			serverName = host
+		}
+		dialOptions = append(dialOptions, grpc.WithTransportCredentials(credentials.NewTLS(o.tls.ClientConfig(serverName))))
+	} else {
+		dialOptions = append(dialOptions, grpc.WithInsecure())
+	}
+
+	if o.token != "" {
+		if o.tls == nil && !isUnix {
+			return nil, errors.New("bearer tokens require tls unless connecting to a unix socket")
+		}
+		dialOptions = append(dialOptions, grpc.WithPerRPCCredentials(tokenCredentials{token: o.token, insecure: isUnix}))
+	}
 
 	conn, err := grpc.Dial(addr, dialOptions...)
 	if err != nil {
@@ -0,0 +1,165 @@
+package commands
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"golang.org/x/net/context"
+
+	"github.com/zdnscloud/lvmd/parser"
+)
+
+// ManagedTag marks the thin pools created by lvmd, they are autoextended
+// with the policy of the daemon unless they have a policy of their own
+const ManagedTag = "lvmd.managed"
+
+// policyTagPrefix prefixes the tag storing the policy of a thin pool as
+// "lvmd.autoextend=<threshold>:<percent>"
+const policyTagPrefix = "lvmd.autoextend="
+
+// ThinPoolPolicy extends the data or metadata of a thin pool by Percent of
+// its size once its usage reaches Threshold percent, a Threshold of 0
+// disables autoextend
+type ThinPoolPolicy struct {
+	Threshold uint32
+	Percent   uint32
+}
+
+// Enabled returns whether the policy extends pools at all
+func (p ThinPoolPolicy) Enabled() bool {
+	return p.Threshold != 0
+}
+
+// Validate checks that the threshold is a percentage and that an enabled
+// policy extends by more than nothing
+func (p ThinPoolPolicy) Validate() error {
+	if p.Threshold > 100 {
+		return newError(ErrInvalidArgument, "autoextend threshold %d is larger than 100", p.Threshold)
+	}
+	if p.Enabled() && p.Percent == 0 {
+		return newError(ErrInvalidArgument, "autoextend percent must be larger than 0")
+	}
+	return nil
+}
+
+func (p ThinPoolPolicy) tag() string {
+	return fmt.Sprintf("%s%d:%d", policyTagPrefix, p.Threshold, p.Percent)
+}
+
+// ThinPoolPolicyFromTags returns the policy stored in the tags of a thin
+// pool, ok is false when the pool has no valid policy
+func ThinPoolPolicyFromTags(tags []string) (policy ThinPoolPolicy, ok bool) {
+	for _, tag := range tags {
+		if !strings.HasPrefix(tag, policyTagPrefix) {
+			continue
+		}
+		fields := strings.Split(strings.TrimPrefix(tag, policyTagPrefix), ":")
+		if len(fields) != 2 {
+			continue
+		}
+		threshold, err := strconv.ParseUint(fields[0], 10, 32)
+		if err != nil {
+			continue
+		}
+		percent, err := strconv.ParseUint(fields[1], 10, 32)
+		if err != nil {
+			continue
+		}
+		policy = ThinPoolPolicy{Threshold: uint32(threshold), Percent: uint32(percent)}
+		if policy.Validate() == nil {
+			return policy, true
+		}
+	}
+	return ThinPoolPolicy{}, false
+}
+
+// SetThinPoolPolicy stores policy in the tags of the thin pool vg/pool,
+// replacing its previous policy
+func SetThinPoolPolicy(ctx context.Context, vg string, pool string, policy ThinPoolPolicy) (string, error) {
+	if err := policy.Validate(); err != nil {
+		return "", err
+	}
+	lv, err := findLV(ctx, vg, pool)
+	if err != nil {
+		return "", err
+	}
+	if lv == nil {
+		return "", newError(ErrNotFound, "thin pool %s/%s not found", vg, pool)
+	}
+	if lv.Attributes.Type != parser.VolumeTypeThinPool {
+		return "", newError(ErrInvalidArgument, "volume %s/%s isn't a thin pool", vg, pool)
+	}
+
+	var args []string
+	for _, tag := range lv.Tags {
+		if strings.HasPrefix(tag, policyTagPrefix) && tag != policy.tag() {
+			args = append(args, "--deltag", tag)
+		}
+	}
+	if !hasTags(lv.Tags, []string{policy.tag()}) {
+		args = append(args, "--addtag", policy.tag())
+	}
+	if len(args) == 0 {
+		return "", nil
+	}
+	return run(ctx, "lvchange", append(args, fmt.Sprintf("%s/%s", vg, pool))...)
+}
+
+// ExtendThinPoolData grows the data of the thin pool vg/pool by percent of
+// its size, or by the free space of the volume group when less is left
+func ExtendThinPoolData(ctx context.Context, vg string, pool string, percent uint32) (string, error) {
+	status, err := GetThinPoolStatus(ctx, vg, pool)
+	if err != nil {
+		return "", err
+	}
+	increase, err := thinPoolIncrease(ctx, vg, status.Size, percent, 1)
+	if err != nil {
+		return "", err
+	}
+	return run(ctx, "lvresize", "-L", fmt.Sprintf("%db", status.Size+increase), "-v", fmt.Sprintf("%s/%s", vg, pool))
+}
+
+// ExtendThinPoolMetadata grows the metadata of the thin pool vg/pool by
+// percent of its size, up to the maximum metadata size of 16GiB
+func ExtendThinPoolMetadata(ctx context.Context, vg string, pool string, percent uint32) (string, error) {
+	status, err := GetThinPoolStatus(ctx, vg, pool)
+	if err != nil {
+		return "", err
+	}
+	if status.MetadataSize >= maxMetadataSize {
+		return "", newError(ErrUnsupported, "metadata of thin pool %s/%s has the maximum size", vg, pool)
+	}
+	// lvm grows the spare metadata volume along with the metadata
+	increase, err := thinPoolIncrease(ctx, vg, status.MetadataSize, percent, 2)
+	if err != nil {
+		return "", err
+	}
+	size := status.MetadataSize + increase
+	if size > maxMetadataSize {
+		size = maxMetadataSize
+	}
+	return run(ctx, "lvresize", "--poolmetadatasize", fmt.Sprintf("%db", size), "-v", fmt.Sprintf("%s/%s", vg, pool))
+}
+
+// thinPoolIncrease returns how much a volume of size grows by percent, in
+// extents and bounded by the free space of vg. Each extent of the increase
+// takes copies extents of the volume group
+func thinPoolIncrease(ctx context.Context, vg string, size uint64, percent uint32, copies uint64) (uint64, error) {
+	extent, err := extentSize(ctx, vg)
+	if err != nil {
+		return 0, err
+	}
+	group, err := GetVG(ctx, vg)
+	if err != nil {
+		return 0, err
+	}
+	increase := roundUp(size*uint64(percent)/100, extent)
+	if available := group.FreeSize / copies / extent * extent; increase > available {
+		increase = available
+	}
+	if increase == 0 {
+		return 0, newError(ErrInsufficientSpace, "volume group %s has no free space to extend the thin pool", vg)
+	}
+	return increase, nil
+}
@@ -0,0 +1,104 @@
+package commands
+
+import (
+	"path/filepath"
+	"strings"
+
+	"golang.org/x/net/context"
+
+	"github.com/zdnscloud/lvmd/parser"
+)
+
+const lsblkColumns = "NAME,PATH,SIZE,TYPE,ROTA,RM,MODEL,SERIAL,WWN,TRAN,PTTYPE,FSTYPE,MOUNTPOINT"
+
+// BlockDeviceFilter selects the block devices returned by ListBlockDevices,
+// the zero value selects all devices
+type BlockDeviceFilter struct {
+	MinSize          uint64
+	ExcludeRemovable bool
+	// PathGlobs match the path or any udev link of a device, like
+	// /dev/disk/by-id/wwn-*
+	PathGlobs []string
+}
+
+func (f BlockDeviceFilter) validate() error {
+	for _, glob := range f.PathGlobs {
+		if _, err := filepath.Match(glob, ""); err != nil {
+			return newError(ErrInvalidArgument, "invalid path glob %q", glob)
+		}
+	}
+	return nil
+}
+
+func (f BlockDeviceFilter) match(dev *parser.BlockDevice) bool {
+	if dev.Size < f.MinSize || (f.ExcludeRemovable && dev.Removable) {
+		return false
+	}
+	if len(f.PathGlobs) == 0 {
+		return true
+	}
+	for _, glob := range f.PathGlobs {
+		for _, path := range append([]string{dev.Path}, dev.Links...) {
+			if ok, _ := filepath.Match(glob, path); ok {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// ListBlockDevices lists the block devices with their udev links and
+// whether they are physical volumes, so callers can pick disks for new
+// physical volumes
+func ListBlockDevices(ctx context.Context, filter BlockDeviceFilter) ([]*parser.BlockDevice, error) {
+	if err := filter.validate(); err != nil {
+		return nil, err
+	}
+	out, err := runStdout(ctx, "lsblk", "--json", "--bytes", "--output", lsblkColumns)
+	if err != nil {
+		return nil, err
+	}
+	devices, err := parser.ParseBlockDevices(out)
+	if err != nil {
+		return nil, err
+	}
+
+	pvs, err := ListPV(ctx)
+	if err != nil {
+		return nil, err
+	}
+	owners := make(map[string]string)
+	for _, pv := range pvs {
+		if pv.Name != "" {
+			owners[pv.Name] = pv.VGName
+		}
+	}
+
+	var res []*parser.BlockDevice
+	for _, dev := range devices {
+		if dev.Links, err = deviceLinks(ctx, dev.Path); err != nil {
+			return nil, err
+		}
+		if vg, ok := owners[dev.Path]; ok {
+			dev.PhysicalVolume = true
+			dev.VolumeGroup = vg
+		}
+		if filter.match(dev) {
+			res = append(res, dev)
+		}
+	}
+	return res, nil
+}
+
+// deviceLinks returns the udev links of the device node path
+func deviceLinks(ctx context.Context, path string) ([]string, error) {
+	out, err := runStdout(ctx, "udevadm", "info", "--query=symlink", path)
+	if err != nil {
+		return nil, err
+	}
+	var links []string
+	for _, link := range strings.Fields(out) {
+		links = append(links, "/dev/"+link)
+	}
+	return links, nil
+}
@@ -0,0 +1,134 @@
+package commands
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"github.com/zdnscloud/lvmd/parser"
+)
+
+const (
+	cloneChunkSize        = 4 * 1024 * 1024
+	cloneProgressInterval = time.Second
+)
+
+// CloneProgress is reported periodically while CloneLV copies data
+type CloneProgress struct {
+	// Copied counts the bytes of the source processed so far, including
+	// skipped zeroes
+	Copied         uint64
+	Skipped        uint64
+	Total          uint64
+	BytesPerSecond uint64
+	Done           bool
+}
+
+// CloneLV copies the content of the source volume into the destination
+// volume, which must exist and be at least as large as the source. A thin
+// destination is discarded first, so zero regions of the source don't need
+// to be written to it, unless its pool ignores discards. Copying stops with
+// an error when progress fails
+func CloneLV(ctx context.Context, srcVG string, src string, destVG string, dest string, progress func(CloneProgress) error) error {
+	if srcVG == destVG && src == dest {
+		return newError(ErrInvalidArgument, "source and destination are the same volume")
+	}
+
+	srcLV, err := GetLV(ctx, srcVG, src)
+	if err != nil {
+		return err
+	}
+	destLV, err := GetLV(ctx, destVG, dest)
+	if err != nil {
+		return err
+	}
+	if destLV.Size < srcLV.Size {
+		return newError(ErrInvalidArgument, "destination size %d is smaller than source size %d", destLV.Size, srcLV.Size)
+	}
+	if destLV.Attributes.Open == parser.VolumeOpenIsOpen {
+		return newError(ErrBusy, "destination %s/%s is in use", destVG, dest)
+	}
+
+	skipZero := destLV.Attributes.Type == parser.VolumeTypeThin
+	if skipZero {
+		if _, err := run(ctx, "blkdiscard", devicePath(destVG, dest)); err != nil {
+			var cmdErr *Error
+			if !errors.As(err, &cmdErr) || cmdErr.Kind != ErrUnsupported {
+				return err
+			}
+			// pools ignoring discards keep the old data, so zeroes have to
+			// be written like to any other volume
+			skipZero = false
+		}
+	}
+
+	in, err := os.Open(devicePath(srcVG, src))
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+	out, err := os.OpenFile(devicePath(destVG, dest), os.O_WRONLY, 0)
+	if err != nil {
+		return err
+	}
+	defer out.Close()
+
+	if err := copyBlocks(ctx, in, out, srcLV.Size, skipZero, progress); err != nil {
+		return err
+	}
+	return out.Sync()
+}
+
+// copyBlocks copies size bytes from src to dst in chunks, chunks holding
+// only zeroes aren't written when skipZero is set
+func copyBlocks(ctx context.Context, src io.ReaderAt, dst io.WriterAt, size uint64, skipZero bool, progress func(CloneProgress) error) error {
+	buf := make([]byte, cloneChunkSize)
+	zero := make([]byte, cloneChunkSize)
+	p := CloneProgress{Total: size}
+	start := time.Now()
+	lastReport := start
+
+	report := func(now time.Time) error {
+		if elapsed := now.Sub(start).Seconds(); elapsed > 0 {
+			p.BytesPerSecond = uint64(float64(p.Copied) / elapsed)
+		}
+		lastReport = now
+		return progress(p)
+	}
+
+	for p.Copied < size {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		n := uint64(cloneChunkSize)
+		if size-p.Copied < n {
+			n = size - p.Copied
+		}
+		chunk := buf[:n]
+		if read, err := src.ReadAt(chunk, int64(p.Copied)); err != nil && !(err == io.EOF && uint64(read) == n) {
+			return fmt.Errorf("read at offset %d failed: %w", p.Copied, err)
+		}
+
+		if skipZero && bytes.Equal(chunk, zero[:n]) {
+			p.Skipped += n
+		} else if _, err := dst.WriteAt(chunk, int64(p.Copied)); err != nil {
+			return fmt.Errorf("write at offset %d failed: %w", p.Copied, err)
+		}
+		p.Copied += n
+
+		if now := time.Now(); now.Sub(lastReport) >= cloneProgressInterval {
+			if err := report(now); err != nil {
+				return err
+			}
+		}
+	}
+
+	p.Done = true
+	return report(time.Now())
+}
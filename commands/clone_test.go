@@ -0,0 +1,71 @@
+package commands
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"golang.org/x/net/context"
+)
+
+var _ = Describe("Block copy", func() {
+	const size = 3*cloneChunkSize + 100
+	var src, dst *os.File
+
+	BeforeEach(func() {
+		var err error
+		src, err = ioutil.TempFile("", "lvmd-src")
+		Expect(err).To(BeNil())
+		dst, err = ioutil.TempFile("", "lvmd-dst")
+		Expect(err).To(BeNil())
+		Expect(src.Truncate(size)).To(Succeed())
+		Expect(dst.Truncate(size)).To(Succeed())
+		_, err = src.WriteAt([]byte("head"), 0)
+		Expect(err).To(BeNil())
+		_, err = src.WriteAt([]byte("tail"), size-4)
+		Expect(err).To(BeNil())
+	})
+
+	AfterEach(func() {
+		src.Close()
+		dst.Close()
+		os.Remove(src.Name())
+		os.Remove(dst.Name())
+	})
+
+	It("should copy the data and skip zero chunks", func() {
+		var reports []CloneProgress
+		err := copyBlocks(context.Background(), src, dst, size, true, func(p CloneProgress) error {
+			reports = append(reports, p)
+			return nil
+		})
+		Expect(err).To(BeNil())
+
+		last := reports[len(reports)-1]
+		Expect(last.Done).To(BeTrue())
+		Expect(last.Copied).To(Equal(uint64(size)))
+		Expect(last.Total).To(Equal(uint64(size)))
+		Expect(last.Skipped).To(Equal(uint64(2 * cloneChunkSize)))
+
+		data, err := ioutil.ReadFile(dst.Name())
+		Expect(err).To(BeNil())
+		Expect(string(data[:4])).To(Equal("head"))
+		Expect(string(data[size-4:])).To(Equal("tail"))
+	})
+
+	It("should stop when progress can't be reported", func() {
+		err := copyBlocks(context.Background(), src, dst, size, false, func(p CloneProgress) error {
+			return errors.New("client gone")
+		})
+		Expect(err).To(MatchError("client gone"))
+	})
+
+	It("should stop when the context is done", func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		err := copyBlocks(ctx, src, dst, size, false, func(p CloneProgress) error { return nil })
+		Expect(err).To(Equal(context.Canceled))
+	})
+})
@@ -21,7 +21,8 @@ package commands
 import (
 	"errors"
 	"fmt"
-	"os/exec"
+	"path/filepath"
+	"strconv"
 	"strings"
 
 	"golang.org/x/net/context"
@@ -29,15 +30,42 @@ import (
 	"github.com/zdnscloud/lvmd/parser"
 )
 
+// DevDir is the directory holding the device nodes of volume groups
+var DevDir = "/dev"
+
+func devicePath(vg string, name string) string {
+	return filepath.Join(DevDir, vg, name)
+}
+
 // ListLV lists lvm volumes
 func ListLV(ctx context.Context, listspec string) ([]*parser.LV, error) {
-	cmd := exec.Command("lvs", "--units=b", "--separator=<:SEP:>", "--nosuffix", "--noheadings",
-		"-o", "lv_name,lv_size,lv_uuid,lv_attr,copy_percent,lv_kernel_major,lv_kernel_minor,lv_tags", "--nameprefixes", "-a", listspec)
-	out, err := cmd.CombinedOutput()
+	lvs, err := listLV(ctx, listspec)
 	if err != nil {
 		return nil, err
 	}
-	outStr := strings.TrimSpace(string(out))
+	segments, err := listSegments(ctx, listspec)
+	if err != nil {
+		return nil, err
+	}
+	byUUID := make(map[string][]parser.Segment)
+	for _, seg := range segments {
+		byUUID[seg.LVUUID] = append(byUUID[seg.LVUUID], *seg)
+	}
+	for _, lv := range lvs {
+		lv.SetSegments(byUUID[lv.UUID])
+	}
+	return lvs, nil
+}
+
+func listLV(ctx context.Context, listspec string) ([]*parser.LV, error) {
+	out, err := runStdout(ctx, "lvs", reportArgs("lv_name,lv_size,lv_uuid,lv_attr,copy_percent,lv_kernel_major,lv_kernel_minor,lv_tags,origin,snap_percent,pool_lv,data_percent,metadata_percent", "-a", listspec)...)
+	if err != nil {
+		return nil, err
+	}
+	if reportFormat == ReportFormatJSON {
+		return parser.ParseLVReport(out)
+	}
+	outStr := strings.TrimSpace(out)
 	outLines := strings.Split(outStr, "\n")
 	lvs := make([]*parser.LV, len(outLines))
 	for i, line := range outLines {
@@ -51,17 +79,123 @@ func ListLV(ctx context.Context, listspec string) ([]*parser.LV, error) {
 	return lvs, nil
 }
 
+// listSegments lists the segments of the volumes in listspec, in the order
+// of the volumes and of the segments in each volume
+func listSegments(ctx context.Context, listspec string) ([]*parser.Segment, error) {
+	out, err := runStdout(ctx, "lvs", reportArgs("lv_uuid,seg_start,seg_size,segtype,stripes,stripe_size,region_size,devices", "--segments", "-a", listspec)...)
+	if err != nil {
+		return nil, err
+	}
+	if reportFormat == ReportFormatJSON {
+		return parser.ParseSegmentReport(out)
+	}
+	var segments []*parser.Segment
+	for _, line := range strings.Split(strings.TrimSpace(out), "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+		seg, err := parser.ParseSegment(line)
+		if err != nil {
+			return nil, err
+		}
+		segments = append(segments, seg)
+	}
+	return segments, nil
+}
+
+// GetLV returns the logical volume vg/name
+func GetLV(ctx context.Context, vg string, name string) (*parser.LV, error) {
+	lvs, err := ListLV(ctx, fmt.Sprintf("%s/%s", vg, name))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list LVs: %w", err)
+	}
+	if len(lvs) != 1 {
+		return nil, fmt.Errorf("expected 1 LV, got %d", len(lvs))
+	}
+	return lvs[0], nil
+}
+
+// findLV returns nil rather than an error when the volume doesn't exist
+func findLV(ctx context.Context, vg string, name string) (*parser.LV, error) {
+	lv, err := GetLV(ctx, vg, name)
+	var cmdErr *Error
+	if errors.As(err, &cmdErr) && cmdErr.Kind == ErrNotFound {
+		return nil, nil
+	}
+	return lv, err
+}
+
+func extentSize(ctx context.Context, vg string) (uint64, error) {
+	out, err := runStdout(ctx, "vgs", "--units=b", "--nosuffix", "--noheadings", "-o", "vg_extent_size", vg)
+	if err != nil {
+		return 0, err
+	}
+	return strconv.ParseUint(strings.TrimSpace(out), 10, 64)
+}
+
+// matchLV checks whether an existing volume satisfies a create request, lvm
+// rounds sizes up to the extent size so a larger volume within one extent
+// of the requested size matches
+func matchLV(ctx context.Context, vg string, lv *parser.LV, size uint64, pool string, tags []string) error {
+	if lv.PoolLV != pool {
+		return newError(ErrAlreadyExists, "volume %s/%s already exists in pool %q", vg, lv.Name, lv.PoolLV)
+	}
+	extent, err := extentSize(ctx, vg)
+	if err != nil {
+		return err
+	}
+	if lv.Size < size || lv.Size-size >= extent {
+		return newError(ErrAlreadyExists, "volume %s/%s already exists with size %d", vg, lv.Name, lv.Size)
+	}
+	if !hasTags(lv.Tags, tags) {
+		return newError(ErrAlreadyExists, "volume %s/%s already exists with tags %v", vg, lv.Name, lv.Tags)
+	}
+	return nil
+}
+
+func hasTags(tags []string, expected []string) bool {
+	for _, e := range expected {
+		found := false
+		for _, t := range tags {
+			if t == e {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// CreateThinPoolUseAllSize creates a thin pool using all free space of the
+// volume group, an existing thin pool with the same name is accepted
 func CreateThinPoolUseAllSize(ctx context.Context, vg string, pool string) (string, error) {
-	args := []string{"-v", "-l", "100%FREE", "--thinpool", pool, vg, "-y"}
-	cmd := exec.Command("lvcreate", args...)
-	out, err := cmd.CombinedOutput()
-	return string(out), err
+	return CreateThinPool(ctx, vg, pool, ThinPoolOptions{})
 }
 
-// CreateLV creates a new volume
+// CreateThinLV creates a new thin volume in the pool given as vg/pool, an
+// existing volume with the same spec is accepted so callers can retry
 func CreateThinLV(ctx context.Context, vg string, name string, size uint64, mirrors uint32, tags []string) (string, error) {
 	if size == 0 {
-		return "", errors.New("size must be greater than 0")
+		return "", newError(ErrInvalidArgument, "size must be greater than 0")
+	}
+
+	vgName, pool := vg, ""
+	if idx := strings.Index(vg, "/"); idx != -1 {
+		vgName, pool = vg[:idx], vg[idx+1:]
+	}
+	existing, err := findLV(ctx, vgName, name)
+	if err != nil {
+		return "", err
+	}
+	if existing != nil {
+		if err := matchLV(ctx, vgName, existing, size, pool, tags); err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("Logical volume \"%s\" already exists\n", name), nil
 	}
 
 	args := []string{"--thin", "-v", "-n", name, "-V", fmt.Sprintf("%db", size)}
@@ -72,24 +206,31 @@ func CreateThinLV(ctx context.Context, vg string, name string, size uint64, mirr
 		args = append(args, "--add-tag", tag)
 	}
 	args = append(args, vg)
-	cmd := exec.Command("lvcreate", args...)
-	out, err := cmd.CombinedOutput()
-	return string(out), err
+	return run(ctx, "lvcreate", args...)
 }
 
 func ChangeLV(ctx context.Context, vg string, name string) (string, error) {
 	//lvchange -a y /dev/iscsi-group/iscsi-pool
-	dev := fmt.Sprintf("/dev/%s/%s", vg, name)
-	args := []string{"-a", "y", dev}
-	cmd := exec.Command("lvchange", args...)
-	out, err := cmd.CombinedOutput()
-	return string(out), err
+	args := []string{"-a", "y", devicePath(vg, name)}
+	return run(ctx, "lvchange", args...)
 }
 
-// CreateLV creates a new volume
+// CreateLV creates a new volume, an existing volume with the same spec is
+// accepted so callers can retry
 func CreateLV(ctx context.Context, vg string, name string, size uint64, mirrors uint32, tags []string) (string, error) {
 	if size == 0 {
-		return "", errors.New("size must be greater than 0")
+		return "", newError(ErrInvalidArgument, "size must be greater than 0")
+	}
+
+	existing, err := findLV(ctx, vg, name)
+	if err != nil {
+		return "", err
+	}
+	if existing != nil {
+		if err := matchLV(ctx, vg, existing, size, "", tags); err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("Logical volume \"%s\" already exists\n", name), nil
 	}
 
 	args := []string{"-v", "-n", name, "-L", fmt.Sprintf("%db", size)}
@@ -100,9 +241,7 @@ func CreateLV(ctx context.Context, vg string, name string, size uint64, mirrors
 		args = append(args, "--add-tag", tag)
 	}
 	args = append(args, vg)
-	cmd := exec.Command("lvcreate", args...)
-	out, err := cmd.CombinedOutput()
-	return string(out), err
+	return run(ctx, "lvcreate", args...)
 }
 
 // ProtectedTagName is a tag that prevents RemoveLV & RemoveVG from removing a volume
@@ -112,57 +251,105 @@ const ProtectedTagName = "protected"
 func RemoveLV(ctx context.Context, vg string, name string) (string, error) {
 	lvs, err := ListLV(ctx, fmt.Sprintf("%s/%s", vg, name))
 	if err != nil {
-		return "", fmt.Errorf("failed to list LVs: %v", err)
+		return "", fmt.Errorf("failed to list LVs: %w", err)
 	}
 	if len(lvs) != 1 {
 		return "", fmt.Errorf("expected 1 LV, got %d", len(lvs))
 	}
 	for _, tag := range lvs[0].Tags {
 		if tag == ProtectedTagName {
-			return "", errors.New("volume is protected")
+			return "", newError(ErrProtected, "volume is protected")
 		}
 	}
 
-	cmd := exec.Command("lvremove", "-v", "-f", fmt.Sprintf("%s/%s", vg, name))
-	out, err := cmd.CombinedOutput()
-	return string(out), err
+	return run(ctx, "lvremove", "-v", "-f", fmt.Sprintf("%s/%s", vg, name))
 }
 
-// CloneLV clones a volume via dd
-func CloneLV(ctx context.Context, src, dest string) (string, error) {
-	// FIXME(farcaller): bloody insecure. And broken.
-	cmd := exec.Command("dd", fmt.Sprintf("if=%s", src), fmt.Sprintf("of=%s", dest), "bs=4M")
-	out, err := cmd.CombinedOutput()
-	return string(out), err
-}
+// CreateSnapshot creates a snapshot of an existing volume, snapshots of thick
+// volumes need the size of their copy-on-write space while snapshots of thin
+// volumes are sizeless and allocated from the pool of their origin
+func CreateSnapshot(ctx context.Context, vg string, origin string, name string, size uint64, tags []string) (string, error) {
+	lv, err := GetLV(ctx, vg, origin)
+	if err != nil {
+		return "", err
+	}
 
-func ResizeLV(ctx context.Context, vg string, name string, size uint64) (string, error) {
-	cmd := exec.Command("lvresize", "-L", fmt.Sprintf("%db", size), "-v", fmt.Sprintf("%s/%s", vg, name))
-	out, err := cmd.CombinedOutput()
-	return string(out), err
+	args := []string{"-v", "-s", "-n", name}
+	if lv.Attributes.Type == parser.VolumeTypeThin {
+		if size != 0 {
+			return "", newError(ErrInvalidArgument, "snapshot of thin volume can't have a size")
+		}
+	} else {
+		if size == 0 {
+			return "", newError(ErrInvalidArgument, "size must be greater than 0")
+		}
+		args = append(args, "-L", fmt.Sprintf("%db", size))
+	}
+	for _, tag := range tags {
+		args = append(args, "--add-tag", tag)
+	}
+	args = append(args, fmt.Sprintf("%s/%s", vg, origin))
+	return run(ctx, "lvcreate", args...)
 }
 
-func ResizeLVe2fsck(ctx context.Context, vg string, name string) (string, error) {
-	cmd := exec.Command("e2fsck", "-f", "-y", fmt.Sprintf("/dev/%s/%s", vg, name))
-	out, err := cmd.CombinedOutput()
-	return string(out), err
+// ListSnapshots lists the snapshots in a volume group, when origin isn't
+// empty only its snapshots are returned
+func ListSnapshots(ctx context.Context, vg string, origin string) ([]*parser.LV, error) {
+	lvs, err := ListLV(ctx, vg)
+	if err != nil {
+		return nil, err
+	}
+	var snapshots []*parser.LV
+	for _, lv := range lvs {
+		if lv.Origin == "" {
+			continue
+		}
+		if origin == "" || lv.Origin == origin {
+			snapshots = append(snapshots, lv)
+		}
+	}
+	return snapshots, nil
 }
 
-func ResizeLV2fs(ctx context.Context, vg string, name string) (string, error) {
-	cmd := exec.Command("resize2fs", fmt.Sprintf("/dev/%s/%s", vg, name))
-	out, err := cmd.CombinedOutput()
-	return string(out), err
+// MergeSnapshot merges a snapshot back into its origin, rolling the origin
+// back to the content of the snapshot and removing the snapshot. When the
+// origin is open the merge starts on its next activation
+func MergeSnapshot(ctx context.Context, vg string, name string) (string, error) {
+	lv, err := GetLV(ctx, vg, name)
+	if err != nil {
+		return "", err
+	}
+	if lv.Origin == "" {
+		return "", newError(ErrInvalidArgument, "%s/%s is not a snapshot", vg, name)
+	}
+	return run(ctx, "lvconvert", "--merge", "-v", fmt.Sprintf("%s/%s", vg, name))
 }
 
 func ListVG(ctx context.Context) ([]*parser.VG, error) {
+	return listVG(ctx)
+}
+
+// GetVG returns the volume group with the given name
+func GetVG(ctx context.Context, name string) (*parser.VG, error) {
+	vgs, err := listVG(ctx, name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list VGs: %w", err)
+	}
+	if len(vgs) != 1 {
+		return nil, fmt.Errorf("expected 1 VG, got %d", len(vgs))
+	}
+	return vgs[0], nil
+}
 
-	cmd := exec.Command("vgs", "--units=b", "--separator=<:SEP:>", "--nosuffix", "--noheadings",
-		"-o", "vg_name,vg_size,vg_free,vg_uuid,vg_tags", "--nameprefixes", "-a")
-	out, err := cmd.CombinedOutput()
+func listVG(ctx context.Context, names ...string) ([]*parser.VG, error) {
+	out, err := runStdout(ctx, "vgs", reportArgs("vg_name,vg_size,vg_free,vg_uuid,vg_tags,vg_extent_size,vg_extent_count,vg_free_count,pv_count,lv_count,snap_count,max_lv,max_pv,vg_attr,vg_lock_type", append([]string{"-a"}, names...)...)...)
 	if err != nil {
 		return nil, err
 	}
-	outStr := strings.TrimSpace(string(out))
+	if reportFormat == ReportFormatJSON {
+		return parser.ParseVGReport(out)
+	}
+	outStr := strings.TrimSpace(out)
 	outLines := strings.Split(outStr, "\n")
 	vgs := make([]*parser.VG, len(outLines))
 	for i, line := range outLines {
@@ -177,31 +364,48 @@ func ListVG(ctx context.Context) ([]*parser.VG, error) {
 }
 
 func ExtendVG(ctx context.Context, name string, physicalVolume string) (string, error) {
-	cmd := exec.Command("vgextend", name, physicalVolume)
-	out, err := cmd.CombinedOutput()
-	return string(out), err
+	return run(ctx, "vgextend", name, physicalVolume)
 }
 
 func ReduceVG(ctx context.Context, name string, physicalVolume string) (string, error) {
-	cmd := exec.Command("vgreduce", name, physicalVolume)
-	out, err := cmd.CombinedOutput()
-	return string(out), err
+	return run(ctx, "vgreduce", name, physicalVolume)
 }
 
+// CreateVG creates a volume group on a block, an existing volume group which
+// contains the block and has the requested tags is accepted
 func CreateVG(ctx context.Context, name string, physicalVolume string, tags []string) (string, error) {
+	vgs, err := ListVG(ctx)
+	if err != nil {
+		return "", fmt.Errorf("failed to list VGs: %w", err)
+	}
+	for _, vg := range vgs {
+		if vg.Name != name {
+			continue
+		}
+		owner, err := Match(ctx, physicalVolume)
+		if err != nil {
+			return "", fmt.Errorf("failed to match PV: %w", err)
+		}
+		if owner != name {
+			return "", newError(ErrAlreadyExists, "volume group %s already exists without %s", name, physicalVolume)
+		}
+		if !hasTags(vg.Tags, tags) {
+			return "", newError(ErrAlreadyExists, "volume group %s already exists with tags %v", name, vg.Tags)
+		}
+		return fmt.Sprintf("Volume group \"%s\" already exists\n", name), nil
+	}
+
 	args := []string{name, physicalVolume, "-v"}
 	for _, tag := range tags {
 		args = append(args, "--add-tag", tag)
 	}
-	cmd := exec.Command("vgcreate", args...)
-	out, err := cmd.CombinedOutput()
-	return string(out), err
+	return run(ctx, "vgcreate", args...)
 }
 
 func RemoveVG(ctx context.Context, name string) (string, error) {
 	vgs, err := ListVG(ctx)
 	if err != nil {
-		return "", fmt.Errorf("failed to list VGs: %v", err)
+		return "", fmt.Errorf("failed to list VGs: %w", err)
 	}
 	var vg *parser.VG
 	for _, v := range vgs {
@@ -211,23 +415,21 @@ func RemoveVG(ctx context.Context, name string) (string, error) {
 		}
 	}
 	if vg == nil {
-		return "", fmt.Errorf("could not find vg to delete")
+		return "", newError(ErrNotFound, "could not find vg to delete")
 	}
 	for _, tag := range vg.Tags {
 		if tag == ProtectedTagName {
-			return "", errors.New("volume is protected")
+			return "", newError(ErrProtected, "volume is protected")
 		}
 	}
 
-	cmd := exec.Command("vgremove", "-v", "-f", name)
-	out, err := cmd.CombinedOutput()
-	return string(out), err
+	return run(ctx, "vgremove", "-v", "-f", name)
 }
 
 func AddTagLV(ctx context.Context, vg string, name string, tags []string) (string, error) {
 	lvs, err := ListLV(ctx, fmt.Sprintf("%s/%s", vg, name))
 	if err != nil {
-		return "", fmt.Errorf("failed to list LVs: %v", err)
+		return "", fmt.Errorf("failed to list LVs: %w", err)
 	}
 	if len(lvs) != 1 {
 		return "", fmt.Errorf("expected 1 LV, got %d", len(lvs))
@@ -241,15 +443,13 @@ func AddTagLV(ctx context.Context, vg string, name string, tags []string) (strin
 
 	args = append(args, fmt.Sprintf("%s/%s", vg, name))
 
-	cmd := exec.Command("lvchange", args...)
-	out, err := cmd.CombinedOutput()
-	return string(out), err
+	return run(ctx, "lvchange", args...)
 }
 
 func RemoveTagLV(ctx context.Context, vg string, name string, tags []string) (string, error) {
 	lvs, err := ListLV(ctx, fmt.Sprintf("%s/%s", vg, name))
 	if err != nil {
-		return "", fmt.Errorf("failed to list LVs: %v", err)
+		return "", fmt.Errorf("failed to list LVs: %w", err)
 	}
 	if len(lvs) != 1 {
 		return "", fmt.Errorf("expected 1 LV, got %d", len(lvs))
@@ -263,95 +463,107 @@ func RemoveTagLV(ctx context.Context, vg string, name string, tags []string) (st
 
 	args = append(args, fmt.Sprintf("%s/%s", vg, name))
 
-	cmd := exec.Command("lvchange", args...)
-	out, err := cmd.CombinedOutput()
-	return string(out), err
+	return run(ctx, "lvchange", args...)
 }
 
+// CreatePV initializes a block as physical volume, it does nothing when the
+// block is a physical volume already
 func CreatePV(ctx context.Context, block string) (string, error) {
+	_, err := run(ctx, "pvs", "--noheadings", "-o", "pv_name", block)
+	if err == nil {
+		return fmt.Sprintf("Physical volume \"%s\" already exists\n", block), nil
+	}
+	var cmdErr *Error
+	if !errors.As(err, &cmdErr) || cmdErr.Kind != ErrNotFound {
+		return "", err
+	}
+
 	args := []string{block, "-y", "-v"}
-	cmd := exec.Command("pvcreate", args...)
-	out, err := cmd.CombinedOutput()
-	return string(out), err
+	return run(ctx, "pvcreate", args...)
 }
 
 func RemovePV(ctx context.Context, block string) (string, error) {
 	args := []string{block, "-y", "-v"}
-	cmd := exec.Command("pvremove", args...)
-	out, err := cmd.CombinedOutput()
-	return string(out), err
+	return run(ctx, "pvremove", args...)
 }
 
+// ListPV returns the physical volumes. pvs -a reports the other block
+// devices lvm can see as well, they are left out
 func ListPV(ctx context.Context) ([]*parser.PV, error) {
-	cmd := exec.Command("pvs", "--units=b", "--separator=<:SEP:>", "--nosuffix", "--noheadings",
-		"-o", "pv_name,pv_size,pv_used,pv_free,pv_fmt,pv_uuid", "--nameprefixes", "-a")
-	out, err := cmd.CombinedOutput()
+	all, err := listPV(ctx)
 	if err != nil {
 		return nil, err
 	}
-	outStr := strings.TrimSpace(string(out))
-	outLines := strings.Split(outStr, "\n")
-	pvs := make([]*parser.PV, len(outLines))
-	for i, line := range outLines {
-		line = strings.TrimSpace(line)
-		pv, err := parser.ParsePV(line)
-		if err != nil {
-			return nil, err
+	var pvs []*parser.PV
+	for _, pv := range all {
+		if isPV(pv) {
+			pvs = append(pvs, pv)
 		}
-		pvs[i] = pv
 	}
 	return pvs, nil
 }
 
-func Validate(ctx context.Context, block string) (bool, error) {
-	cmd := exec.Command("udevadm", "info", "--query=property", block)
-	out, err := cmd.CombinedOutput()
+// isPV tells a physical volume from the other devices reported by pvs -a,
+// which have neither a format nor an uuid
+func isPV(pv *parser.PV) bool {
+	return pv.Fmt != "" && pv.UUID != ""
+}
+
+// GetPV returns the physical volume on block, lvm reports it under its
+// canonical device name which may differ from block
+func GetPV(ctx context.Context, block string) (*parser.PV, error) {
+	pvs, err := listPV(ctx, block)
 	if err != nil {
-		return false, err
+		return nil, fmt.Errorf("failed to list PVs: %w", err)
 	}
-	out1Str := strings.TrimSpace(string(out))
-	if strings.Contains(out1Str, "ID_PART_TABLE") || strings.Contains(out1Str, "ID_FS_TYPE") {
-		return false, nil
+	// pvs -a reports a block which isn't a physical volume without a format
+	// or uuid rather than failing
+	if len(pvs) == 1 && !isPV(pvs[0]) {
+		return nil, newError(ErrNotFound, "block %s isn't a physical volume", block)
 	}
+	if len(pvs) != 1 {
+		return nil, fmt.Errorf("expected 1 PV, got %d", len(pvs))
+	}
+	return pvs[0], nil
+}
 
-	out, err = exec.Command("blkid").Output()
+func listPV(ctx context.Context, blocks ...string) ([]*parser.PV, error) {
+	out, err := runStdout(ctx, "pvs", reportArgs("pv_name,pv_size,pv_used,pv_free,pv_fmt,pv_uuid,vg_name,pv_pe_count,pv_pe_alloc_count,pv_attr,dev_size,pv_mda_count,pv_mda_size,pv_tags", append([]string{"-a"}, blocks...)...)...)
 	if err != nil {
-		return false, err
+		return nil, err
 	}
-	outputs := strings.Split(string(out), "\n")
-	for _, l := range outputs {
-		if !strings.Contains(l, block) {
-			continue
-		}
-		if strings.Contains(l, "PTTYPE=") || strings.Contains(l, "TYPE=") {
-			return false, nil
+	if reportFormat == ReportFormatJSON {
+		return parser.ParsePVReport(out)
+	}
+	outStr := strings.TrimSpace(out)
+	outLines := strings.Split(outStr, "\n")
+	pvs := make([]*parser.PV, len(outLines))
+	for i, line := range outLines {
+		line = strings.TrimSpace(line)
+		pv, err := parser.ParsePV(line)
+		if err != nil {
+			return nil, err
 		}
+		pvs[i] = pv
 	}
-	return true, nil
-}
-
-func Destory(ctx context.Context, block string) (string, error) {
-	cmd := exec.Command("wipefs", "-af", block)
-	out, err := cmd.CombinedOutput()
-	return string(out), err
+	return pvs, nil
 }
 
-func Match(ctx context.Context, block string) string {
-	cmd := exec.Command("pvs", "--noheadings", "--separator=#", "--nosuffix", block)
-	out, err := cmd.CombinedOutput()
-	if err != nil {
-		return ""
+// Match returns the volume group of the physical volume on block, it is
+// empty when block isn't a physical volume or not in a volume group
+func Match(ctx context.Context, block string) (string, error) {
+	pv, err := findPV(ctx, block)
+	if err != nil || pv == nil {
+		return "", err
 	}
-	outStr := strings.TrimSpace(string(out))
-	return strings.Split(outStr, "#")[1]
+	return pv.VGName, nil
 }
 
-func GetPVNum(ctx context.Context, name string) (string, error) {
-	cmd := exec.Command("vgs", "--noheadings", "--separator=#", "--nosuffix", name)
-	out, err := cmd.CombinedOutput()
+// GetPVNum returns the number of physical volumes in the volume group
+func GetPVNum(ctx context.Context, name string) (uint32, error) {
+	vg, err := GetVG(ctx, name)
 	if err != nil {
-		return "0", err
+		return 0, err
 	}
-	outStr := strings.TrimSpace(string(out))
-	return strings.Split(outStr, "#")[1], nil
+	return vg.PVCount, nil
 }
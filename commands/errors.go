@@ -0,0 +1,132 @@
+package commands
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ErrorKind classifies the failures of lvm commands
+type ErrorKind int
+
+const (
+	ErrUnknown ErrorKind = iota
+	ErrNotFound
+	ErrAlreadyExists
+	ErrInsufficientSpace
+	ErrProtected
+	ErrBusy
+	ErrInvalidArgument
+	ErrUnsupported
+)
+
+var errorKindNames = map[ErrorKind]string{
+	ErrUnknown:           "UNKNOWN",
+	ErrNotFound:          "NOT_FOUND",
+	ErrAlreadyExists:     "ALREADY_EXISTS",
+	ErrInsufficientSpace: "INSUFFICIENT_SPACE",
+	ErrProtected:         "PROTECTED",
+	ErrBusy:              "DEVICE_BUSY",
+	ErrInvalidArgument:   "INVALID_ARGUMENT",
+	ErrUnsupported:       "UNSUPPORTED",
+}
+
+func (k ErrorKind) String() string {
+	return errorKindNames[k]
+}
+
+// ExitError is returned by an Executor when the program exits with a non
+// zero status
+type ExitError struct {
+	Status int
+	Stderr string
+}
+
+func (e *ExitError) Error() string {
+	return fmt.Sprintf("exit status %d", e.Status)
+}
+
+// Error is returned when a command fails or a request is rejected before
+// any command is run, in the latter case Command is empty
+type Error struct {
+	Kind       ErrorKind
+	Command    string
+	ExitStatus int
+	Stderr     string
+	msg        string
+}
+
+func newError(kind ErrorKind, format string, args ...interface{}) *Error {
+	return &Error{Kind: kind, msg: fmt.Sprintf(format, args...)}
+}
+
+func (e *Error) Error() string {
+	if e.Command == "" {
+		return e.msg
+	}
+	return fmt.Sprintf("exit status %d", e.ExitStatus)
+}
+
+// lvm exits with EINVALID_CMD_LINE when it can't parse its arguments
+const lvmInvalidCmdLine = 3
+
+// errorPatterns maps fragments of lvm and util-linux error messages to the
+// kind of failure, the first matching pattern wins. The fragments are
+// those of whole messages, warnings printed before the error mention
+// devices in use or invalid metadata without the command failing for it
+var errorPatterns = []struct {
+	pattern string
+	kind    ErrorKind
+}{
+	{"insufficient free space", ErrInsufficientSpace},
+	{"insufficient suitable allocatable extents", ErrInsufficientSpace},
+	{"not enough free", ErrInsufficientSpace},
+	{"already exists", ErrAlreadyExists},
+	{"device or resource busy", ErrBusy},
+	{"exclusively", ErrBusy},
+	{"is already in volume group", ErrBusy},
+	{"without -ff", ErrBusy},
+	{"still in use", ErrBusy},
+	{"contains a filesystem in use", ErrBusy},
+	{"is used by vg", ErrBusy},
+	{"can't remove open logical volume", ErrBusy},
+	{"not found", ErrNotFound},
+	{"failed to find", ErrNotFound},
+	{"cannot process volume group", ErrNotFound},
+	{"no such file", ErrNotFound},
+	{"no such device", ErrNotFound},
+	{"no device found", ErrNotFound},
+	{"no pv found", ErrNotFound},
+	{"unknown device", ErrNotFound},
+	{"invalid argument for", ErrInvalidArgument},
+	{"unrecognised", ErrInvalidArgument},
+	{"unrecognized", ErrInvalidArgument},
+	{"matches existing size", ErrInvalidArgument},
+	{"operation not supported", ErrUnsupported},
+}
+
+// classify matches the patterns against the last message of the command
+// which isn't a warning, falling back on the exit status
+func classify(e *ExitError) ErrorKind {
+	msg := strings.ToLower(lastError(e.Stderr))
+	for _, p := range errorPatterns {
+		if strings.Contains(msg, p.pattern) {
+			return p.kind
+		}
+	}
+	if e.Status == lvmInvalidCmdLine {
+		return ErrInvalidArgument
+	}
+	return ErrUnknown
+}
+
+// lastError returns the last line of stderr which isn't empty or a warning
+func lastError(stderr string) string {
+	lines := strings.Split(stderr, "\n")
+	for i := len(lines) - 1; i >= 0; i-- {
+		line := strings.TrimSpace(lines[i])
+		if line != "" && !strings.HasPrefix(line, "WARNING:") {
+			return line
+		}
+	}
+	return ""
+}
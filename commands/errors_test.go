@@ -0,0 +1,75 @@
+package commands
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Error classification", func() {
+	It("should classify the messages of lvm and util-linux", func() {
+		samples := []struct {
+			status int
+			stderr string
+			kind   ErrorKind
+		}{
+			{5, "  Volume group \"k8s\" has insufficient free space (255 extents): 256 required.\n", ErrInsufficientSpace},
+			{5, "  Insufficient suitable allocatable extents for logical volume data: 10 more required\n", ErrInsufficientSpace},
+			{5, "  Logical Volume \"data\" already exists in volume group \"k8s\"\n", ErrAlreadyExists},
+			{5, "  A volume group called k8s already exists.\n", ErrAlreadyExists},
+			{5, "  Can't open /dev/sdb exclusively.  Mounted filesystem?\n", ErrBusy},
+			{5, "  Physical volume '/dev/sdb' is already in volume group 'k8s'\n", ErrBusy},
+			{5, "  Can't initialize physical volume \"/dev/sdb\" of volume group \"k8s\" without -ff\n", ErrBusy},
+			{5, "  Physical volume \"/dev/sdc\" still in use\n", ErrBusy},
+			{5, "  Logical volume k8s/data contains a filesystem in use.\n", ErrBusy},
+			{5, "  PV /dev/sdb is used by VG k8s so please use vgreduce first.\n", ErrBusy},
+			{5, "  Can't remove open logical volume \"data\"\n", ErrBusy},
+			{5, "  Volume group \"nope\" not found\n  Cannot process volume group nope\n", ErrNotFound},
+			{5, "  Failed to find logical volume \"k8s/nope\"\n", ErrNotFound},
+			{5, "  Device /dev/sdz not found.\n", ErrNotFound},
+			{5, "  No device found for /dev/sdz.\n", ErrNotFound},
+			{3, "  Invalid argument for --size: 10x\n  Error during parsing of command line.\n", ErrInvalidArgument},
+			{5, "  Unrecognised field: foo\n", ErrInvalidArgument},
+			{5, "  New size (256 extents) matches existing size (256 extents).\n", ErrInvalidArgument},
+			{1, "blkdiscard: /dev/k8s/data: BLKDISCARD ioctl failed: Operation not supported\n", ErrUnsupported},
+		}
+		for _, s := range samples {
+			Expect(classify(&ExitError{Status: s.status, Stderr: s.stderr})).To(Equal(s.kind), s.stderr)
+		}
+	})
+
+	It("should classify the error after the warnings", func() {
+		samples := []struct {
+			status int
+			stderr string
+			kind   ErrorKind
+		}{
+			{5, "  WARNING: PV /dev/sdc in VG k8s is using an old PV header, modify the VG to update.\n" +
+				"  Volume group \"k8s\" has insufficient free space (255 extents): 256 required.\n", ErrInsufficientSpace},
+			{5, "  WARNING: Not using device /dev/sdd for PV 3cVBkE-dIzn because device is in use by LV k8s/data.\n" +
+				"  Failed to find logical volume \"k8s/nope\"\n", ErrNotFound},
+			{5, "  WARNING: invalid metadata text from /dev/sdc at 4608.\n" +
+				"  Logical Volume \"data\" already exists in volume group \"k8s\"\n", ErrAlreadyExists},
+			{5, "  WARNING: Device /dev/sdc has size of 20971520 sectors which is smaller than corresponding PV size of 41943040 sectors. Was device resized?\n" +
+				"  Logical volume k8s/data contains a filesystem in use.\n", ErrBusy},
+		}
+		for _, s := range samples {
+			Expect(classify(&ExitError{Status: s.status, Stderr: s.stderr})).To(Equal(s.kind), s.stderr)
+		}
+	})
+
+	It("should not classify by words of unrelated messages", func() {
+		samples := []struct {
+			status int
+			stderr string
+			kind   ErrorKind
+		}{
+			{5, "  WARNING: Device /dev/sdc in use by LV k8s/data.\n  Failed to write VG k8s.\n", ErrUnknown},
+			{5, "  Can't remove final physical volume \"/dev/sdb\" from volume group \"k8s\"\n", ErrUnknown},
+			{5, "  Cannot change VG k8s while PVs are missing.\n  Consider vgreduce --removemissing.\n", ErrUnknown},
+			{3, "  Please specify a logical volume name.\n", ErrInvalidArgument},
+		}
+		for _, s := range samples {
+			Expect(classify(&ExitError{Status: s.status, Stderr: s.stderr})).To(Equal(s.kind), s.stderr)
+		}
+	})
+})
@@ -0,0 +1,131 @@
+package commands
+
+import (
+	"bytes"
+	"os"
+	"os/exec"
+	"syscall"
+	"time"
+
+	"golang.org/x/net/context"
+)
+
+// KillGracePeriod is how long a command is given to exit after SIGTERM once
+// its context is done, before the whole process group is killed
+var KillGracePeriod = 10 * time.Second
+
+// Executor runs the external lvm and block device tools used by this package
+type Executor interface {
+	// Run executes the named program with args and env added to its
+	// environment, and returns its stdout and stderr apart so warnings on
+	// stderr don't end up in the reports parsed from stdout. A non zero
+	// exit status is reported as *ExitError. When ctx is done before the
+	// program exits, the program is stopped and ctx.Err() is returned
+	Run(ctx context.Context, env []string, name string, args ...string) (stdout string, stderr string, err error)
+}
+
+type osExecutor struct{}
+
+func (e osExecutor) Run(ctx context.Context, env []string, name string, args ...string) (string, string, error) {
+	if err := ctx.Err(); err != nil {
+		return "", "", err
+	}
+
+	var stdout, stderr bytes.Buffer
+	cmd := exec.Command(name, args...)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if len(env) != 0 {
+		cmd.Env = append(os.Environ(), env...)
+	}
+	// run in a new process group, so helpers forked by the command are
+	// stopped together with it
+	cmd.SysProcAttr = &syscall.SysProcAttr{Setpgid: true}
+	if err := cmd.Start(); err != nil {
+		return "", "", err
+	}
+
+	done := make(chan error, 1)
+	go func() {
+		done <- cmd.Wait()
+	}()
+
+	select {
+	case err := <-done:
+		if exitErr, ok := err.(*exec.ExitError); ok {
+			err = &ExitError{Status: exitErr.ExitCode(), Stderr: stderr.String()}
+		}
+		return stdout.String(), stderr.String(), err
+	case <-ctx.Done():
+	}
+
+	pgid := -cmd.Process.Pid
+	syscall.Kill(pgid, syscall.SIGTERM)
+	timer := time.NewTimer(KillGracePeriod)
+	defer timer.Stop()
+	select {
+	case <-done:
+	case <-timer.C:
+		syscall.Kill(pgid, syscall.SIGKILL)
+		<-done
+	}
+	return stdout.String(), stderr.String(), ctx.Err()
+}
+
+var executor Executor = osExecutor{}
+
+// SetExecutor replaces the executor used by all functions in this package,
+// tests use it to run against a fake lvm backend
+func SetExecutor(e Executor) {
+	executor = e
+}
+
+// Observer is told about every command run by this package, err is the
+// error returned by the executor
+type Observer func(name string, duration time.Duration, err error)
+
+var observer Observer
+
+// SetObserver sets the observer of the commands run by this package, nil
+// stops observing them
+func SetObserver(o Observer) {
+	observer = o
+}
+
+// run executes a command with the package executor and returns its stdout
+// followed by its stderr as the log of the command, failures are returned
+// as *Error classified from the output of the command
+func run(ctx context.Context, name string, args ...string) (string, error) {
+	stdout, stderr, err := execute(ctx, nil, name, args...)
+	return stdout + stderr, err
+}
+
+// runStdout is run for the commands whose output is parsed, it returns
+// stdout only
+func runStdout(ctx context.Context, name string, args ...string) (string, error) {
+	stdout, _, err := execute(ctx, nil, name, args...)
+	return stdout, err
+}
+
+// runEnv is run with env added to the environment of the command
+func runEnv(ctx context.Context, env []string, name string, args ...string) (string, error) {
+	stdout, stderr, err := execute(ctx, env, name, args...)
+	return stdout + stderr, err
+}
+
+func execute(ctx context.Context, env []string, name string, args ...string) (string, string, error) {
+	start := time.Now()
+	stdout, stderr, err := executor.Run(ctx, env, name, args...)
+	if observer != nil {
+		observer(name, time.Since(start), err)
+	}
+	if exitErr, ok := err.(*ExitError); ok {
+		return stdout, stderr, &Error{
+			Kind:       classify(exitErr),
+			Command:    name,
+			ExitStatus: exitErr.Status,
+			Stderr:     exitErr.Stderr,
+		}
+	}
+	return stdout, stderr, err
+}
@@ -0,0 +1,63 @@
+package commands
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"golang.org/x/net/context"
+)
+
+func TestCommands(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Commands Suite")
+}
+
+var _ = Describe("OS executor", func() {
+	It("should return stdout and stderr apart", func() {
+		stdout, stderr, err := osExecutor{}.Run(context.Background(), nil, "sh", "-c", "echo out; echo err >&2")
+		Expect(err).To(BeNil())
+		Expect(stdout).To(Equal("out\n"))
+		Expect(stderr).To(Equal("err\n"))
+	})
+
+	It("should add env to the environment", func() {
+		stdout, _, err := osExecutor{}.Run(context.Background(), []string{"LVMD_TEST=value"}, "sh", "-c", "echo $LVMD_TEST $PATH")
+		Expect(err).To(BeNil())
+		Expect(stdout).To(HavePrefix("value /"))
+	})
+
+	It("should report the exit status and stderr of a failed command", func() {
+		stdout, _, err := osExecutor{}.Run(context.Background(), nil, "sh", "-c", "echo out; echo failed >&2; exit 5")
+		Expect(stdout).To(Equal("out\n"))
+		Expect(err).To(Equal(&ExitError{Status: 5, Stderr: "failed\n"}))
+	})
+
+	It("should stop the process group when the deadline is exceeded", func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+		defer cancel()
+
+		start := time.Now()
+		_, _, err := osExecutor{}.Run(ctx, nil, "sh", "-c", "sleep 30 & wait")
+		Expect(err).To(Equal(context.DeadlineExceeded))
+		Expect(time.Since(start)).To(BeNumerically("<", 5*time.Second))
+	})
+
+	It("should kill a process ignoring SIGTERM after the grace period", func() {
+		defer func(d time.Duration) { KillGracePeriod = d }(KillGracePeriod)
+		KillGracePeriod = 100 * time.Millisecond
+
+		ctx, cancel := context.WithCancel(context.Background())
+		time.AfterFunc(100*time.Millisecond, cancel)
+		_, _, err := osExecutor{}.Run(ctx, nil, "sh", "-c", "trap '' TERM; sleep 30 & wait")
+		Expect(err).To(Equal(context.Canceled))
+	})
+
+	It("should not start a command with a done context", func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		_, _, err := osExecutor{}.Run(ctx, nil, "true")
+		Expect(err).To(Equal(context.Canceled))
+	})
+})
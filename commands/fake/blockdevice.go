@@ -0,0 +1,167 @@
+package fake
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"syscall"
+)
+
+// lsblkDevice is a device in the json output of lsblk 2.33 and later, which
+// reports numbers and booleans as such and empty values as null
+type lsblkDevice struct {
+	Name       string         `json:"name"`
+	Path       string         `json:"path"`
+	Size       uint64         `json:"size"`
+	Type       string         `json:"type"`
+	Rota       bool           `json:"rota"`
+	RM         bool           `json:"rm"`
+	Model      *string        `json:"model"`
+	Serial     *string        `json:"serial"`
+	WWN        *string        `json:"wwn"`
+	Tran       *string        `json:"tran"`
+	PTType     *string        `json:"pttype"`
+	FSType     *string        `json:"fstype"`
+	Mountpoint *string        `json:"mountpoint"`
+	Children   []*lsblkDevice `json:"children,omitempty"`
+}
+
+func nullable(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
+
+// lsblk emulates lsblk --json --bytes, the volumes of a volume group are
+// reported as the children of each of its pvs
+func (l *LVM) lsblk(args []string) (string, error) {
+	o := parseOptions(args, "--output", "-o")
+	if !o.has("--json") || !o.has("--bytes") {
+		return fail(1, "lsblk: only --json --bytes is supported")
+	}
+
+	paths := o.args
+	if len(paths) == 0 {
+		for path := range l.blocks {
+			paths = append(paths, path)
+		}
+		sort.Strings(paths)
+	}
+	var devices []*lsblkDevice
+	for _, path := range paths {
+		b, ok := l.blocks[path]
+		if !ok {
+			return fail(32, "lsblk: %s: not a block device", path)
+		}
+		dev := &lsblkDevice{
+			Name:       filepath.Base(path),
+			Path:       path,
+			Size:       b.Size,
+			Type:       "disk",
+			Rota:       b.Rotational,
+			RM:         b.Removable,
+			Model:      nullable(b.Model),
+			Serial:     nullable(b.Serial),
+			WWN:        nullable(b.WWN),
+			Tran:       nullable(b.Transport),
+			Mountpoint: nullable(b.MountPoint),
+		}
+		for _, sig := range b.Signatures {
+			if strings.HasPrefix(sig, "PTTYPE=") {
+				dev.PTType = nullable(strings.TrimPrefix(sig, "PTTYPE="))
+			} else {
+				dev.FSType = nullable(sig)
+			}
+		}
+		if p, ok := l.pvs[path]; ok {
+			dev.FSType = nullable("LVM2_member")
+			for _, v := range l.vgLVs(p.vg) {
+				name := v.vg + "-" + v.name
+				dev.Children = append(dev.Children, &lsblkDevice{
+					Name:       name,
+					Path:       "/dev/mapper/" + name,
+					Size:       v.size,
+					Type:       "lvm",
+					FSType:     nullable(v.fsType),
+					Mountpoint: nullable(v.mountPoint),
+				})
+			}
+		}
+		devices = append(devices, dev)
+	}
+	data, _ := json.MarshalIndent(map[string][]*lsblkDevice{"blockdevices": devices}, "", "   ")
+	return string(data) + "\n", nil
+}
+
+// OpenExclusive implements commands.DeviceOpener, a device is busy while it
+// is open, mounted or holds active volumes
+func (l *LVM) OpenExclusive(path string) error {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	b, ok := l.blocks[path]
+	if !ok {
+		return &os.PathError{Op: "open", Path: path, Err: syscall.ENOENT}
+	}
+	busy := b.Open || b.MountPoint != ""
+	if p, ok := l.pvs[path]; ok && len(l.vgLVs(p.vg)) != 0 {
+		busy = true
+	}
+	if busy {
+		return &os.PathError{Op: "open", Path: path, Err: syscall.EBUSY}
+	}
+	return nil
+}
+
+// signatureOffsets are the offsets wipefs reports for the fake signatures
+var signatureOffsets = map[string]uint64{
+	"LVM2_member":       0x218,
+	"ext4":              0x438,
+	"swap":              0xff6,
+	"linux_raid_member": 0x1000,
+	"gpt":               0x200,
+	"dos":               0x1fe,
+}
+
+// listSignatures emulates wipefs --json --output OFFSET,TYPE,USAGE,UUID,LABEL,
+// which prints nothing for a device without signatures
+func (l *LVM) listSignatures(b *Block) string {
+	type signature struct {
+		Offset string  `json:"offset"`
+		Type   string  `json:"type"`
+		Usage  string  `json:"usage"`
+		UUID   *string `json:"uuid"`
+		Label  *string `json:"label"`
+	}
+	var signatures []signature
+	add := func(typ, usage string) {
+		signatures = append(signatures, signature{
+			Offset: fmt.Sprintf("0x%x", signatureOffsets[typ]),
+			Type:   typ,
+			Usage:  usage,
+		})
+	}
+	if _, ok := l.pvs[b.Path]; ok {
+		add("LVM2_member", "raid")
+	}
+	for _, sig := range b.Signatures {
+		switch {
+		case strings.HasPrefix(sig, "PTTYPE="):
+			add(strings.TrimPrefix(sig, "PTTYPE="), "partition table")
+		case sig == "LVM2_member" || sig == "linux_raid_member":
+			add(sig, "raid")
+		case sig == "swap":
+			add(sig, "other")
+		default:
+			add(sig, "filesystem")
+		}
+	}
+	if len(signatures) == 0 {
+		return ""
+	}
+	data, _ := json.MarshalIndent(map[string][]signature{"signatures": signatures}, "", "   ")
+	return string(data) + "\n"
+}
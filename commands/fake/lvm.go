@@ -0,0 +1,1131 @@
+// Package fake provides an in-memory lvm backend implementing
+// commands.Executor, it emulates the output and state changes of the lvm
+// tools invoked by the commands package so the daemon can be tested
+// without root privileges or real block devices.
+package fake
+
+import (
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"golang.org/x/net/context"
+
+	"github.com/zdnscloud/lvmd/commands"
+)
+
+const (
+	// ExtentSize is the physical extent size of every fake volume group
+	ExtentSize uint64 = 4 * 1024 * 1024
+	// MetadataSize is the space reserved for lvm metadata on every fake pv
+	MetadataSize uint64 = 1024 * 1024
+
+	dmMajor = 253
+)
+
+// Block is a block device known to the fake backend
+type Block struct {
+	Path string
+	Size uint64
+	// Signatures holds the filesystem or partition table signatures found
+	// on the device, e.g. "ext4" or "PTTYPE=gpt"
+	Signatures []string
+	// the device information reported by lsblk and udev, Links are the udev
+	// links relative to /dev like disk/by-id/wwn-0x5000c500a1b2c3d4
+	Rotational bool
+	Removable  bool
+	Model      string
+	Serial     string
+	WWN        string
+	Transport  string
+	Links      []string
+	MountPoint string
+	// Open is set while another process holds the device open exclusively
+	Open bool
+	// ReadOnly makes writes to the device fail, like a write protected disk
+	ReadOnly bool
+}
+
+type pv struct {
+	name string
+	uuid string
+	vg   string
+}
+
+type vg struct {
+	name string
+	uuid string
+	tags []string
+	pvs  []string
+}
+
+type lv struct {
+	vg     string
+	name   string
+	uuid   string
+	size   uint64
+	attr   []byte
+	tags   []string
+	pool   string
+	origin string
+	minor  int
+	// fsType and mountPoint describe the filesystem on the volume
+	fsType     string
+	mountPoint string
+	// settings of thin pools
+	metadataSize uint64
+	chunkSize    uint64
+	zero         bool
+	discards     string
+	// usage of thin pools and thin volumes, transactionID counts the changes
+	// to the thin volumes of a pool
+	dataPercent     float64
+	metadataPercent float64
+	transactionID   uint64
+}
+
+// LVM is an in-memory lvm backend, its zero value is not usable, use NewLVM
+type LVM struct {
+	lock      sync.Mutex
+	blocks    map[string]*Block
+	pvs       map[string]*pv
+	vgs       map[string]*vg
+	lvs       []*lv
+	nextID    int
+	nextMinor int
+	calls     [][]string
+	// legacyReports rejects json reports like lvm before 2.02.158
+	legacyReports bool
+	// env is the environment of the command being run
+	env map[string]string
+	// warning is printed to stderr by every lvm command
+	warning string
+}
+
+// NewLVM returns an empty fake lvm backend
+func NewLVM() *LVM {
+	return &LVM{
+		blocks: make(map[string]*Block),
+		pvs:    make(map[string]*pv),
+		vgs:    make(map[string]*vg),
+	}
+}
+
+// AddBlock registers a block device which can be used as a pv
+func (l *LVM) AddBlock(path string, size uint64, signatures ...string) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	l.blocks[path] = &Block{Path: path, Size: size, Signatures: signatures}
+}
+
+// AddBlockDevice registers a block device with its device information
+func (l *LVM) AddBlockDevice(b Block) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	l.blocks[b.Path] = &b
+}
+
+// Format puts a filesystem of fsType on the volume vg/name
+func (l *LVM) Format(vgName, name, fsType string) error {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	v := l.findLV(vgName, name)
+	if v == nil {
+		return fmt.Errorf("volume %s/%s not found", vgName, name)
+	}
+	v.fsType = fsType
+	return nil
+}
+
+// Mount mounts the filesystem on the volume vg/name at target
+func (l *LVM) Mount(vgName, name, target string) error {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	v := l.findLV(vgName, name)
+	if v == nil {
+		return fmt.Errorf("volume %s/%s not found", vgName, name)
+	}
+	if v.fsType == "" {
+		return fmt.Errorf("volume %s/%s has no filesystem", vgName, name)
+	}
+	v.mountPoint = target
+	return nil
+}
+
+// SetPoolUsage sets the data and metadata usage in percent of the thin pool
+// vg/pool
+func (l *LVM) SetPoolUsage(vgName, pool string, data, metadata float64) error {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	v := l.findLV(vgName, pool)
+	if v == nil || v.attr[0] != 't' {
+		return fmt.Errorf("thin pool %s/%s not found", vgName, pool)
+	}
+	v.dataPercent = data
+	v.metadataPercent = metadata
+	return nil
+}
+
+// SetLegacyReports makes the reporting commands reject --reportformat like
+// lvm before 2.02.158
+func (l *LVM) SetLegacyReports(legacy bool) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	l.legacyReports = legacy
+}
+
+// SetWarning makes every lvm command print warning to stderr, like the
+// warnings about lvmetad or duplicate pvs, empty stops the warnings
+func (l *LVM) SetWarning(warning string) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	l.warning = warning
+}
+
+// Calls returns every command executed so far, each one starts with the
+// program name
+func (l *LVM) Calls() [][]string {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	calls := make([][]string, len(l.calls))
+	copy(calls, l.calls)
+	return calls
+}
+
+// Run implements commands.Executor
+func (l *LVM) Run(ctx context.Context, env []string, name string, args ...string) (string, string, error) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	l.calls = append(l.calls, append([]string{name}, args...))
+	if err := ctx.Err(); err != nil {
+		return "", "", err
+	}
+	l.env = parseEnv(env)
+	defer func() { l.env = nil }()
+
+	var stderr string
+	if l.warning != "" && isLVMCommand(name) {
+		stderr = "  WARNING: " + l.warning + "\n"
+	}
+	out, err := l.run(name, args)
+	if exitErr, ok := err.(*commands.ExitError); ok {
+		stderr += exitErr.Stderr
+	}
+	return out, stderr, err
+}
+
+func parseEnv(env []string) map[string]string {
+	vars := make(map[string]string)
+	for _, v := range env {
+		if idx := strings.Index(v, "="); idx > 0 {
+			vars[v[:idx]] = v[idx+1:]
+		}
+	}
+	return vars
+}
+
+func isLVMCommand(name string) bool {
+	return strings.HasPrefix(name, "lv") || strings.HasPrefix(name, "vg") || strings.HasPrefix(name, "pv")
+}
+
+func (l *LVM) run(name string, args []string) (string, error) {
+	switch name {
+	case "lvs", "vgs", "pvs":
+		if l.legacyReports && parseOptions(args, "--reportformat").has("--reportformat") {
+			return fail(3, "%s: unrecognized option '--reportformat'", name)
+		}
+	}
+	switch name {
+	case "lvs":
+		return l.lvsCmd(args)
+	case "vgs":
+		return l.vgsCmd(args)
+	case "pvs":
+		return l.pvsCmd(args)
+	case "lvcreate":
+		return l.lvcreate(args)
+	case "lvremove":
+		return l.lvremove(args)
+	case "lvresize":
+		return l.lvresize(args)
+	case "lvchange":
+		return l.lvchange(args)
+	case "vgcreate":
+		return l.vgcreate(args)
+	case "vgextend":
+		return l.vgextend(args)
+	case "vgreduce":
+		return l.vgreduce(args)
+	case "vgremove":
+		return l.vgremove(args)
+	case "pvcreate":
+		return l.pvcreate(args)
+	case "pvremove":
+		return l.pvremove(args)
+	case "udevadm":
+		return l.udevadm(args)
+	case "blkid":
+		return l.blkid(args)
+	case "lsblk":
+		return l.lsblk(args)
+	case "wipefs":
+		return l.wipefs(args)
+	case "lvconvert":
+		return l.lvconvert(args)
+	case "findmnt":
+		return l.findmnt(args)
+	case "xfs_growfs":
+		return l.growfs(name, args)
+	case "btrfs":
+		if len(args) < 3 || args[0] != "filesystem" || args[1] != "resize" {
+			return fail(1, "btrfs: unknown command")
+		}
+		return l.growfs(name, args[2:])
+	case "blkdiscard":
+		return l.blkdiscard(args)
+	case "e2fsck", "resize2fs":
+		return "", nil
+	default:
+		return fail(127, "%s: command not found", name)
+	}
+}
+
+func fail(status int, format string, args ...interface{}) (string, error) {
+	msg := "  " + fmt.Sprintf(format, args...) + "\n"
+	return "", &commands.ExitError{Status: status, Stderr: msg}
+}
+
+type options struct {
+	flags map[string][]string
+	args  []string
+}
+
+func (o options) has(flag string) bool {
+	_, ok := o.flags[flag]
+	return ok
+}
+
+func (o options) get(flag string) string {
+	values := o.flags[flag]
+	if len(values) == 0 {
+		return ""
+	}
+	return values[len(values)-1]
+}
+
+// parseOptions splits args into flags and positional arguments, withValue
+// lists the flags which consume the following argument
+func parseOptions(args []string, withValue ...string) options {
+	takesValue := make(map[string]bool)
+	for _, f := range withValue {
+		takesValue[f] = true
+	}
+
+	o := options{flags: make(map[string][]string)}
+	for i := 0; i < len(args); i++ {
+		arg := args[i]
+		if !strings.HasPrefix(arg, "-") || arg == "-" {
+			o.args = append(o.args, arg)
+			continue
+		}
+		if idx := strings.Index(arg, "="); idx != -1 && strings.HasPrefix(arg, "--") {
+			o.flags[arg[:idx]] = append(o.flags[arg[:idx]], arg[idx+1:])
+			continue
+		}
+		if takesValue[arg] && i+1 < len(args) {
+			o.flags[arg] = append(o.flags[arg], args[i+1])
+			i++
+			continue
+		}
+		o.flags[arg] = append(o.flags[arg], "")
+	}
+	return o
+}
+
+func (l *LVM) newUUID() string {
+	l.nextID++
+	return fmt.Sprintf("fake-%06d", l.nextID)
+}
+
+// lvByDevice finds the volume of a device path like /dev/vg/name
+func (l *LVM) lvByDevice(device string) *lv {
+	return l.findLV(filepath.Base(filepath.Dir(device)), filepath.Base(device))
+}
+
+// blkdiscard emulates discarding a volume given by its device path, the
+// thin volumes of a pool ignoring discards don't support them
+func (l *LVM) blkdiscard(args []string) (string, error) {
+	if len(args) == 0 {
+		return fail(1, "blkdiscard: no device specified")
+	}
+	path := args[len(args)-1]
+	v := l.findLV(filepath.Base(filepath.Dir(path)), filepath.Base(path))
+	if v == nil {
+		return fail(1, "blkdiscard: cannot open %s: No such file or directory", path)
+	}
+	if pool := l.findLV(v.vg, v.pool); pool != nil && pool.discards == "ignore" {
+		return fail(1, "blkdiscard: %s: BLKDISCARD ioctl failed: Operation not supported", path)
+	}
+	return "", nil
+}
+
+func (l *LVM) findLV(vgName, name string) *lv {
+	for _, v := range l.lvs {
+		if v.vg == vgName && v.name == name {
+			return v
+		}
+	}
+	return nil
+}
+
+func (l *LVM) vgLVs(vgName string) []*lv {
+	var lvs []*lv
+	for _, v := range l.lvs {
+		if v.vg == vgName {
+			lvs = append(lvs, v)
+		}
+	}
+	return lvs
+}
+
+func (l *LVM) pvSize(name string) uint64 {
+	b, ok := l.blocks[name]
+	if !ok || b.Size < MetadataSize {
+		return 0
+	}
+	return (b.Size - MetadataSize) / ExtentSize * ExtentSize
+}
+
+func (l *LVM) vgSize(v *vg) uint64 {
+	var size uint64
+	for _, name := range v.pvs {
+		size += l.pvSize(name)
+	}
+	return size
+}
+
+// vgUsed returns the space allocated to lvs, thin volumes take space from
+// their pool rather than from the vg. Thin pools take space for their
+// metadata and the vg keeps a spare metadata volume as large as the
+// largest one
+func (l *LVM) vgUsed(v *vg) uint64 {
+	var used uint64
+	for _, lv := range l.vgLVs(v.name) {
+		if lv.pool == "" {
+			used += lv.size + lv.metadataSize
+		}
+	}
+	return used + l.metadataSpare(v)
+}
+
+func (l *LVM) metadataSpare(v *vg) uint64 {
+	var spare uint64
+	for _, lv := range l.vgLVs(v.name) {
+		if lv.metadataSize > spare {
+			spare = lv.metadataSize
+		}
+	}
+	return spare
+}
+
+func (l *LVM) vgFree(v *vg) uint64 {
+	return l.vgSize(v) - l.vgUsed(v)
+}
+
+// pvUsed spreads the vg allocation over its pvs in order
+func (l *LVM) pvUsed(p *pv) uint64 {
+	v, ok := l.vgs[p.vg]
+	if !ok {
+		return 0
+	}
+	used := l.vgUsed(v)
+	for _, name := range v.pvs {
+		size := l.pvSize(name)
+		if name == p.name {
+			if used > size {
+				return size
+			}
+			return used
+		}
+		if used > size {
+			used -= size
+		} else {
+			used = 0
+		}
+	}
+	return 0
+}
+
+func roundUp(size uint64) uint64 {
+	return (size + ExtentSize - 1) / ExtentSize * ExtentSize
+}
+
+var sizeUnits = map[string]uint64{
+	"b": 1,
+	"k": 1 << 10,
+	"m": 1 << 20,
+	"g": 1 << 30,
+	"t": 1 << 40,
+}
+
+// parseSize parses a size argument like "1024b", "10g" or "+4m", megabytes
+// are assumed when there is no unit
+func parseSize(arg string) (uint64, bool, error) {
+	relative := strings.HasPrefix(arg, "+")
+	arg = strings.TrimPrefix(arg, "+")
+	unit := sizeUnits["m"]
+	if n := len(arg); n > 0 {
+		if u, ok := sizeUnits[strings.ToLower(arg[n-1:])]; ok {
+			unit = u
+			arg = arg[:n-1]
+		}
+	}
+	n, err := strconv.ParseUint(arg, 10, 64)
+	if err != nil {
+		return 0, false, fmt.Errorf("invalid size %q", arg)
+	}
+	return n * unit, relative, nil
+}
+
+func splitLVPath(path string) (string, string) {
+	path = strings.TrimPrefix(path, "/dev/")
+	if idx := strings.Index(path, "/"); idx != -1 {
+		return path[:idx], path[idx+1:]
+	}
+	return path, ""
+}
+
+func hasTag(tags []string, tag string) bool {
+	for _, t := range tags {
+		if t == tag {
+			return true
+		}
+	}
+	return false
+}
+
+func removeTag(tags []string, tag string) []string {
+	var res []string
+	for _, t := range tags {
+		if t != tag {
+			res = append(res, t)
+		}
+	}
+	return res
+}
+
+func (l *LVM) lvcreate(args []string) (string, error) {
+	o := parseOptions(args, "-n", "-L", "-V", "-l", "-m", "--thinpool", "--add-tag",
+		"--poolmetadatasize", "--chunksize", "-Z", "--discards")
+	if len(o.args) != 1 {
+		return fail(3, "Please specify a volume group")
+	}
+	vgName, poolName := splitLVPath(o.args[0])
+	v, ok := l.vgs[vgName]
+	if !ok {
+		return fail(5, "Volume group \"%s\" not found", vgName)
+	}
+
+	name := o.get("-n")
+	if pool := o.get("--thinpool"); pool != "" {
+		name = pool
+	}
+	if name == "" {
+		return fail(3, "Please specify a logical volume name")
+	}
+	if l.findLV(vgName, name) != nil {
+		return fail(5, "Logical Volume \"%s\" already exists in volume group \"%s\"", name, vgName)
+	}
+
+	newLV := &lv{
+		vg:    vgName,
+		name:  name,
+		uuid:  l.newUUID(),
+		attr:  []byte("-wi-a-----"),
+		tags:  o.flags["--add-tag"],
+		minor: l.nextMinor,
+	}
+
+	switch {
+	case o.has("-s"):
+		origin := l.findLV(vgName, poolName)
+		if origin == nil {
+			return fail(5, "Failed to find logical volume \"%s/%s\"", vgName, poolName)
+		}
+		newLV.origin = origin.name
+		if origin.pool != "" {
+			if o.has("-L") {
+				return fail(3, "Snapshot of thin volume can't have a size")
+			}
+			newLV.size = origin.size
+			newLV.pool = origin.pool
+			newLV.attr = []byte("Vwi---tz-k")
+			l.findLV(vgName, origin.pool).transactionID++
+			break
+		}
+		size, _, err := parseSize(o.get("-L"))
+		if err != nil {
+			return fail(3, "%v", err)
+		}
+		size = roundUp(size)
+		if size > l.vgFree(v) {
+			return fail(5, "Volume group \"%s\" has insufficient free space (%d extents): %d required.",
+				vgName, l.vgFree(v)/ExtentSize, size/ExtentSize)
+		}
+		newLV.size = size
+		newLV.attr = []byte("swi-a-s---")
+		origin.attr[0] = 'o'
+	case o.has("--thin") || o.has("-V"):
+		if poolName == "" {
+			return fail(3, "Please specify a thin pool")
+		}
+		pool := l.findLV(vgName, poolName)
+		if pool == nil || pool.attr[0] != 't' {
+			return fail(5, "Thin pool %s/%s not found", vgName, poolName)
+		}
+		size, _, err := parseSize(o.get("-V"))
+		if err != nil {
+			return fail(3, "%v", err)
+		}
+		newLV.size = roundUp(size)
+		newLV.pool = poolName
+		newLV.attr = []byte("Vwi-a-tz--")
+		pool.transactionID++
+	default:
+		// space taken besides the data, for the metadata of thin pools
+		var overhead uint64
+		if o.has("--thinpool") {
+			if out, err := l.setPoolOptions(newLV, o); err != nil {
+				return out, err
+			}
+			overhead = newLV.metadataSize
+			if spare := l.metadataSpare(v); newLV.metadataSize > spare {
+				overhead += newLV.metadataSize - spare
+			}
+		}
+
+		var size uint64
+		if extents := o.get("-l"); extents != "" {
+			percent, err := strconv.ParseUint(strings.TrimSuffix(extents, "%FREE"), 10, 32)
+			if err != nil || !strings.HasSuffix(extents, "%FREE") || percent > 100 {
+				return fail(3, "Unsupported extents argument %s", extents)
+			}
+			size = l.vgFree(v) * percent / 100 / ExtentSize * ExtentSize
+			// lvm leaves room for the metadata of a pool taking all space
+			if l.vgFree(v) >= overhead && size > l.vgFree(v)-overhead {
+				size = l.vgFree(v) - overhead
+			}
+		} else {
+			s, _, err := parseSize(o.get("-L"))
+			if err != nil {
+				return fail(3, "%v", err)
+			}
+			size = roundUp(s)
+		}
+		if size == 0 || size+overhead > l.vgFree(v) {
+			return fail(5, "Volume group \"%s\" has insufficient free space (%d extents): %d required.",
+				vgName, l.vgFree(v)/ExtentSize, (size+overhead)/ExtentSize)
+		}
+		newLV.size = size
+	}
+
+	l.nextMinor++
+	l.lvs = append(l.lvs, newLV)
+	return fmt.Sprintf("  Logical volume \"%s\" created.\n", name), nil
+}
+
+// defaultMetadataSize is the metadata size of a thin pool when it isn't
+// given, lvm's minimum of 2MiB rounded up to the extent size
+const defaultMetadataSize = ExtentSize
+
+func (l *LVM) setPoolOptions(pool *lv, o options) (string, error) {
+	pool.attr = []byte("twi-a-tz--")
+	pool.metadataSize = defaultMetadataSize
+	pool.chunkSize = 64 * 1024
+	pool.zero = true
+	pool.discards = "passdown"
+	if arg := o.get("--poolmetadatasize"); arg != "" {
+		size, _, err := parseSize(arg)
+		if err != nil {
+			return fail(3, "%v", err)
+		}
+		pool.metadataSize = roundUp(size)
+	}
+	if arg := o.get("--chunksize"); arg != "" {
+		size, _, err := parseSize(arg)
+		if err != nil || size%(64*1024) != 0 {
+			return fail(3, "Chunk size must be a multiple of 64KiB")
+		}
+		pool.chunkSize = size
+	}
+	switch o.get("-Z") {
+	case "n":
+		pool.zero = false
+		pool.attr[7] = '-'
+	case "", "y":
+	default:
+		return fail(3, "Invalid argument for --zero")
+	}
+	switch arg := o.get("--discards"); arg {
+	case "":
+	case "passdown", "nopassdown", "ignore":
+		pool.discards = arg
+	default:
+		return fail(3, "Invalid argument for --discards: %s", arg)
+	}
+	return "", nil
+}
+
+func (l *LVM) lvremove(args []string) (string, error) {
+	o := parseOptions(args)
+	if len(o.args) != 1 {
+		return fail(3, "Please enter one or more logical volume paths")
+	}
+	vgName, name := splitLVPath(o.args[0])
+	target := l.findLV(vgName, name)
+	if target == nil {
+		return fail(5, "Failed to find logical volume \"%s/%s\"", vgName, name)
+	}
+	for _, v := range l.lvs {
+		if v.vg == vgName && v.pool == name {
+			return fail(5, "Removing pool \"%s\" will remove %d dependent volume(s). Proceed? [y/n]: n", name, 1)
+		}
+	}
+	if target.pool != "" {
+		l.findLV(vgName, target.pool).transactionID++
+	}
+	l.deleteLV(target)
+	return fmt.Sprintf("  Logical volume \"%s\" successfully removed\n", name), nil
+}
+
+func (l *LVM) lvconvert(args []string) (string, error) {
+	o := parseOptions(args)
+	if !o.has("--merge") || len(o.args) != 1 {
+		return fail(3, "Unsupported lvconvert arguments")
+	}
+	vgName, name := splitLVPath(o.args[0])
+	snapshot := l.findLV(vgName, name)
+	if snapshot == nil {
+		return fail(5, "Failed to find logical volume \"%s/%s\"", vgName, name)
+	}
+	if snapshot.origin == "" {
+		return fail(5, "\"%s/%s\" is not a mergeable logical volume.", vgName, name)
+	}
+
+	// the merged origin keeps its identity while the snapshot disappears
+	origin := l.findLV(vgName, snapshot.origin)
+	l.deleteLV(snapshot)
+	if origin.attr[0] == 'o' && len(l.snapshots(origin)) == 0 {
+		origin.attr[0] = '-'
+	}
+	return fmt.Sprintf("  Merging of volume %s/%s started.\n", vgName, name), nil
+}
+
+func (l *LVM) snapshots(origin *lv) []*lv {
+	var snapshots []*lv
+	for _, v := range l.vgLVs(origin.vg) {
+		if v.origin == origin.name {
+			snapshots = append(snapshots, v)
+		}
+	}
+	return snapshots
+}
+
+func (l *LVM) deleteLV(target *lv) {
+	for i, v := range l.lvs {
+		if v == target {
+			l.lvs = append(l.lvs[:i], l.lvs[i+1:]...)
+			return
+		}
+	}
+}
+
+func (l *LVM) lvresize(args []string) (string, error) {
+	o := parseOptions(args, "-L", "--poolmetadatasize")
+	if len(o.args) != 1 {
+		return fail(3, "Please provide a logical volume path")
+	}
+	vgName, name := splitLVPath(o.args[0])
+	target := l.findLV(vgName, name)
+	if target == nil {
+		return fail(5, "Failed to find logical volume \"%s/%s\"", vgName, name)
+	}
+	if o.has("--poolmetadatasize") {
+		return l.resizeMetadata(target, o.get("--poolmetadatasize"))
+	}
+	size, relative, err := parseSize(o.get("-L"))
+	if err != nil {
+		return fail(3, "%v", err)
+	}
+	if relative {
+		size += target.size
+	}
+	size = roundUp(size)
+	if size < target.size && !o.has("-f") {
+		return fail(5, "Do you really want to reduce %s/%s? [y/n]: [n]", vgName, name)
+	}
+	if size == target.size {
+		return fail(5, "New size (%d extents) matches existing size (%d extents).", size/ExtentSize, size/ExtentSize)
+	}
+	if target.pool == "" && size > target.size && size-target.size > l.vgFree(l.vgs[vgName]) {
+		return fail(5, "Insufficient free space: %d extents needed, but only %d available",
+			(size-target.size)/ExtentSize, l.vgFree(l.vgs[vgName])/ExtentSize)
+	}
+	// the used chunks of a pool take a smaller share of the larger pool
+	target.dataPercent = target.dataPercent * float64(target.size) / float64(size)
+	target.size = size
+	return fmt.Sprintf("  Logical volume %s/%s successfully resized.\n", vgName, name), nil
+}
+
+// resizeMetadata grows the metadata of a thin pool, the spare metadata
+// volume grows along when the metadata becomes the largest of the vg
+func (l *LVM) resizeMetadata(pool *lv, arg string) (string, error) {
+	if pool.attr[0] != 't' {
+		return fail(5, "Logical volume %s/%s is not a thin pool", pool.vg, pool.name)
+	}
+	size, relative, err := parseSize(arg)
+	if err != nil {
+		return fail(3, "%v", err)
+	}
+	if relative {
+		size += pool.metadataSize
+	}
+	size = roundUp(size)
+	if size <= pool.metadataSize {
+		return fail(5, "Thin pool metadata size can only be increased")
+	}
+	v := l.vgs[pool.vg]
+	required := size - pool.metadataSize
+	if spare := l.metadataSpare(v); size > spare {
+		required += size - spare
+	}
+	if required > l.vgFree(v) {
+		return fail(5, "Insufficient free space: %d extents needed, but only %d available",
+			required/ExtentSize, l.vgFree(v)/ExtentSize)
+	}
+	pool.metadataPercent = pool.metadataPercent * float64(pool.metadataSize) / float64(size)
+	pool.metadataSize = size
+	return fmt.Sprintf("  Size of logical volume %s/%s_tmeta changed.\n", pool.vg, pool.name), nil
+}
+
+func (l *LVM) lvchange(args []string) (string, error) {
+	o := parseOptions(args, "-a", "--addtag", "--deltag")
+	if len(o.args) != 1 {
+		return fail(3, "Please give logical volume path(s)")
+	}
+	vgName, name := splitLVPath(o.args[0])
+	target := l.findLV(vgName, name)
+	if target == nil {
+		return fail(5, "Failed to find logical volume \"%s/%s\"", vgName, name)
+	}
+	switch o.get("-a") {
+	case "y":
+		target.attr[4] = 'a'
+	case "n":
+		target.attr[4] = '-'
+	}
+	for _, tag := range o.flags["--addtag"] {
+		if !hasTag(target.tags, tag) {
+			target.tags = append(target.tags, tag)
+		}
+	}
+	for _, tag := range o.flags["--deltag"] {
+		target.tags = removeTag(target.tags, tag)
+	}
+	return fmt.Sprintf("  Logical volume %s/%s changed.\n", vgName, name), nil
+}
+
+func (l *LVM) vgcreate(args []string) (string, error) {
+	o := parseOptions(args, "--add-tag")
+	if len(o.args) < 2 {
+		return fail(3, "Please provide volume group name and physical volumes")
+	}
+	name := o.args[0]
+	if _, ok := l.vgs[name]; ok {
+		return fail(5, "A volume group called %s already exists.", name)
+	}
+	for _, pvName := range o.args[1:] {
+		if out, err := l.checkFreePV(pvName); err != nil {
+			return out, err
+		}
+	}
+	v := &vg{name: name, uuid: l.newUUID(), tags: o.flags["--add-tag"]}
+	for _, pvName := range o.args[1:] {
+		l.addPVToVG(v, pvName)
+	}
+	l.vgs[name] = v
+	return fmt.Sprintf("  Volume group \"%s\" successfully created\n", name), nil
+}
+
+// checkFreePV verifies a device is usable for a vg, it's initialized
+// implicitly when it isn't a pv yet
+func (l *LVM) checkFreePV(name string) (string, error) {
+	if _, ok := l.blocks[name]; !ok {
+		return fail(5, "Device %s not found.", name)
+	}
+	if p, ok := l.pvs[name]; ok && p.vg != "" {
+		return fail(5, "Physical volume '%s' is already in volume group '%s'", name, p.vg)
+	}
+	return "", nil
+}
+
+func (l *LVM) addPVToVG(v *vg, name string) {
+	p, ok := l.pvs[name]
+	if !ok {
+		p = &pv{name: name, uuid: l.newUUID()}
+		l.pvs[name] = p
+	}
+	p.vg = v.name
+	v.pvs = append(v.pvs, name)
+}
+
+func (l *LVM) vgextend(args []string) (string, error) {
+	o := parseOptions(args)
+	if len(o.args) < 2 {
+		return fail(3, "Please enter volume group name and physical volume(s)")
+	}
+	v, ok := l.vgs[o.args[0]]
+	if !ok {
+		return fail(5, "Volume group \"%s\" not found", o.args[0])
+	}
+	for _, pvName := range o.args[1:] {
+		if out, err := l.checkFreePV(pvName); err != nil {
+			return out, err
+		}
+	}
+	for _, pvName := range o.args[1:] {
+		l.addPVToVG(v, pvName)
+	}
+	return fmt.Sprintf("  Volume group \"%s\" successfully extended\n", v.name), nil
+}
+
+func (l *LVM) vgreduce(args []string) (string, error) {
+	o := parseOptions(args)
+	if len(o.args) != 2 {
+		return fail(3, "Please enter volume group name and physical volume")
+	}
+	v, ok := l.vgs[o.args[0]]
+	if !ok {
+		return fail(5, "Volume group \"%s\" not found", o.args[0])
+	}
+	p, ok := l.pvs[o.args[1]]
+	if !ok || p.vg != v.name {
+		return fail(5, "Physical Volume \"%s\" not found in Volume Group \"%s\".", o.args[1], v.name)
+	}
+	if len(v.pvs) == 1 {
+		return fail(5, "Can't remove final physical volume \"%s\" from volume group \"%s\"", p.name, v.name)
+	}
+	var pvs []string
+	for _, name := range v.pvs {
+		if name != p.name {
+			pvs = append(pvs, name)
+		}
+	}
+	if l.vgUsed(v) > l.vgSize(v)-l.pvSize(p.name) {
+		return fail(5, "Physical volume \"%s\" still in use", p.name)
+	}
+	v.pvs = pvs
+	p.vg = ""
+	return fmt.Sprintf("  Removed \"%s\" from volume group \"%s\"\n", p.name, v.name), nil
+}
+
+func (l *LVM) vgremove(args []string) (string, error) {
+	o := parseOptions(args)
+	if len(o.args) != 1 {
+		return fail(3, "Please enter one or more volume group paths")
+	}
+	v, ok := l.vgs[o.args[0]]
+	if !ok {
+		return fail(5, "Volume group \"%s\" not found", o.args[0])
+	}
+	var lvs []*lv
+	for _, lv := range l.lvs {
+		if lv.vg != v.name {
+			lvs = append(lvs, lv)
+		}
+	}
+	l.lvs = lvs
+	for _, name := range v.pvs {
+		l.pvs[name].vg = ""
+	}
+	delete(l.vgs, v.name)
+	return fmt.Sprintf("  Volume group \"%s\" successfully removed\n", v.name), nil
+}
+
+func (l *LVM) pvcreate(args []string) (string, error) {
+	o := parseOptions(args)
+	if len(o.args) != 1 {
+		return fail(3, "Please enter a physical volume path")
+	}
+	name := o.args[0]
+	b, ok := l.blocks[name]
+	if !ok {
+		return fail(5, "Device %s not found.", name)
+	}
+	if p, ok := l.pvs[name]; ok && p.vg != "" {
+		return fail(5, "Can't initialize physical volume \"%s\" of volume group \"%s\" without -ff", name, p.vg)
+	}
+	b.Signatures = nil
+	l.pvs[name] = &pv{name: name, uuid: l.newUUID()}
+	return fmt.Sprintf("  Physical volume \"%s\" successfully created.\n", name), nil
+}
+
+func (l *LVM) pvremove(args []string) (string, error) {
+	o := parseOptions(args)
+	if len(o.args) != 1 {
+		return fail(3, "Please enter a physical volume path")
+	}
+	name := o.args[0]
+	p, ok := l.pvs[name]
+	if !ok {
+		return fail(5, "No PV found on device %s.", name)
+	}
+	if p.vg != "" {
+		return fail(5, "PV %s is used by VG %s so please use vgreduce first.", name, p.vg)
+	}
+	delete(l.pvs, name)
+	return fmt.Sprintf("  Labels on physical volume \"%s\" successfully wiped.\n", name), nil
+}
+
+func (l *LVM) udevadm(args []string) (string, error) {
+	o := parseOptions(args)
+	if len(o.args) != 2 || o.args[0] != "info" {
+		return fail(1, "Unknown command")
+	}
+	if o.get("--query") == "symlink" {
+		for _, v := range l.lvs {
+			if o.args[1] == "/dev/mapper/"+v.vg+"-"+v.name {
+				return v.vg + "/" + v.name + "\n", nil
+			}
+		}
+	}
+	b, ok := l.blocks[o.args[1]]
+	if !ok {
+		return fail(4, "Unknown device \"%s\": No such device", o.args[1])
+	}
+	if o.get("--query") == "symlink" {
+		return strings.Join(b.Links, " ") + "\n", nil
+	}
+	lines := []string{"DEVNAME=" + b.Path, "SUBSYSTEM=block", "DEVTYPE=disk"}
+	if _, ok := l.pvs[b.Path]; ok {
+		lines = append(lines, "ID_FS_TYPE=LVM2_member")
+	}
+	for _, sig := range b.Signatures {
+		if strings.HasPrefix(sig, "PTTYPE=") {
+			lines = append(lines, "ID_PART_TABLE_TYPE="+strings.TrimPrefix(sig, "PTTYPE="))
+		} else {
+			lines = append(lines, "ID_FS_TYPE="+sig)
+		}
+	}
+	return strings.Join(lines, "\n") + "\n", nil
+}
+
+func (l *LVM) blkid(args []string) (string, error) {
+	o := parseOptions(args, "-s", "-o")
+	if len(o.args) == 1 {
+		return l.probe(o.args[0])
+	}
+	var paths []string
+	for path := range l.blocks {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+	var out strings.Builder
+	for _, path := range paths {
+		var attrs []string
+		if _, ok := l.pvs[path]; ok {
+			attrs = append(attrs, `TYPE="LVM2_member"`)
+		}
+		for _, sig := range l.blocks[path].Signatures {
+			if strings.HasPrefix(sig, "PTTYPE=") {
+				attrs = append(attrs, fmt.Sprintf(`PTTYPE="%s"`, strings.TrimPrefix(sig, "PTTYPE=")))
+			} else {
+				attrs = append(attrs, fmt.Sprintf(`TYPE="%s"`, sig))
+			}
+		}
+		if len(attrs) > 0 {
+			fmt.Fprintf(&out, "%s: %s\n", path, strings.Join(attrs, " "))
+		}
+	}
+	return out.String(), nil
+}
+
+// probe emulates blkid -p -s TYPE -o value on a single device
+func (l *LVM) probe(device string) (string, error) {
+	var fsType string
+	if v := l.lvByDevice(device); v != nil {
+		fsType = v.fsType
+	} else if b, ok := l.blocks[device]; ok {
+		if _, ok := l.pvs[device]; ok {
+			fsType = "LVM2_member"
+		}
+		for _, sig := range b.Signatures {
+			if !strings.HasPrefix(sig, "PTTYPE=") {
+				fsType = sig
+			}
+		}
+	} else {
+		return fail(8, "error: %s: No such file or directory", device)
+	}
+	if fsType == "" {
+		return "", &commands.ExitError{Status: 2}
+	}
+	return fsType + "\n", nil
+}
+
+func (l *LVM) findmnt(args []string) (string, error) {
+	o := parseOptions(args, "-o", "--source")
+	if v := l.lvByDevice(o.get("--source")); v != nil && v.mountPoint != "" {
+		return v.mountPoint + "\n", nil
+	}
+	return "", &commands.ExitError{Status: 1}
+}
+
+// growfs emulates the tools growing a mounted filesystem, they take the
+// mount point as their last argument
+func (l *LVM) growfs(name string, args []string) (string, error) {
+	if len(args) == 0 {
+		return fail(1, "%s: no mount point specified", name)
+	}
+	target := args[len(args)-1]
+	for _, v := range l.lvs {
+		if v.mountPoint != "" && v.mountPoint == target {
+			return fmt.Sprintf("%s: resized %s to %d bytes\n", name, target, v.size), nil
+		}
+	}
+	return fail(1, "%s: %s is not a mounted filesystem", name, target)
+}
+
+func (l *LVM) wipefs(args []string) (string, error) {
+	o := parseOptions(args, "--output")
+	if len(o.args) != 1 {
+		return fail(1, "no device specified")
+	}
+	b, ok := l.blocks[o.args[0]]
+	if !ok {
+		return fail(1, "error: %s: probing initialization failed: No such file or directory", o.args[0])
+	}
+	if !o.has("-af") && !o.has("--all") {
+		return l.listSignatures(b), nil
+	}
+	if p, ok := l.pvs[b.Path]; ok {
+		if p.vg != "" {
+			return fail(1, "error: %s: probing initialization failed: Device or resource busy", b.Path)
+		}
+	}
+	if b.ReadOnly {
+		return fail(1, "wipefs: error: %s: probing initialization failed: Read-only file system", b.Path)
+	}
+	if o.has("--backup") {
+		if err := l.backupSignatures(b); err != nil {
+			return fail(1, "error: %s: failed to create a signature backup: %v", b.Path, err)
+		}
+	}
+	delete(l.pvs, b.Path)
+	b.Signatures = nil
+	return "", nil
+}
@@ -0,0 +1,402 @@
+package fake
+
+import (
+	"encoding/json"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+type lvField func(l *LVM, v *lv) string
+type vgField func(l *LVM, v *vg) string
+type pvField func(l *LVM, p *pv) string
+
+var lvFields = map[string]lvField{
+	"lv_name": func(l *LVM, v *lv) string { return v.name },
+	"vg_name": func(l *LVM, v *lv) string { return v.vg },
+	"lv_size": func(l *LVM, v *lv) string { return formatSize(v.size) },
+	"lv_uuid": func(l *LVM, v *lv) string { return v.uuid },
+	"lv_attr": func(l *LVM, v *lv) string { return string(v.attr) },
+	"lv_tags": func(l *LVM, v *lv) string { return strings.Join(v.tags, ",") },
+	"pool_lv": func(l *LVM, v *lv) string { return v.pool },
+	"origin":  func(l *LVM, v *lv) string { return v.origin },
+	"lv_kernel_major": func(l *LVM, v *lv) string {
+		if v.attr[4] != 'a' {
+			return "-1"
+		}
+		return strconv.Itoa(dmMajor)
+	},
+	"lv_kernel_minor": func(l *LVM, v *lv) string {
+		if v.attr[4] != 'a' {
+			return "-1"
+		}
+		return strconv.Itoa(v.minor)
+	},
+	"data_percent": func(l *LVM, v *lv) string {
+		if v.attr[0] == 't' || v.attr[0] == 'V' {
+			return formatPercent(v.dataPercent)
+		}
+		return ""
+	},
+	"metadata_percent": func(l *LVM, v *lv) string {
+		if v.attr[0] == 't' {
+			return formatPercent(v.metadataPercent)
+		}
+		return ""
+	},
+	"lv_metadata_size": func(l *LVM, v *lv) string {
+		if v.attr[0] == 't' {
+			return formatSize(v.metadataSize)
+		}
+		return ""
+	},
+	"chunk_size": func(l *LVM, v *lv) string {
+		if v.attr[0] == 't' {
+			return formatSize(v.chunkSize)
+		}
+		return "0"
+	},
+	"transaction_id": func(l *LVM, v *lv) string {
+		if v.attr[0] == 't' {
+			return strconv.FormatUint(v.transactionID, 10)
+		}
+		return ""
+	},
+	"copy_percent": func(l *LVM, v *lv) string { return "" },
+	"snap_percent": func(l *LVM, v *lv) string {
+		if v.attr[0] == 's' {
+			return "0.00"
+		}
+		return ""
+	},
+	// every fake volume has a single segment on the first pv of its vg
+	"seg_start": func(l *LVM, v *lv) string { return "0" },
+	"seg_size":  func(l *LVM, v *lv) string { return formatSize(v.size) },
+	"segtype": func(l *LVM, v *lv) string {
+		switch v.attr[0] {
+		case 't':
+			return "thin-pool"
+		case 'V':
+			return "thin"
+		}
+		return "linear"
+	},
+	"stripes":     func(l *LVM, v *lv) string { return "1" },
+	"stripe_size": func(l *LVM, v *lv) string { return "0" },
+	"region_size": func(l *LVM, v *lv) string { return "0" },
+	"devices": func(l *LVM, v *lv) string {
+		switch v.attr[0] {
+		case 't':
+			return v.name + "_tdata(0)"
+		case 'V':
+			return ""
+		}
+		return l.vgs[v.vg].pvs[0] + "(0)"
+	},
+	"move_pv":    func(l *LVM, v *lv) string { return "" },
+	"mirror_log": func(l *LVM, v *lv) string { return "" },
+	"convert_lv": func(l *LVM, v *lv) string { return "" },
+}
+
+var vgFields = map[string]vgField{
+	"vg_name":         func(l *LVM, v *vg) string { return v.name },
+	"vg_size":         func(l *LVM, v *vg) string { return formatSize(l.vgSize(v)) },
+	"vg_free":         func(l *LVM, v *vg) string { return formatSize(l.vgFree(v)) },
+	"vg_uuid":         func(l *LVM, v *vg) string { return v.uuid },
+	"vg_tags":         func(l *LVM, v *vg) string { return strings.Join(v.tags, ",") },
+	"vg_attr":         func(l *LVM, v *vg) string { return "wz--n-" },
+	"vg_extent_size":  func(l *LVM, v *vg) string { return formatSize(ExtentSize) },
+	"vg_extent_count": func(l *LVM, v *vg) string { return formatSize(l.vgSize(v) / ExtentSize) },
+	"vg_free_count":   func(l *LVM, v *vg) string { return formatSize(l.vgFree(v) / ExtentSize) },
+	"vg_lock_type":    func(l *LVM, v *vg) string { return "" },
+	"max_lv":          func(l *LVM, v *vg) string { return "0" },
+	"max_pv":          func(l *LVM, v *vg) string { return "0" },
+	"pv_count":        func(l *LVM, v *vg) string { return strconv.Itoa(len(v.pvs)) },
+	"lv_count":        func(l *LVM, v *vg) string { return strconv.Itoa(len(l.vgLVs(v.name))) },
+	"snap_count": func(l *LVM, v *vg) string {
+		count := 0
+		for _, lv := range l.vgLVs(v.name) {
+			if lv.origin != "" {
+				count++
+			}
+		}
+		return strconv.Itoa(count)
+	},
+}
+
+var pvFields = map[string]pvField{
+	"pv_name": func(l *LVM, p *pv) string { return p.name },
+	"vg_name": func(l *LVM, p *pv) string { return p.vg },
+	"pv_uuid": func(l *LVM, p *pv) string { return p.uuid },
+	"pv_fmt":  func(l *LVM, p *pv) string { return "lvm2" },
+	"pv_attr": func(l *LVM, p *pv) string {
+		if p.vg == "" {
+			return "---"
+		}
+		return "a--"
+	},
+	"pv_tags": func(l *LVM, p *pv) string { return "" },
+	"dev_size": func(l *LVM, p *pv) string {
+		if b, ok := l.blocks[p.name]; ok {
+			return formatSize(b.Size)
+		}
+		return "0"
+	},
+	"pv_pe_count": func(l *LVM, p *pv) string {
+		if p.vg == "" {
+			return "0"
+		}
+		return formatSize(l.pvSize(p.name) / ExtentSize)
+	},
+	"pv_pe_alloc_count": func(l *LVM, p *pv) string { return formatSize(l.pvUsed(p) / ExtentSize) },
+	"pv_mda_count":      func(l *LVM, p *pv) string { return "1" },
+	"pv_mda_size":       func(l *LVM, p *pv) string { return formatSize(MetadataSize) },
+	"pv_size":           func(l *LVM, p *pv) string { return formatSize(l.pvSize(p.name)) },
+	"pv_used":           func(l *LVM, p *pv) string { return formatSize(l.pvUsed(p)) },
+	"pv_free": func(l *LVM, p *pv) string {
+		if p.vg == "" {
+			return formatSize(l.pvSize(p.name))
+		}
+		return formatSize(l.pvSize(p.name) - l.pvUsed(p))
+	},
+}
+
+var (
+	defaultLVFields = "lv_name,vg_name,lv_attr,lv_size,pool_lv,origin,data_percent,metadata_percent,move_pv,mirror_log,copy_percent,convert_lv"
+	defaultVGFields = "vg_name,pv_count,lv_count,snap_count,vg_attr,vg_size,vg_free"
+	defaultPVFields = "pv_name,vg_name,pv_fmt,pv_attr,pv_size,pv_free"
+	// defaultSegFields are the fields of lvs --segments
+	defaultSegFields = "lv_name,vg_name,lv_attr,stripes,segtype,seg_size"
+)
+
+func formatSize(size uint64) string {
+	return strconv.FormatUint(size, 10)
+}
+
+func formatPercent(percent float64) string {
+	return strconv.FormatFloat(percent, 'f', 2, 64)
+}
+
+// reporter renders rows the way the lvm reporting tools do, kind names the
+// objects in json reports
+type reporter struct {
+	kind        string
+	fields      []string
+	separator   string
+	nameprefix  bool
+	unitsSuffix bool
+	json        bool
+	rows        [][]string
+}
+
+func newReporter(o options, kind string, defaults string) *reporter {
+	fields := o.get("-o")
+	if fields == "" {
+		fields = defaults
+	}
+	r := &reporter{
+		kind:        kind,
+		fields:      strings.Split(fields, ","),
+		separator:   "  ",
+		nameprefix:  o.has("--nameprefixes"),
+		unitsSuffix: !o.has("--nosuffix"),
+	}
+	if o.has("--separator") {
+		r.separator = o.get("--separator")
+	}
+	r.json = o.get("--reportformat") == "json"
+	return r
+}
+
+func (r *reporter) isSize(field string) bool {
+	return field == "seg_start" || strings.HasSuffix(field, "_size") || strings.HasSuffix(field, "_free") || strings.HasSuffix(field, "_used")
+}
+
+func (r *reporter) add(values []string) {
+	r.rows = append(r.rows, values)
+}
+
+func (r *reporter) String() string {
+	if r.json {
+		return r.jsonString()
+	}
+	var out strings.Builder
+	for _, row := range r.rows {
+		cols := make([]string, len(row))
+		for i, value := range row {
+			value = r.value(i, value)
+			if r.nameprefix {
+				value = "LVM2_" + strings.ToUpper(r.fields[i]) + "='" + value + "'"
+			}
+			cols[i] = value
+		}
+		out.WriteString("  " + strings.Join(cols, r.separator) + "\n")
+	}
+	return out.String()
+}
+
+func (r *reporter) value(i int, value string) string {
+	if r.unitsSuffix && r.isSize(r.fields[i]) && value != "" {
+		value += "B"
+	}
+	return value
+}
+
+// jsonString renders the rows in the layout of --reportformat json
+func (r *reporter) jsonString() string {
+	objects := make([]map[string]string, len(r.rows))
+	for i, row := range r.rows {
+		objects[i] = make(map[string]string, len(row))
+		for j, value := range row {
+			objects[i][r.fields[j]] = r.value(j, value)
+		}
+	}
+	report := map[string][]map[string][]map[string]string{
+		"report": {{r.kind: objects}},
+	}
+	data, _ := json.MarshalIndent(report, "  ", "    ")
+	return "  " + string(data) + "\n"
+}
+
+func (l *LVM) lvsCmd(args []string) (string, error) {
+	o := parseOptions(args, "-o", "-O", "--units", "--separator", "--reportformat")
+	r := newReporter(o, "lv", defaultLVFields)
+	if o.has("--segments") {
+		r = newReporter(o, "seg", defaultSegFields)
+	}
+	for _, f := range r.fields {
+		if _, ok := lvFields[f]; !ok {
+			return fail(5, "Unrecognised field: %s", f)
+		}
+	}
+
+	var selected []*lv
+	selectors := nonEmpty(o.args)
+	if len(selectors) == 0 {
+		selected = append(selected, l.lvs...)
+	}
+	for _, s := range selectors {
+		vgName, name := splitLVPath(s)
+		if _, ok := l.vgs[vgName]; !ok {
+			return fail(5, "Volume group \"%s\" not found", vgName)
+		}
+		if name == "" {
+			selected = append(selected, l.vgLVs(vgName)...)
+			continue
+		}
+		v := l.findLV(vgName, name)
+		if v == nil {
+			return fail(5, "Failed to find logical volume \"%s/%s\"", vgName, name)
+		}
+		selected = append(selected, v)
+	}
+
+	for _, v := range selected {
+		values := make([]string, len(r.fields))
+		for i, f := range r.fields {
+			values[i] = lvFields[f](l, v)
+		}
+		r.add(values)
+	}
+	return r.String(), nil
+}
+
+func (l *LVM) vgsCmd(args []string) (string, error) {
+	o := parseOptions(args, "-o", "-O", "--units", "--separator", "--reportformat")
+	r := newReporter(o, "vg", defaultVGFields)
+	for _, f := range r.fields {
+		if _, ok := vgFields[f]; !ok {
+			return fail(5, "Unrecognised field: %s", f)
+		}
+	}
+
+	names := nonEmpty(o.args)
+	if len(names) == 0 {
+		for name := range l.vgs {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+	}
+	for _, name := range names {
+		v, ok := l.vgs[name]
+		if !ok {
+			return fail(5, "Volume group \"%s\" not found", name)
+		}
+		values := make([]string, len(r.fields))
+		for i, f := range r.fields {
+			values[i] = vgFields[f](l, v)
+		}
+		r.add(values)
+	}
+	return r.String(), nil
+}
+
+func (l *LVM) pvsCmd(args []string) (string, error) {
+	o := parseOptions(args, "-o", "-O", "--units", "--separator", "--reportformat")
+	r := newReporter(o, "pv", defaultPVFields)
+	for _, f := range r.fields {
+		if _, ok := pvFields[f]; !ok {
+			return fail(5, "Unrecognised field: %s", f)
+		}
+	}
+
+	// -a reports the other block devices as well, without a format or uuid
+	all := o.has("-a")
+	names := nonEmpty(o.args)
+	if len(names) == 0 {
+		for name := range l.pvs {
+			names = append(names, name)
+		}
+		if all {
+			for name := range l.blocks {
+				if _, ok := l.pvs[name]; !ok {
+					names = append(names, name)
+				}
+			}
+		}
+		sort.Strings(names)
+	}
+	for _, name := range names {
+		p, ok := l.pvs[name]
+		if !ok {
+			if _, isBlock := l.blocks[name]; !isBlock || !all {
+				return fail(5, "Failed to find physical volume \"%s\".", name)
+			}
+			p = &pv{name: name}
+		}
+		values := make([]string, len(r.fields))
+		for i, f := range r.fields {
+			if p.uuid == "" {
+				values[i] = blankPVField(l, f, p)
+			} else {
+				values[i] = pvFields[f](l, p)
+			}
+		}
+		r.add(values)
+	}
+	return r.String(), nil
+}
+
+// blankPVField reports a block device which isn't a pv, only its name and
+// size are known
+func blankPVField(l *LVM, f string, p *pv) string {
+	switch f {
+	case "pv_name", "dev_size":
+		return pvFields[f](l, p)
+	case "pv_attr":
+		return "---"
+	case "vg_name", "pv_uuid", "pv_fmt", "pv_tags":
+		return ""
+	}
+	return "0"
+}
+
+func nonEmpty(args []string) []string {
+	var res []string
+	for _, arg := range args {
+		if arg != "" {
+			res = append(res, arg)
+		}
+	}
+	return res
+}
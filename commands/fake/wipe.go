@@ -0,0 +1,51 @@
+package fake
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"syscall"
+)
+
+// backupSignatures saves the signatures of b to $HOME like wipefs --backup,
+// the fake backups hold the signature as it is given to AddBlock
+func (l *LVM) backupSignatures(b *Block) error {
+	home := l.env["HOME"]
+	if home == "" {
+		return fmt.Errorf("HOME is not set")
+	}
+	signatures := b.Signatures
+	if _, ok := l.pvs[b.Path]; ok {
+		signatures = append([]string{"LVM2_member"}, signatures...)
+	}
+	for _, sig := range signatures {
+		name := fmt.Sprintf("wipefs-%s-0x%08x.bak", filepath.Base(b.Path), signatureOffsets[strings.TrimPrefix(sig, "PTTYPE=")])
+		if err := ioutil.WriteFile(filepath.Join(home, name), []byte(sig), 0600); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// WriteDevice implements commands.DeviceWriter, writing back a signature
+// backup restores the signature it holds
+func (l *LVM) WriteDevice(path string, data []byte, offset int64) error {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	b, ok := l.blocks[path]
+	if !ok {
+		return &os.PathError{Op: "open", Path: path, Err: syscall.ENOENT}
+	}
+	if b.ReadOnly {
+		return &os.PathError{Op: "write", Path: path, Err: syscall.EROFS}
+	}
+	sig := string(data)
+	if sig == "LVM2_member" {
+		l.pvs[b.Path] = &pv{name: b.Path, uuid: l.newUUID()}
+	} else {
+		b.Signatures = append(b.Signatures, sig)
+	}
+	return nil
+}
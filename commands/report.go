@@ -0,0 +1,52 @@
+package commands
+
+import (
+	"encoding/json"
+
+	"golang.org/x/net/context"
+)
+
+// ReportFormat is the output format of the lvm reporting commands
+type ReportFormat string
+
+const (
+	// ReportFormatBasic reports columns with field name prefixes, it's
+	// supported by all lvm versions but can't represent every name or tag
+	ReportFormatBasic ReportFormat = "basic"
+	// ReportFormatJSON reports json objects, lvm supports it since 2.02.158
+	ReportFormatJSON ReportFormat = "json"
+)
+
+var reportFormat = ReportFormatBasic
+
+// SetReportFormat selects the output format the reporting commands are run
+// with, it's meant to be set once at startup
+func SetReportFormat(format ReportFormat) {
+	reportFormat = format
+}
+
+// DetectReportFormat returns ReportFormatJSON when the installed lvm
+// reports in json, and ReportFormatBasic otherwise
+func DetectReportFormat(ctx context.Context) (ReportFormat, error) {
+	out, err := runStdout(ctx, "vgs", "--reportformat", "json", "-o", "vg_name")
+	if err != nil {
+		if exitStatus(err) < 0 {
+			return "", err
+		}
+		// old versions reject the option
+		return ReportFormatBasic, nil
+	}
+	if !json.Valid([]byte(out)) {
+		return ReportFormatBasic, nil
+	}
+	return ReportFormatJSON, nil
+}
+
+// reportArgs returns the arguments of a reporting command for fields in the
+// selected format, sizes are reported in bytes without suffix
+func reportArgs(fields string, args ...string) []string {
+	if reportFormat == ReportFormatJSON {
+		return append([]string{"--units=b", "--nosuffix", "--reportformat", "json", "-o", fields}, args...)
+	}
+	return append([]string{"--units=b", "--separator=<:SEP:>", "--nosuffix", "--noheadings", "-o", fields, "--nameprefixes"}, args...)
+}
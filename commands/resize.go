@@ -0,0 +1,159 @@
+package commands
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"golang.org/x/net/context"
+)
+
+const (
+	// blkid exits with 2 when it finds no signature on the device
+	blkidNoSignature = 2
+	// findmnt exits with 1 when the device isn't mounted
+	findmntNotFound = 1
+	// e2fsck exits with 1 when it corrected errors of the filesystem
+	e2fsckCorrected = 1
+)
+
+// filesystem is the filesystem found on a volume, mountPoint is empty when
+// it isn't mounted
+type filesystem struct {
+	fsType     string
+	mountPoint string
+}
+
+func detectFilesystem(ctx context.Context, device string) (*filesystem, error) {
+	out, err := runStdout(ctx, "blkid", "-p", "-s", "TYPE", "-o", "value", device)
+	if err != nil {
+		if exitStatus(err) == blkidNoSignature {
+			return nil, nil
+		}
+		return nil, err
+	}
+	fs := &filesystem{fsType: strings.TrimSpace(out)}
+	if fs.fsType == "" {
+		return nil, nil
+	}
+
+	out, err = runStdout(ctx, "findmnt", "-n", "-o", "TARGET", "--source", device)
+	if err != nil {
+		if exitStatus(err) == findmntNotFound {
+			return fs, nil
+		}
+		return nil, err
+	}
+	// a device may be mounted several times, e.g. by bind mounts, growing
+	// through any of them is fine
+	fs.mountPoint = strings.TrimSpace(strings.Split(strings.TrimSpace(out), "\n")[0])
+	return fs, nil
+}
+
+func exitStatus(err error) int {
+	var cmdErr *Error
+	if errors.As(err, &cmdErr) && cmdErr.Command != "" {
+		return cmdErr.ExitStatus
+	}
+	return -1
+}
+
+// checkGrowable verifies the filesystem can be grown in its current state
+// before the volume is touched
+func (fs *filesystem) checkGrowable() error {
+	switch fs.fsType {
+	case "ext2", "ext3", "ext4":
+		return nil
+	case "xfs", "btrfs":
+		if fs.mountPoint == "" {
+			return newError(ErrUnsupported, "%s filesystem can only be grown while mounted", fs.fsType)
+		}
+		return nil
+	default:
+		return newError(ErrUnsupported, "growing %s filesystem is not supported", fs.fsType)
+	}
+}
+
+// grow extends the filesystem to the size of device, mounted ext filesystems
+// are grown online, unmounted ones are checked first as resize2fs requires
+func (fs *filesystem) grow(ctx context.Context, device string) (string, error) {
+	switch fs.fsType {
+	case "ext2", "ext3", "ext4":
+		var logs []string
+		if fs.mountPoint == "" {
+			log, err := run(ctx, "e2fsck", "-f", "-y", device)
+			if err != nil && exitStatus(err) != e2fsckCorrected {
+				return log, err
+			}
+			logs = append(logs, log)
+		}
+		log, err := run(ctx, "resize2fs", device)
+		return strings.Join(append(logs, log), "|"), err
+	case "xfs":
+		return run(ctx, "xfs_growfs", fs.mountPoint)
+	case "btrfs":
+		return run(ctx, "btrfs", "filesystem", "resize", "max", fs.mountPoint)
+	default:
+		return "", newError(ErrUnsupported, "growing %s filesystem is not supported", fs.fsType)
+	}
+}
+
+// ResizeLV resizes vg/name to size and grows the filesystem on it to the new
+// size. Shrinking is only allowed for volumes without a filesystem, a
+// filesystem which can't be grown in its current state is rejected before
+// the volume is resized. With blockOnly the filesystem is left alone when
+// growing, it's up to the caller to grow it. Resizing to the current size
+// only grows the filesystem, so a failed resize can be retried
+func ResizeLV(ctx context.Context, vg string, name string, size uint64, blockOnly bool) (string, error) {
+	if size == 0 {
+		return "", newError(ErrInvalidArgument, "size of volume %s/%s must be larger than 0", vg, name)
+	}
+	lv, err := GetLV(ctx, vg, name)
+	if err != nil {
+		return "", err
+	}
+	extent, err := extentSize(ctx, vg)
+	if err != nil {
+		return "", err
+	}
+	size = roundUp(size, extent)
+	shrink := size < lv.Size
+
+	device := devicePath(vg, name)
+	var fs *filesystem
+	if shrink || !blockOnly {
+		if fs, err = detectFilesystem(ctx, device); err != nil {
+			return "", err
+		}
+	}
+	if fs != nil {
+		if shrink {
+			return "", newError(ErrUnsupported, "shrinking volume %s/%s with %s filesystem is not supported", vg, name, fs.fsType)
+		}
+		if err := fs.checkGrowable(); err != nil {
+			return "", err
+		}
+	}
+
+	var logs []string
+	if size != lv.Size {
+		args := []string{"-L", fmt.Sprintf("%db", size), "-v"}
+		if shrink {
+			// lvresize asks for confirmation before reducing a volume
+			args = append(args, "-f")
+		}
+		log, err := run(ctx, "lvresize", append(args, fmt.Sprintf("%s/%s", vg, name))...)
+		if err != nil {
+			return log, err
+		}
+		logs = append(logs, log)
+	}
+	if fs != nil {
+		log, err := fs.grow(ctx, device)
+		logs = append(logs, log)
+		if err != nil {
+			return strings.Join(logs, "|"), err
+		}
+	}
+	return strings.Join(logs, "|"), nil
+}
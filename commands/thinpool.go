@@ -0,0 +1,223 @@
+package commands
+
+import (
+	"fmt"
+	"strings"
+
+	"golang.org/x/net/context"
+
+	"github.com/zdnscloud/lvmd/parser"
+)
+
+// Discard modes of thin pools, see lvmthin(7)
+const (
+	DiscardsPassdown   = "passdown"
+	DiscardsNoPassdown = "nopassdown"
+	DiscardsIgnore     = "ignore"
+)
+
+const (
+	kib = uint64(1024)
+	mib = 1024 * kib
+	gib = 1024 * mib
+
+	minChunkSize    = 64 * kib
+	maxChunkSize    = 1 * gib
+	minMetadataSize = 2 * mib
+	maxMetadataSize = 16 * gib
+)
+
+// ThinPoolOptions tunes a new thin pool, zero values leave the choice to lvm
+type ThinPoolOptions struct {
+	// Size of the data volume, the pool takes PercentFree of the free space
+	// of the volume group when it's 0, or all of it when both are 0
+	Size        uint64
+	PercentFree uint32
+	// MetadataSize is the size of the metadata volume, lvm keeps a spare
+	// metadata volume of the same size in the volume group
+	MetadataSize uint64
+	// ChunkSize must be a multiple of 64KiB between 64KiB and 1GiB
+	ChunkSize uint64
+	// Zeroing zeroes newly provisioned chunks when set to true
+	Zeroing  *bool
+	Discards string
+}
+
+func (o ThinPoolOptions) validate() error {
+	if o.Size != 0 && o.PercentFree != 0 {
+		return newError(ErrInvalidArgument, "size and percent of free space are exclusive")
+	}
+	if o.PercentFree > 100 {
+		return newError(ErrInvalidArgument, "percent of free space %d is larger than 100", o.PercentFree)
+	}
+	if o.ChunkSize != 0 && (o.ChunkSize%minChunkSize != 0 || o.ChunkSize < minChunkSize || o.ChunkSize > maxChunkSize) {
+		return newError(ErrInvalidArgument, "chunk size %d isn't a multiple of 64KiB between 64KiB and 1GiB", o.ChunkSize)
+	}
+	if o.MetadataSize != 0 && (o.MetadataSize < minMetadataSize || o.MetadataSize > maxMetadataSize) {
+		return newError(ErrInvalidArgument, "metadata size %d isn't between 2MiB and 16GiB", o.MetadataSize)
+	}
+	switch o.Discards {
+	case "", DiscardsPassdown, DiscardsNoPassdown, DiscardsIgnore:
+	default:
+		return newError(ErrInvalidArgument, "unknown discards mode %q", o.Discards)
+	}
+	return nil
+}
+
+func (o ThinPoolOptions) args() []string {
+	var args []string
+	switch {
+	case o.Size != 0:
+		args = append(args, "-L", fmt.Sprintf("%db", o.Size))
+	case o.PercentFree != 0:
+		args = append(args, "-l", fmt.Sprintf("%d%%FREE", o.PercentFree))
+	default:
+		args = append(args, "-l", "100%FREE")
+	}
+	if o.MetadataSize != 0 {
+		args = append(args, "--poolmetadatasize", fmt.Sprintf("%db", o.MetadataSize))
+	}
+	if o.ChunkSize != 0 {
+		args = append(args, "--chunksize", fmt.Sprintf("%db", o.ChunkSize))
+	}
+	if o.Zeroing != nil {
+		zero := "n"
+		if *o.Zeroing {
+			zero = "y"
+		}
+		args = append(args, "-Z", zero)
+	}
+	if o.Discards != "" {
+		args = append(args, "--discards", o.Discards)
+	}
+	return args
+}
+
+// checkFreeSpace verifies a pool of an absolute size fits into the volume
+// group. The metadata volume and its spare need space too, lvm makes the
+// metadata at least 2MiB when its size isn't given
+func (o ThinPoolOptions) checkFreeSpace(ctx context.Context, vg string) error {
+	if o.Size == 0 {
+		return nil
+	}
+	extent, err := extentSize(ctx, vg)
+	if err != nil {
+		return err
+	}
+	group, err := GetVG(ctx, vg)
+	if err != nil {
+		return err
+	}
+	metadata := o.MetadataSize
+	if metadata == 0 {
+		metadata = minMetadataSize
+	}
+	required := roundUp(o.Size, extent) + 2*roundUp(metadata, extent)
+	if required > group.FreeSize {
+		return newError(ErrInsufficientSpace, "volume group %s has insufficient free space for the pool: %d required, %d free",
+			vg, required, group.FreeSize)
+	}
+	return nil
+}
+
+func roundUp(size uint64, extent uint64) uint64 {
+	return (size + extent - 1) / extent * extent
+}
+
+// CreateThinPool creates the thin pool vg/pool tagged with ManagedTag, an
+// existing thin pool is accepted so callers can retry as long as its size
+// matches a requested absolute size
+func CreateThinPool(ctx context.Context, vg string, pool string, opts ThinPoolOptions) (string, error) {
+	if err := opts.validate(); err != nil {
+		return "", err
+	}
+	existing, err := findLV(ctx, vg, pool)
+	if err != nil {
+		return "", err
+	}
+	if existing != nil {
+		if existing.Attributes.Type != parser.VolumeTypeThinPool {
+			return "", newError(ErrAlreadyExists, "volume %s/%s already exists and isn't a thin pool", vg, pool)
+		}
+		if opts.Size != 0 {
+			if err := matchLV(ctx, vg, existing, opts.Size, "", nil); err != nil {
+				return "", err
+			}
+		}
+		return fmt.Sprintf("Thin pool \"%s\" already exists\n", pool), nil
+	}
+
+	if err := opts.checkFreeSpace(ctx, vg); err != nil {
+		return "", err
+	}
+	args := append([]string{"-v"}, opts.args()...)
+	args = append(args, "--add-tag", ManagedTag, "--thinpool", pool, vg, "-y")
+	return run(ctx, "lvcreate", args...)
+}
+
+// ListThinPools returns the usage of the thin pools in vg
+func ListThinPools(ctx context.Context, vg string) ([]*parser.ThinPool, error) {
+	lvs, err := ListLV(ctx, vg)
+	if err != nil {
+		return nil, err
+	}
+	var pools []*parser.ThinPool
+	for _, lv := range lvs {
+		if lv.Attributes.Type != parser.VolumeTypeThinPool {
+			continue
+		}
+		pool, err := thinPoolStatus(ctx, vg, lv.Name)
+		if err != nil {
+			return nil, err
+		}
+		pool.Tags = lv.Tags
+		for _, thin := range lvs {
+			if thin.PoolLV == pool.Name {
+				pool.VirtualSize += thin.Size
+				pool.ThinVolumes++
+			}
+		}
+		pools = append(pools, pool)
+	}
+	return pools, nil
+}
+
+// GetThinPoolStatus returns the usage of the thin pool vg/pool
+func GetThinPoolStatus(ctx context.Context, vg string, pool string) (*parser.ThinPool, error) {
+	pools, err := ListThinPools(ctx, vg)
+	if err != nil {
+		return nil, err
+	}
+	for _, p := range pools {
+		if p.Name == pool {
+			return p, nil
+		}
+	}
+	lv, err := findLV(ctx, vg, pool)
+	if err != nil {
+		return nil, err
+	}
+	if lv == nil {
+		return nil, newError(ErrNotFound, "thin pool %s/%s not found", vg, pool)
+	}
+	return nil, newError(ErrInvalidArgument, "volume %s/%s isn't a thin pool", vg, pool)
+}
+
+func thinPoolStatus(ctx context.Context, vg string, pool string) (*parser.ThinPool, error) {
+	out, err := runStdout(ctx, "lvs", reportArgs("lv_name,vg_name,lv_size,lv_metadata_size,data_percent,metadata_percent,chunk_size,transaction_id",
+		fmt.Sprintf("%s/%s", vg, pool))...)
+	if err != nil {
+		return nil, err
+	}
+	if reportFormat == ReportFormatJSON {
+		pools, err := parser.ParseThinPoolReport(out)
+		if err != nil {
+			return nil, err
+		}
+		if len(pools) != 1 {
+			return nil, fmt.Errorf("expected 1 thin pool, got %d", len(pools))
+		}
+		return pools[0], nil
+	}
+	return parser.ParseThinPool(strings.TrimSpace(out))
+}
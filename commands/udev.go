@@ -0,0 +1,36 @@
+package commands
+
+import (
+	"bufio"
+	"os/exec"
+	"strings"
+
+	"golang.org/x/net/context"
+)
+
+// MonitorUdev calls changed for every udev event of a block device until
+// ctx is done or udevadm exits. It runs udevadm directly rather than
+// through the executor, which only runs commands to completion
+func MonitorUdev(ctx context.Context, changed func()) error {
+	cmd := exec.CommandContext(ctx, "udevadm", "monitor", "--udev", "--subsystem-match=block")
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		return err
+	}
+	if err := cmd.Start(); err != nil {
+		return err
+	}
+	scanner := bufio.NewScanner(stdout)
+	for scanner.Scan() {
+		// events look like "UDEV  [1234.5678] change /devices/... (block)",
+		// the header lines are skipped
+		if strings.HasPrefix(scanner.Text(), "UDEV") {
+			changed()
+		}
+	}
+	err = cmd.Wait()
+	if ctx.Err() != nil {
+		return ctx.Err()
+	}
+	return err
+}
@@ -0,0 +1,185 @@
+package commands
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"syscall"
+
+	"golang.org/x/net/context"
+
+	"github.com/zdnscloud/lvmd/parser"
+)
+
+// UnusableKind classifies why a block can't be used for a new physical
+// volume
+type UnusableKind int
+
+const (
+	UnusableSignature UnusableKind = iota + 1
+	UnusablePartitionTable
+	UnusableMounted
+	UnusableHolder
+	UnusableSwap
+	UnusableRaidMember
+	UnusablePhysicalVolume
+	UnusableOpen
+)
+
+// UnusableReason is a reason why a block can't be used for a new physical
+// volume. Detail is the signature type, mountpoint, holder, swap device or
+// volume group by kind, Signature is set for the reasons found by wipefs
+type UnusableReason struct {
+	Kind      UnusableKind
+	Detail    string
+	Signature *parser.Signature
+}
+
+func (r UnusableReason) String() string {
+	switch r.Kind {
+	case UnusableSignature:
+		return fmt.Sprintf("%s signature at offset %#x", r.Detail, r.Signature.Offset)
+	case UnusablePartitionTable:
+		return fmt.Sprintf("%s partition table at offset %#x", r.Detail, r.Signature.Offset)
+	case UnusableMounted:
+		return fmt.Sprintf("mounted at %s", r.Detail)
+	case UnusableHolder:
+		return fmt.Sprintf("held by %s", r.Detail)
+	case UnusableSwap:
+		if r.Signature != nil {
+			return fmt.Sprintf("swap signature at offset %#x", r.Signature.Offset)
+		}
+		return fmt.Sprintf("active swap space on %s", r.Detail)
+	case UnusableRaidMember:
+		return fmt.Sprintf("member of a %s array", r.Detail)
+	case UnusablePhysicalVolume:
+		if r.Detail == "" {
+			return "physical volume"
+		}
+		return fmt.Sprintf("physical volume of volume group %s", r.Detail)
+	case UnusableOpen:
+		return "open by another process"
+	}
+	return "unknown"
+}
+
+// DeviceOpener is implemented by executors which emulate the block devices
+// as well, Validate asks them instead of opening the device
+type DeviceOpener interface {
+	// OpenExclusive fails with syscall.EBUSY when the device is in use
+	OpenExclusive(path string) error
+}
+
+func openExclusive(path string) error {
+	if opener, ok := executor.(DeviceOpener); ok {
+		return opener.OpenExclusive(path)
+	}
+	// an exclusive open of a block device fails while it is mounted or held
+	// open exclusively, by device mapper or md among others
+	f, err := os.OpenFile(path, os.O_RDONLY|syscall.O_EXCL, 0)
+	if err != nil {
+		return err
+	}
+	return f.Close()
+}
+
+// Validate returns the reasons why block can't be used for a new physical
+// volume, it is usable when there are none
+func Validate(ctx context.Context, block string) ([]UnusableReason, error) {
+	var reasons []UnusableReason
+	devices, err := listBlockTree(ctx, block)
+	if err != nil {
+		return nil, err
+	}
+	for i, dev := range devices {
+		for _, mountpoint := range dev.Mountpoints {
+			if mountpoint == "[SWAP]" {
+				reasons = append(reasons, UnusableReason{Kind: UnusableSwap, Detail: dev.Path})
+			} else {
+				reasons = append(reasons, UnusableReason{Kind: UnusableMounted, Detail: mountpoint})
+			}
+		}
+		// devices stacked on a partition hold the disk as well
+		if i == 0 || dev.Parent != "" {
+			for _, holder := range dev.Holders {
+				reasons = append(reasons, UnusableReason{Kind: UnusableHolder, Detail: holder})
+			}
+		}
+	}
+
+	signatures, err := ListSignatures(ctx, block)
+	if err != nil {
+		return nil, err
+	}
+	pv, err := findPV(ctx, block)
+	if err != nil {
+		return nil, err
+	}
+	if pv != nil {
+		reasons = append(reasons, UnusableReason{Kind: UnusablePhysicalVolume, Detail: pv.VGName})
+	}
+	for _, sig := range signatures {
+		reason := UnusableReason{Kind: UnusableSignature, Detail: sig.Type, Signature: sig}
+		switch {
+		case sig.Type == "LVM2_member":
+			if pv != nil {
+				continue
+			}
+			reason.Kind = UnusablePhysicalVolume
+			reason.Detail = ""
+		case sig.Type == "swap":
+			reason.Kind = UnusableSwap
+		case sig.Usage == parser.UsageRaid:
+			reason.Kind = UnusableRaidMember
+		case sig.Usage == parser.UsagePartitionTable:
+			reason.Kind = UnusablePartitionTable
+		}
+		reasons = append(reasons, reason)
+	}
+
+	if err := openExclusive(block); err != nil {
+		if !errors.Is(err, syscall.EBUSY) {
+			return nil, fmt.Errorf("failed to open %s: %w", block, err)
+		}
+		reasons = append(reasons, UnusableReason{Kind: UnusableOpen, Detail: block})
+	}
+	return reasons, nil
+}
+
+// listBlockTree returns block followed by its partitions and the devices
+// stacked on them
+func listBlockTree(ctx context.Context, block string) ([]*parser.BlockDevice, error) {
+	out, err := runStdout(ctx, "lsblk", "--json", "--bytes", "--output", lsblkColumns, block)
+	if err != nil {
+		return nil, err
+	}
+	devices, err := parser.ParseBlockDevices(out)
+	if err != nil {
+		return nil, err
+	}
+	if len(devices) == 0 {
+		return nil, newError(ErrNotFound, "block device %s not found", block)
+	}
+	return devices, nil
+}
+
+// ListSignatures returns the signatures wipefs finds on block, without
+// erasing them
+func ListSignatures(ctx context.Context, block string) ([]*parser.Signature, error) {
+	out, err := runStdout(ctx, "wipefs", "--json", "--output", "OFFSET,TYPE,USAGE,UUID,LABEL", block)
+	if err != nil {
+		return nil, err
+	}
+	return parser.ParseSignatures(out)
+}
+
+// findPV returns nil rather than an error when block isn't a physical
+// volume
+func findPV(ctx context.Context, block string) (*parser.PV, error) {
+	pv, err := GetPV(ctx, block)
+	var cmdErr *Error
+	if errors.As(err, &cmdErr) && cmdErr.Kind == ErrNotFound {
+		return nil, nil
+	}
+	return pv, err
+}
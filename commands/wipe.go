@@ -0,0 +1,214 @@
+package commands
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"golang.org/x/net/context"
+
+	"github.com/zdnscloud/lvmd/parser"
+)
+
+var signatureBackupDir string
+
+// SetSignatureBackupDir sets the directory Destory saves the erased
+// signatures to, empty disables the backups and RestoreSignatures
+func SetSignatureBackupDir(dir string) {
+	signatureBackupDir = dir
+}
+
+// SignatureBackupDir returns the directory of the signature backups of
+// block, empty when backups are disabled. The backups of each block are
+// kept apart as wipefs names them after the device name only, links like
+// /dev/disk/by-id/... share the directory of the device they point to
+func SignatureBackupDir(block string) string {
+	if signatureBackupDir == "" {
+		return ""
+	}
+	if resolved, err := filepath.EvalSymlinks(block); err == nil {
+		block = resolved
+	}
+	name := strings.Replace(strings.TrimPrefix(filepath.Clean(block), "/dev/"), "/", "_", -1)
+	return filepath.Join(signatureBackupDir, name)
+}
+
+// checkNotInUse refuses blocks which are in use, a physical volume is in
+// use until it is removed from its volume group
+func checkNotInUse(ctx context.Context, block string) error {
+	reasons, err := Validate(ctx, block)
+	if err != nil {
+		return err
+	}
+	var busy []string
+	for _, r := range reasons {
+		inUse := false
+		switch r.Kind {
+		case UnusableMounted, UnusableHolder, UnusableOpen:
+			inUse = true
+		case UnusableSwap:
+			// active swap space rather than a swap signature
+			inUse = r.Signature == nil
+		case UnusablePhysicalVolume:
+			inUse = r.Detail != ""
+		}
+		if inUse {
+			busy = append(busy, r.String())
+		}
+	}
+	if len(busy) != 0 {
+		return newError(ErrBusy, "block %s is in use: %s", block, strings.Join(busy, ", "))
+	}
+	return nil
+}
+
+// Destory erases the signatures on block and returns them, with dryRun
+// nothing is erased. The signatures are saved for RestoreSignatures unless
+// backups are disabled
+func Destory(ctx context.Context, block string, dryRun bool) ([]*parser.Signature, string, error) {
+	if err := checkNotInUse(ctx, block); err != nil {
+		return nil, "", err
+	}
+	signatures, err := ListSignatures(ctx, block)
+	if err != nil || dryRun {
+		return signatures, "", err
+	}
+	if signatureBackupDir == "" {
+		out, err := run(ctx, "wipefs", "-af", block)
+		return signatures, out, err
+	}
+
+	dir := SignatureBackupDir(block)
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return nil, "", fmt.Errorf("failed to create signature backup directory: %v", err)
+	}
+	// wipefs saves the backups to $HOME, a new directory keeps the older
+	// backups until the wipe succeeded
+	tmp, err := ioutil.TempDir(dir, ".wipefs")
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to create signature backup directory: %v", err)
+	}
+	defer os.RemoveAll(tmp)
+	out, err := runEnv(ctx, []string{"HOME=" + tmp}, "wipefs", "--all", "--force", "--backup", block)
+	if err != nil {
+		return nil, out, err
+	}
+	// older backups are kept when there was nothing to erase, so wiping
+	// twice doesn't lose them
+	if len(signatures) != 0 {
+		if err := replaceBackups(dir, tmp); err != nil {
+			return nil, out, err
+		}
+	}
+	return signatures, out, nil
+}
+
+// RestoreSignatures writes back the signatures erased from block by the
+// last Destory and returns the signatures on block afterwards. It refuses
+// blocks which got new signatures since
+func RestoreSignatures(ctx context.Context, block string) ([]*parser.Signature, string, error) {
+	if signatureBackupDir == "" {
+		return nil, "", newError(ErrUnsupported, "signature backups are disabled")
+	}
+	dir := SignatureBackupDir(block)
+	backups, err := listBackups(dir)
+	if err != nil {
+		return nil, "", err
+	}
+	if len(backups) == 0 {
+		return nil, "", newError(ErrNotFound, "no signature backups of %s in %s", block, dir)
+	}
+
+	if err := checkNotInUse(ctx, block); err != nil {
+		return nil, "", err
+	}
+	signatures, err := ListSignatures(ctx, block)
+	if err != nil {
+		return nil, "", err
+	}
+	if len(signatures) != 0 {
+		return nil, "", newError(ErrAlreadyExists, "block %s has signatures, wipe them before restoring", block)
+	}
+
+	for _, backup := range backups {
+		if err := restoreBackup(backup, block); err != nil {
+			return nil, "", err
+		}
+	}
+	if err := removeBackups(dir); err != nil {
+		return nil, "", err
+	}
+
+	signatures, err = ListSignatures(ctx, block)
+	return signatures, "", err
+}
+
+// DeviceWriter is implemented by executors which emulate the block devices
+// as well, RestoreSignatures writes to them instead of the device
+type DeviceWriter interface {
+	WriteDevice(path string, data []byte, offset int64) error
+}
+
+// restoreBackup writes the signature backup back to block, at the offset
+// in its name wipefs-<device>-<offset>.bak
+func restoreBackup(backup string, block string) error {
+	name := strings.TrimSuffix(filepath.Base(backup), ".bak")
+	offset, err := strconv.ParseInt(name[strings.LastIndex(name, "-")+1:], 0, 64)
+	if err != nil {
+		return fmt.Errorf("failed to parse offset of signature backup %s: %v", backup, err)
+	}
+	data, err := ioutil.ReadFile(backup)
+	if err != nil {
+		return fmt.Errorf("failed to read signature backup: %v", err)
+	}
+	if writer, ok := executor.(DeviceWriter); ok {
+		return writer.WriteDevice(block, data, offset)
+	}
+
+	f, err := os.OpenFile(block, os.O_WRONLY, 0)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	if _, err := f.WriteAt(data, offset); err != nil {
+		return fmt.Errorf("failed to restore signature at offset %#x: %w", offset, err)
+	}
+	return f.Sync()
+}
+
+func listBackups(dir string) ([]string, error) {
+	return filepath.Glob(filepath.Join(dir, "wipefs-*-0x*.bak"))
+}
+
+// replaceBackups replaces the backups in dir with the ones in tmp
+func replaceBackups(dir, tmp string) error {
+	if err := removeBackups(dir); err != nil {
+		return err
+	}
+	backups, err := listBackups(tmp)
+	if err != nil {
+		return err
+	}
+	for _, backup := range backups {
+		if err := os.Rename(backup, filepath.Join(dir, filepath.Base(backup))); err != nil {
+			return fmt.Errorf("failed to save signature backup: %v", err)
+		}
+	}
+	return nil
+}
+
+func removeBackups(dir string) error {
+	backups, err := listBackups(dir)
+	if err != nil {
+		return err
+	}
+	for _, backup := range backups {
+		if err := os.Remove(backup); err != nil {
+			return fmt.Errorf("failed to remove signature backup: %v", err)
+		}
+	}
+	return nil
+}
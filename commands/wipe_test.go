@@ -0,0 +1,56 @@
+package commands
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Signature backups", func() {
+	var dir string
+
+	BeforeEach(func() {
+		var err error
+		dir, err = ioutil.TempDir("", "lvmd-signatures")
+		Expect(err).To(BeNil())
+		SetSignatureBackupDir(filepath.Join(dir, "backups"))
+	})
+
+	AfterEach(func() {
+		SetSignatureBackupDir("")
+		os.RemoveAll(dir)
+	})
+
+	It("should share the directory of a device with its links", func() {
+		device := filepath.Join(dir, "sdb")
+		Expect(ioutil.WriteFile(device, nil, 0600)).To(Succeed())
+		link := filepath.Join(dir, "wwn-0x5000c500a1b2c3d4")
+		Expect(os.Symlink("sdb", link)).To(Succeed())
+		Expect(SignatureBackupDir(link)).To(Equal(SignatureBackupDir(device)))
+	})
+
+	It("should name the directory after the device", func() {
+		Expect(SignatureBackupDir("/dev/mapper/k8s-data")).To(Equal(filepath.Join(dir, "backups", "mapper_k8s-data")))
+	})
+
+	It("should write a backup back at its offset", func() {
+		block := filepath.Join(dir, "sdb")
+		Expect(ioutil.WriteFile(block, make([]byte, 8192), 0600)).To(Succeed())
+		backup := filepath.Join(dir, "wipefs-sdb-0x00000438.bak")
+		Expect(ioutil.WriteFile(backup, []byte{0x53, 0xef}, 0600)).To(Succeed())
+
+		Expect(restoreBackup(backup, block)).To(Succeed())
+		data, err := ioutil.ReadFile(block)
+		Expect(err).To(BeNil())
+		Expect(data).To(HaveLen(8192))
+		Expect(data[0x437:0x43b]).To(Equal([]byte{0, 0x53, 0xef, 0}))
+	})
+
+	It("should be empty when backups are disabled", func() {
+		SetSignatureBackupDir("")
+		Expect(SignatureBackupDir("/dev/sdb")).To(BeEmpty())
+	})
+})
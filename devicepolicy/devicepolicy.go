@@ -0,0 +1,292 @@
+// Package devicepolicy restricts the block devices the LVM service may turn
+// into or remove from physical volumes, wipe or restore to the devices
+// allowed by a policy file.
+//
+// A device is allowed when it matches any allow rule, or there are none,
+// and it matches no deny rule. The policy is checked against the device
+// as reported by lsblk before the handler runs any command, so a device
+// named by one of its udev links is checked like the device itself.
+package devicepolicy
+
+import (
+	"fmt"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/zdnscloud/cement/configure"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/zdnscloud/lvmd/commands"
+	"github.com/zdnscloud/lvmd/parser"
+	pb "github.com/zdnscloud/lvmd/proto"
+)
+
+// servicePrefix prefixes the full names of the methods of the LVM service
+const servicePrefix = "/lvm.LVM/"
+
+// bootMountpoints are the mountpoints which make a disk the boot disk
+var bootMountpoints = []string{"/", "/boot", "/boot/efi"}
+
+// blocks returns the block device named by the requests of the methods
+// which touch physical volumes
+var blocks = map[string]func(req interface{}) string{
+	"CreatePV":          func(req interface{}) string { return req.(*pb.CreatePVRequest).Block },
+	"RemovePV":          func(req interface{}) string { return req.(*pb.RemovePVRequest).Block },
+	"CreateVG":          func(req interface{}) string { return req.(*pb.CreateVGRequest).PhysicalVolume },
+	"ExtendVG":          func(req interface{}) string { return req.(*pb.ExtendVGRequest).PhysicalVolume },
+	"ReduceVG":          func(req interface{}) string { return req.(*pb.ExtendVGRequest).PhysicalVolume },
+	"Validate":          func(req interface{}) string { return req.(*pb.ValidateRequest).Block },
+	"Destory":           func(req interface{}) string { return req.(*pb.DestoryRequest).Block },
+	"RestoreSignatures": func(req interface{}) string { return req.(*pb.RestoreSignaturesRequest).Block },
+}
+
+// Rule matches the devices which meet all of its conditions, omitted
+// conditions match all devices. Paths are patterns as matched by
+// path.Match against the path and the udev links of a device, like
+// /dev/disk/by-id/wwn-*. Sizes are in bytes, Transports are the transports
+// reported by lsblk like sata, sas or nvme, a partition has the transport
+// of its disk. BootDisk matches the disk holding /, /boot or /boot/efi and
+// its partitions, Mounted matches the devices with a mounted filesystem or
+// active swap space on them, their partitions or the devices stacked on them
+type Rule struct {
+	Paths      []string `yaml:"paths"`
+	MinSize    uint64   `yaml:"min_size"`
+	MaxSize    uint64   `yaml:"max_size"`
+	Transports []string `yaml:"transports"`
+	BootDisk   bool     `yaml:"boot_disk"`
+	Mounted    bool     `yaml:"mounted"`
+}
+
+// Policy is the content of the device policy file
+type Policy struct {
+	Allow []Rule `yaml:"allow"`
+	Deny  []Rule `yaml:"deny"`
+}
+
+// Guard checks the block devices of calls against a policy
+type Guard struct {
+	policy Policy
+}
+
+// Load reads the device policy file
+func Load(file string) (*Guard, error) {
+	var policy Policy
+	if err := configure.Load(&policy, file); err != nil {
+		return nil, fmt.Errorf("failed to load device policy %s: %v", file, err)
+	}
+	return New(policy)
+}
+
+// New returns a guard for policy
+func New(policy Policy) (*Guard, error) {
+	for _, rules := range [][]Rule{policy.Allow, policy.Deny} {
+		for _, r := range rules {
+			for _, p := range r.Paths {
+				if _, err := path.Match(p, ""); err != nil {
+					return nil, fmt.Errorf("invalid path pattern %q", p)
+				}
+			}
+			if r.MaxSize != 0 && r.MaxSize < r.MinSize {
+				return nil, fmt.Errorf("max_size %d is below min_size %d", r.MaxSize, r.MinSize)
+			}
+		}
+	}
+	return &Guard{policy: policy}, nil
+}
+
+// UnaryInterceptor rejects calls on block devices which aren't allowed
+func (g *Guard) UnaryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	if err := g.check(ctx, info.FullMethod, req); err != nil {
+		return nil, err
+	}
+	return handler(ctx, req)
+}
+
+func (g *Guard) check(ctx context.Context, fullMethod string, req interface{}) error {
+	if !strings.HasPrefix(fullMethod, servicePrefix) {
+		return nil
+	}
+	method := strings.TrimPrefix(fullMethod, servicePrefix)
+	block, ok := blocks[method]
+	if !ok {
+		return nil
+	}
+	// RemoveVG and GetPVNum share the request of CreateVG without a block
+	name := block(req)
+	if name == "" {
+		return nil
+	}
+
+	devices, err := commands.ListBlockDevices(ctx, commands.BlockDeviceFilter{})
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to list block devices: %v", err)
+	}
+	d := newDeviceInfo(devices, name)
+	if d == nil {
+		return status.Errorf(codes.PermissionDenied, "block %s isn't a known block device", name)
+	}
+	if !g.allowed(d) {
+		return status.Errorf(codes.PermissionDenied, "block %s isn't allowed by the device policy", name)
+	}
+	for _, r := range g.policy.Deny {
+		if r.matches(d) {
+			return status.Errorf(codes.PermissionDenied, "block %s is denied by the device policy: %s", name, r.describe(d))
+		}
+	}
+	return nil
+}
+
+func (g *Guard) allowed(d *deviceInfo) bool {
+	if len(g.policy.Allow) == 0 {
+		return true
+	}
+	for _, r := range g.policy.Allow {
+		if r.matches(d) {
+			return true
+		}
+	}
+	return false
+}
+
+// deviceInfo is a device with the facts the rules match on
+type deviceInfo struct {
+	*parser.BlockDevice
+	// paths are the requested path, the path and the udev links
+	paths     []string
+	transport string
+	bootDisk  bool
+	// mountpoints of the device and the devices on it
+	mountpoints []string
+}
+
+// newDeviceInfo finds block among devices, nil when it isn't one of them
+func newDeviceInfo(devices []*parser.BlockDevice, block string) *deviceInfo {
+	byName := make(map[string]*parser.BlockDevice, len(devices))
+	for _, dev := range devices {
+		byName[dev.Name] = dev
+	}
+	// a symlink which isn't a udev link, like /dev/vg/lv, names the device
+	// it resolves to
+	names := []string{block}
+	if resolved, err := filepath.EvalSymlinks(block); err == nil && resolved != block {
+		names = append(names, resolved)
+	}
+	var dev *parser.BlockDevice
+	for _, d := range devices {
+		for _, p := range append([]string{d.Path}, d.Links...) {
+			for _, name := range names {
+				if p == name {
+					dev = d
+				}
+			}
+		}
+	}
+	if dev == nil {
+		return nil
+	}
+
+	d := &deviceInfo{
+		BlockDevice: dev,
+		paths:       append([]string{block, dev.Path}, dev.Links...),
+		transport:   dev.Transport,
+		mountpoints: mountpoints(byName, dev),
+	}
+	disk := dev
+	if parent, ok := byName[dev.Parent]; ok {
+		disk = parent
+		d.transport = parent.Transport
+	}
+	for _, mountpoint := range mountpoints(byName, disk) {
+		for _, boot := range bootMountpoints {
+			if mountpoint == boot {
+				d.bootDisk = true
+			}
+		}
+	}
+	return d
+}
+
+// mountpoints returns the mountpoints of dev, its partitions and the
+// devices stacked on them
+func mountpoints(byName map[string]*parser.BlockDevice, dev *parser.BlockDevice) []string {
+	var res []string
+	seen := make(map[string]bool)
+	var walk func(dev *parser.BlockDevice)
+	walk = func(dev *parser.BlockDevice) {
+		if seen[dev.Name] {
+			return
+		}
+		seen[dev.Name] = true
+		res = append(res, dev.Mountpoints...)
+		for _, holder := range dev.Holders {
+			if h, ok := byName[holder]; ok {
+				walk(h)
+			}
+		}
+		for _, d := range byName {
+			if d.Parent == dev.Name {
+				walk(d)
+			}
+		}
+	}
+	walk(dev)
+	return res
+}
+
+func (r Rule) matches(d *deviceInfo) bool {
+	if len(r.Paths) != 0 && !matchAny(r.Paths, d.paths) {
+		return false
+	}
+	if d.Size < r.MinSize || (r.MaxSize != 0 && d.Size > r.MaxSize) {
+		return false
+	}
+	if len(r.Transports) != 0 && !matchAny(r.Transports, []string{d.transport}) {
+		return false
+	}
+	if r.BootDisk && !d.bootDisk {
+		return false
+	}
+	if r.Mounted && len(d.mountpoints) == 0 {
+		return false
+	}
+	return true
+}
+
+// describe names the conditions of a deny rule which matched d
+func (r Rule) describe(d *deviceInfo) string {
+	var reasons []string
+	if len(r.Paths) != 0 {
+		reasons = append(reasons, fmt.Sprintf("path matches %s", strings.Join(r.Paths, ",")))
+	}
+	if r.MinSize != 0 || r.MaxSize != 0 {
+		reasons = append(reasons, fmt.Sprintf("size %d", d.Size))
+	}
+	if len(r.Transports) != 0 {
+		reasons = append(reasons, fmt.Sprintf("transport %s", d.transport))
+	}
+	if r.BootDisk {
+		reasons = append(reasons, "boot disk")
+	}
+	if r.Mounted {
+		reasons = append(reasons, fmt.Sprintf("mounted at %s", strings.Join(d.mountpoints, ",")))
+	}
+	if len(reasons) == 0 {
+		return "all devices"
+	}
+	return strings.Join(reasons, ", ")
+}
+
+func matchAny(patterns []string, names []string) bool {
+	for _, p := range patterns {
+		for _, name := range names {
+			if ok, _ := path.Match(p, name); ok {
+				return true
+			}
+		}
+	}
+	return false
+}
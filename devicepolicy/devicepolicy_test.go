@@ -0,0 +1,115 @@
+package devicepolicy
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/zdnscloud/lvmd/commands"
+	"github.com/zdnscloud/lvmd/commands/fake"
+	pb "github.com/zdnscloud/lvmd/proto"
+)
+
+func TestDevicePolicy(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Device Policy Suite")
+}
+
+const gib = 1 << 30
+
+const policy = `
+allow:
+  - paths: ["/dev/disk/by-id/wwn-*"]
+    min_size: 10737418240
+    transports: ["sas", "sata"]
+  - paths: ["/dev/nvme*"]
+deny:
+  - boot_disk: true
+  - mounted: true
+  - paths: ["/dev/disk/by-id/wwn-0x5000c500dead*"]
+`
+
+var _ = Describe("Guard", func() {
+	var dir string
+	var guard *Guard
+
+	BeforeEach(func() {
+		lvm := fake.NewLVM()
+		lvm.AddBlockDevice(fake.Block{Path: "/dev/sda", Size: 100 * gib, Transport: "sata", MountPoint: "/",
+			Links: []string{"disk/by-id/wwn-0x5000c500a1b2c3d0"}})
+		lvm.AddBlockDevice(fake.Block{Path: "/dev/sdb", Size: 100 * gib, Transport: "sas",
+			Links: []string{"disk/by-id/wwn-0x5000c500a1b2c3d4"}})
+		lvm.AddBlockDevice(fake.Block{Path: "/dev/sdc", Size: 5 * gib, Transport: "sas",
+			Links: []string{"disk/by-id/wwn-0x5000c500a1b2c3d5"}})
+		lvm.AddBlockDevice(fake.Block{Path: "/dev/sdd", Size: 100 * gib, Transport: "usb",
+			Links: []string{"disk/by-id/wwn-0x5000c500a1b2c3d6"}})
+		lvm.AddBlockDevice(fake.Block{Path: "/dev/sde", Size: 100 * gib, Transport: "sata", MountPoint: "/var",
+			Links: []string{"disk/by-id/wwn-0x5000c500a1b2c3d7"}})
+		lvm.AddBlockDevice(fake.Block{Path: "/dev/sdf", Size: 100 * gib, Transport: "sata",
+			Links: []string{"disk/by-id/wwn-0x5000c500deadbeef"}})
+		lvm.AddBlockDevice(fake.Block{Path: "/dev/nvme0n1", Size: 1 * gib, Transport: "nvme"})
+		commands.SetExecutor(lvm)
+
+		var err error
+		dir, err = ioutil.TempDir("", "devicepolicy")
+		Expect(err).To(BeNil())
+		file := filepath.Join(dir, "policy.yaml")
+		Expect(ioutil.WriteFile(file, []byte(policy), 0600)).To(Succeed())
+		guard, err = Load(file)
+		Expect(err).To(BeNil())
+	})
+
+	AfterEach(func() {
+		os.RemoveAll(dir)
+	})
+
+	call := func(method string, req interface{}) codes.Code {
+		info := &grpc.UnaryServerInfo{FullMethod: "/lvm.LVM/" + method}
+		_, err := guard.UnaryInterceptor(context.Background(), req, info, func(context.Context, interface{}) (interface{}, error) {
+			return nil, nil
+		})
+		return status.Code(err)
+	}
+
+	It("should allow devices matching an allow rule by path or udev link", func() {
+		Expect(call("CreatePV", &pb.CreatePVRequest{Block: "/dev/sdb"})).To(Equal(codes.OK))
+		Expect(call("CreatePV", &pb.CreatePVRequest{Block: "/dev/disk/by-id/wwn-0x5000c500a1b2c3d4"})).To(Equal(codes.OK))
+		Expect(call("Destory", &pb.DestoryRequest{Block: "/dev/nvme0n1"})).To(Equal(codes.OK))
+	})
+
+	It("should deny devices outside the size range or transports", func() {
+		Expect(call("ExtendVG", &pb.ExtendVGRequest{Name: "k8s", PhysicalVolume: "/dev/sdc"})).To(Equal(codes.PermissionDenied))
+		Expect(call("ExtendVG", &pb.ExtendVGRequest{Name: "k8s", PhysicalVolume: "/dev/sdd"})).To(Equal(codes.PermissionDenied))
+	})
+
+	It("should deny the boot disk, mounted devices and denied paths", func() {
+		Expect(call("Destory", &pb.DestoryRequest{Block: "/dev/sda"})).To(Equal(codes.PermissionDenied))
+		Expect(call("Validate", &pb.ValidateRequest{Block: "/dev/sde"})).To(Equal(codes.PermissionDenied))
+		Expect(call("RemovePV", &pb.RemovePVRequest{Block: "/dev/sdf"})).To(Equal(codes.PermissionDenied))
+	})
+
+	It("should deny unknown devices", func() {
+		Expect(call("CreateVG", &pb.CreateVGRequest{Name: "k8s", PhysicalVolume: "/dev/sdz"})).To(Equal(codes.PermissionDenied))
+	})
+
+	It("should leave calls without a block alone", func() {
+		Expect(call("RemoveVG", &pb.CreateVGRequest{Name: "k8s"})).To(Equal(codes.OK))
+		Expect(call("ListPV", &pb.ListPVRequest{})).To(Equal(codes.OK))
+		Expect(call("RemoveLV", &pb.RemoveLVRequest{VolumeGroup: "k8s", Name: "data"})).To(Equal(codes.OK))
+	})
+
+	It("should reject invalid policies", func() {
+		_, err := New(Policy{Allow: []Rule{{Paths: []string{"/dev/sd["}}}})
+		Expect(err).NotTo(BeNil())
+		_, err = New(Policy{Deny: []Rule{{MinSize: 10 * gib, MaxSize: gib}}})
+		Expect(err).NotTo(BeNil())
+	})
+})
@@ -3,33 +3,180 @@ package main
 import (
 	"flag"
 	"net"
+	"net/http"
+	"os"
+	"strconv"
+	"time"
 
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/reflection"
 
 	"github.com/zdnscloud/cement/log"
+	"golang.org/x/net/context"
+
+	"github.com/zdnscloud/lvmd/auth"
+	"github.com/zdnscloud/lvmd/commands"
+	"github.com/zdnscloud/lvmd/devicepolicy"
+	"github.com/zdnscloud/lvmd/metrics"
+	"github.com/zdnscloud/lvmd/monitor"
 	pb "github.com/zdnscloud/lvmd/proto"
 	"github.com/zdnscloud/lvmd/server"
+	"github.com/zdnscloud/lvmd/tlsutil"
+	"github.com/zdnscloud/lvmd/unixsock"
 )
 
 func main() {
-	var addr string
-	flag.StringVar(&addr, "listen", ":1736", "server listen address")
+	var addr, certFile, keyFile, caFile string
+	var socketMode, socketOwner, allowedUIDs string
+	var policyFile, devicePolicyFile, metricsAddr string
+	var thinPoolConfig monitor.Config
+	var autoextendThreshold, autoextendPercent uint
+	var rescanInterval time.Duration
+	var udevEvents bool
+	var reportFormat string
+	var signatureBackupDir string
+	flag.StringVar(&addr, "listen", ":1736", "server listen address, unix:///path listens on a unix socket")
+	flag.StringVar(&certFile, "tls-cert", "", "server certificate file, serve over tls when set")
+	flag.StringVar(&keyFile, "tls-key", "", "server private key file")
+	flag.StringVar(&caFile, "tls-ca", "", "CA bundle to verify client certificates, require client certificates when set")
+	flag.StringVar(&socketMode, "socket-mode", "0660", "permissions of the unix socket")
+	flag.StringVar(&socketOwner, "socket-owner", "", "owner of the unix socket as user[:group]")
+	flag.StringVar(&allowedUIDs, "socket-allowed-uids", "", "comma separated uids allowed to connect to the unix socket, all when empty")
+	flag.StringVar(&policyFile, "auth-policy", "", "authorization policy file, all callers are allowed everything when empty")
+	flag.StringVar(&devicePolicyFile, "device-policy", "", "policy file of the block devices physical volume calls may use, all devices are allowed when empty")
+	flag.StringVar(&metricsAddr, "metrics-listen", "", "address to serve prometheus metrics on at /metrics, disabled when empty")
+	flag.DurationVar(&thinPoolConfig.Interval, "thinpool-check-interval", time.Minute, "interval between checks of the thin pool usage, 0 disables the checks and autoextend")
+	flag.Float64Var(&thinPoolConfig.DataThreshold, "thinpool-data-threshold", 80, "data usage in percent of thin pools to warn about, 0 disables the warning")
+	flag.Float64Var(&thinPoolConfig.MetadataThreshold, "thinpool-metadata-threshold", 80, "metadata usage in percent of thin pools to warn about, 0 disables the warning")
+	flag.UintVar(&autoextendThreshold, "thinpool-autoextend-threshold", 0, "usage in percent at which thin pools created by lvmd are extended, 0 disables autoextend")
+	flag.UintVar(&autoextendPercent, "thinpool-autoextend-percent", 20, "percent of its size by which a thin pool is extended")
+	flag.DurationVar(&rescanInterval, "inventory-rescan-interval", 30*time.Second, "interval between rescans of the inventory streamed by Watch")
+	flag.BoolVar(&udevEvents, "udev-events", true, "rescan the inventory on udev events of block devices")
+	flag.StringVar(&reportFormat, "report-format", "auto", "output format of the lvm reports, json, basic for lvm before 2.02.158, or auto to detect it")
+	flag.StringVar(&signatureBackupDir, "signature-backup-dir", "/var/lib/lvmd/signatures", "directory Destory saves the erased signatures to for RestoreSignatures, backups are disabled when empty")
 	flag.Parse()
 
 	log.InitLogger(log.Debug)
 	defer log.CloseLogger()
 
-	lis, err := net.Listen("tcp", addr)
-	if err != nil {
-		log.Fatalf("listen failed:%s", err.Error())
+	if rescanInterval <= 0 {
+		log.Fatalf("inventory rescan interval must be positive")
+	}
+	thinPoolConfig.Autoextend = commands.ThinPoolPolicy{Threshold: uint32(autoextendThreshold), Percent: uint32(autoextendPercent)}
+	if err := thinPoolConfig.Autoextend.Validate(); err != nil {
+		log.Fatalf("invalid thin pool autoextend policy:%s", err.Error())
+	}
+
+	switch format := commands.ReportFormat(reportFormat); format {
+	case commands.ReportFormatJSON, commands.ReportFormatBasic:
+		commands.SetReportFormat(format)
+	case "auto":
+		format, err := commands.DetectReportFormat(context.Background())
+		if err != nil {
+			log.Fatalf("detect lvm report format failed:%s", err.Error())
+		}
+		log.Infof("use %s lvm reports", format)
+		commands.SetReportFormat(format)
+	default:
+		log.Fatalf("unknown report format %s", reportFormat)
+	}
+
+	commands.SetSignatureBackupDir(signatureBackupDir)
+
+	var lis net.Listener
+	var opts []grpc.ServerOption
+	if path, ok := unixsock.Path(addr); ok {
+		if certFile != "" || caFile != "" {
+			log.Fatalf("tls isn't supported on unix sockets")
+		}
+		mode, err := strconv.ParseUint(socketMode, 8, 32)
+		if err != nil {
+			log.Fatalf("invalid socket mode %s", socketMode)
+		}
+		uids, err := unixsock.ParseUIDs(allowedUIDs)
+		if err != nil {
+			log.Fatalf("invalid allowed uids:%s", err.Error())
+		}
+		if lis, err = unixsock.Listen(path, os.FileMode(mode), socketOwner); err != nil {
+			log.Fatalf("listen failed:%s", err.Error())
+		}
+		opts = append(opts, grpc.Creds(unixsock.NewCredentials(uids)))
+	} else {
+		var err error
+		if lis, err = net.Listen("tcp", addr); err != nil {
+			log.Fatalf("listen failed:%s", err.Error())
+		}
+	}
+
+	if certFile != "" {
+		creds, err := tlsutil.Load(certFile, keyFile, caFile)
+		if err != nil {
+			log.Fatalf("load tls credentials failed:%s", err.Error())
+		}
+		config, err := creds.ServerConfig()
+		if err != nil {
+			log.Fatalf("load tls credentials failed:%s", err.Error())
+		}
+		opts = append(opts, grpc.Creds(credentials.NewTLS(config)))
+	} else if caFile != "" {
+		log.Fatalf("tls-ca requires tls-cert and tls-key")
+	}
+
+	var unaryInterceptors []grpc.UnaryServerInterceptor
+	var streamInterceptors []grpc.StreamServerInterceptor
+	if metricsAddr != "" {
+		m := metrics.New(30 * time.Second)
+		commands.SetObserver(m.ObserveCommand)
+		unaryInterceptors = append(unaryInterceptors, m.UnaryInterceptor)
+		streamInterceptors = append(streamInterceptors, m.StreamInterceptor)
+		mux := http.NewServeMux()
+		mux.Handle("/metrics", m.Handler())
+		go func() {
+			if err := http.ListenAndServe(metricsAddr, mux); err != nil {
+				log.Fatalf("run metrics server failed:%s", err.Error())
+			}
+		}()
+	}
+	if policyFile != "" {
+		authorizer, err := auth.Load(policyFile)
+		if err != nil {
+			log.Fatalf("load authorization policy failed:%s", err.Error())
+		}
+		unaryInterceptors = append(unaryInterceptors, authorizer.UnaryInterceptor)
+		streamInterceptors = append(streamInterceptors, authorizer.StreamInterceptor)
+	}
+	if devicePolicyFile != "" {
+		guard, err := devicepolicy.Load(devicePolicyFile)
+		if err != nil {
+			log.Fatalf("load device policy failed:%s", err.Error())
+		}
+		unaryInterceptors = append(unaryInterceptors, guard.UnaryInterceptor)
+	}
+	if len(unaryInterceptors) != 0 {
+		opts = append(opts,
+			grpc.UnaryInterceptor(server.ChainUnaryInterceptors(unaryInterceptors...)),
+			grpc.StreamInterceptor(server.ChainStreamInterceptors(streamInterceptors...)))
 	}
 
 	svr := server.NewServer()
-	grpcServer := grpc.NewServer()
+	go svr.RunInventory(context.Background(), rescanInterval, udevEvents)
+	if thinPoolConfig.Interval > 0 {
+		thinPoolConfig.Lock = svr.LockVG
+		watcher := monitor.NewWatcher(thinPoolConfig, func(e monitor.Event) {
+			if e.Above {
+				log.Warnf("%s", e.String())
+			} else {
+				log.Infof("%s", e.String())
+			}
+		})
+		go watcher.Run(context.Background())
+	}
+
+	grpcServer := grpc.NewServer(opts...)
 	reflection.Register(grpcServer)
 	pb.RegisterLVMServer(grpcServer, &svr)
-	if err = grpcServer.Serve(lis); err != nil {
+	if err := grpcServer.Serve(lis); err != nil {
 		log.Fatalf("run grpc server failed:%s", err.Error())
 	}
 }
@@ -0,0 +1,100 @@
+package metrics
+
+import (
+	"strings"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"golang.org/x/net/context"
+
+	"github.com/zdnscloud/lvmd/commands"
+)
+
+var (
+	vgSizeDesc = prometheus.NewDesc(namespace+"_vg_size_bytes",
+		"Size of the volume group.", []string{"vg"}, nil)
+	vgFreeDesc = prometheus.NewDesc(namespace+"_vg_free_bytes",
+		"Free space of the volume group.", []string{"vg"}, nil)
+	pvSizeDesc = prometheus.NewDesc(namespace+"_pv_size_bytes",
+		"Size of the physical volume.", []string{"pv"}, nil)
+	pvUsedDesc = prometheus.NewDesc(namespace+"_pv_used_bytes",
+		"Space of the physical volume allocated to logical volumes.", []string{"pv"}, nil)
+	lvSizeDesc = prometheus.NewDesc(namespace+"_lv_size_bytes",
+		"Size of the logical volume.", []string{"vg", "lv"}, nil)
+	thinPoolDataDesc = prometheus.NewDesc(namespace+"_thinpool_data_percent",
+		"Usage of the data of the thin pool in percent.", []string{"vg", "pool"}, nil)
+	thinPoolMetadataDesc = prometheus.NewDesc(namespace+"_thinpool_metadata_percent",
+		"Usage of the metadata of the thin pool in percent.", []string{"vg", "pool"}, nil)
+)
+
+// capacityCollector reads the capacity from lvm on every scrape, so the
+// metrics are never stale
+type capacityCollector struct {
+	timeout time.Duration
+}
+
+func newCapacityCollector(timeout time.Duration) *capacityCollector {
+	return &capacityCollector{timeout: timeout}
+}
+
+func (c *capacityCollector) Describe(ch chan<- *prometheus.Desc) {
+	for _, desc := range []*prometheus.Desc{vgSizeDesc, vgFreeDesc, pvSizeDesc, pvUsedDesc, lvSizeDesc, thinPoolDataDesc, thinPoolMetadataDesc} {
+		ch <- desc
+	}
+}
+
+func (c *capacityCollector) Collect(ch chan<- prometheus.Metric) {
+	ctx, cancel := context.WithTimeout(context.Background(), c.timeout)
+	defer cancel()
+
+	pvs, err := commands.ListPV(ctx)
+	if err != nil {
+		ch <- prometheus.NewInvalidMetric(pvSizeDesc, err)
+	}
+	for _, pv := range pvs {
+		if pv.Name == "" {
+			continue
+		}
+		ch <- prometheus.MustNewConstMetric(pvSizeDesc, prometheus.GaugeValue, float64(pv.Size), pv.Name)
+		ch <- prometheus.MustNewConstMetric(pvUsedDesc, prometheus.GaugeValue, float64(pv.Usize), pv.Name)
+	}
+
+	vgs, err := commands.ListVG(ctx)
+	if err != nil {
+		ch <- prometheus.NewInvalidMetric(vgSizeDesc, err)
+		return
+	}
+	for _, vg := range vgs {
+		if vg.Name == "" {
+			continue
+		}
+		ch <- prometheus.MustNewConstMetric(vgSizeDesc, prometheus.GaugeValue, float64(vg.Size), vg.Name)
+		ch <- prometheus.MustNewConstMetric(vgFreeDesc, prometheus.GaugeValue, float64(vg.FreeSize), vg.Name)
+		c.collectLVs(ctx, ch, vg.Name)
+	}
+}
+
+func (c *capacityCollector) collectLVs(ctx context.Context, ch chan<- prometheus.Metric, vg string) {
+	lvs, err := commands.ListLV(ctx, vg)
+	if err != nil {
+		ch <- prometheus.NewInvalidMetric(lvSizeDesc, err)
+		return
+	}
+	for _, lv := range lvs {
+		// hidden volumes like the data of thin pools are named in brackets
+		if lv.Name == "" || strings.HasPrefix(lv.Name, "[") {
+			continue
+		}
+		ch <- prometheus.MustNewConstMetric(lvSizeDesc, prometheus.GaugeValue, float64(lv.Size), vg, lv.Name)
+	}
+
+	pools, err := commands.ListThinPools(ctx, vg)
+	if err != nil {
+		ch <- prometheus.NewInvalidMetric(thinPoolDataDesc, err)
+		return
+	}
+	for _, pool := range pools {
+		ch <- prometheus.MustNewConstMetric(thinPoolDataDesc, prometheus.GaugeValue, pool.DataPercent, vg, pool.Name)
+		ch <- prometheus.MustNewConstMetric(thinPoolMetadataDesc, prometheus.GaugeValue, pool.MetadataPercent, vg, pool.Name)
+	}
+}
@@ -0,0 +1,121 @@
+// Package metrics exposes the capacity of the volume groups, physical
+// volumes, thin pools and logical volumes along with the calls to the LVM
+// service and the lvm commands they run as Prometheus metrics.
+package metrics
+
+import (
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+)
+
+const namespace = "lvmd"
+
+// Metrics holds the metrics of a daemon in a registry of its own
+type Metrics struct {
+	registry        *prometheus.Registry
+	requests        *prometheus.CounterVec
+	errors          *prometheus.CounterVec
+	latency         *prometheus.HistogramVec
+	commandDuration *prometheus.HistogramVec
+	commandFailures *prometheus.CounterVec
+}
+
+// New returns the metrics of the daemon, the capacity is read from lvm
+// whenever the metrics are scraped, within scrapeTimeout
+func New(scrapeTimeout time.Duration) *Metrics {
+	m := &Metrics{
+		registry: prometheus.NewRegistry(),
+		requests: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: "grpc",
+			Name:      "requests_total",
+			Help:      "Number of calls to the LVM service.",
+		}, []string{"method"}),
+		errors: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: "grpc",
+			Name:      "errors_total",
+			Help:      "Number of failed calls to the LVM service by gRPC code.",
+		}, []string{"method", "code"}),
+		latency: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: "grpc",
+			Name:      "request_duration_seconds",
+			Help:      "Duration of the calls to the LVM service.",
+			Buckets:   []float64{.005, .01, .05, .1, .25, .5, 1, 2.5, 5, 10, 30, 60, 300},
+		}, []string{"method"}),
+		commandDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: "command",
+			Name:      "duration_seconds",
+			Help:      "Duration of the executions of the lvm and block device tools.",
+			Buckets:   []float64{.01, .05, .1, .25, .5, 1, 2.5, 5, 10, 30, 60, 300},
+		}, []string{"command"}),
+		commandFailures: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: "command",
+			Name:      "failures_total",
+			Help:      "Number of executions of the lvm and block device tools which failed.",
+		}, []string{"command"}),
+	}
+	m.registry.MustRegister(
+		m.requests,
+		m.errors,
+		m.latency,
+		m.commandDuration,
+		m.commandFailures,
+		newCapacityCollector(scrapeTimeout),
+		prometheus.NewGoCollector(),
+		prometheus.NewProcessCollector(prometheus.ProcessCollectorOpts{}),
+	)
+	return m
+}
+
+// Handler serves the metrics, a failure to read the capacity of some
+// objects leaves out their metrics rather than failing the scrape
+func (m *Metrics) Handler() http.Handler {
+	return promhttp.HandlerFor(m.registry, promhttp.HandlerOpts{ErrorHandling: promhttp.ContinueOnError})
+}
+
+// ObserveCommand records an execution of a command, it's meant to be set
+// with commands.SetObserver
+func (m *Metrics) ObserveCommand(name string, duration time.Duration, err error) {
+	m.commandDuration.WithLabelValues(name).Observe(duration.Seconds())
+	if err != nil {
+		m.commandFailures.WithLabelValues(name).Inc()
+	}
+}
+
+// UnaryInterceptor records the unary calls
+func (m *Metrics) UnaryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	start := time.Now()
+	resp, err := handler(ctx, req)
+	m.observe(info.FullMethod, time.Since(start), err)
+	return resp, err
+}
+
+// StreamInterceptor records the streaming calls, their duration is the
+// time until the stream ends
+func (m *Metrics) StreamInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	start := time.Now()
+	err := handler(srv, ss)
+	m.observe(info.FullMethod, time.Since(start), err)
+	return err
+}
+
+func (m *Metrics) observe(fullMethod string, duration time.Duration, err error) {
+	// "/lvm.LVM/CreateLV" is recorded as "CreateLV"
+	method := fullMethod[strings.LastIndex(fullMethod, "/")+1:]
+	m.requests.WithLabelValues(method).Inc()
+	m.latency.WithLabelValues(method).Observe(duration.Seconds())
+	if err != nil {
+		m.errors.WithLabelValues(method, status.Code(err).String()).Inc()
+	}
+}
@@ -0,0 +1,101 @@
+package metrics
+
+import (
+	"io/ioutil"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/zdnscloud/lvmd/commands"
+	"github.com/zdnscloud/lvmd/commands/fake"
+)
+
+const gib uint64 = 1024 * 1024 * 1024
+
+func TestMetrics(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Metrics Suite")
+}
+
+var _ = Describe("Metrics", func() {
+	var lvm *fake.LVM
+	var m *Metrics
+	var ctx context.Context
+
+	BeforeEach(func() {
+		lvm = fake.NewLVM()
+		lvm.AddBlock("/dev/sdb", 10*gib)
+		commands.SetExecutor(lvm)
+		m = New(time.Second)
+		ctx = context.Background()
+
+		_, err := commands.CreatePV(ctx, "/dev/sdb")
+		Expect(err).To(BeNil())
+		_, err = commands.CreateVG(ctx, "k8s", "/dev/sdb", nil)
+		Expect(err).To(BeNil())
+		_, err = commands.CreateThinPool(ctx, "k8s", "pool", commands.ThinPoolOptions{Size: 4 * gib})
+		Expect(err).To(BeNil())
+		_, err = commands.CreateThinLV(ctx, "k8s/pool", "thin", gib, 0, nil)
+		Expect(err).To(BeNil())
+		Expect(lvm.SetPoolUsage("k8s", "pool", 12.5, 3)).To(Succeed())
+	})
+
+	AfterEach(func() {
+		commands.SetObserver(nil)
+	})
+
+	scrape := func() string {
+		recorder := httptest.NewRecorder()
+		m.Handler().ServeHTTP(recorder, httptest.NewRequest("GET", "/metrics", nil))
+		Expect(recorder.Code).To(Equal(200))
+		body, err := ioutil.ReadAll(recorder.Body)
+		Expect(err).To(BeNil())
+		return string(body)
+	}
+
+	It("should report the capacity", func() {
+		metrics := scrape()
+		Expect(metrics).To(MatchRegexp(`lvmd_vg_size_bytes{vg="k8s"} 1.07\d*e\+10`))
+		Expect(metrics).To(MatchRegexp(`lvmd_vg_free_bytes{vg="k8s"} 6.4\d*e\+09`))
+		Expect(metrics).To(MatchRegexp(`lvmd_pv_size_bytes{pv="/dev/sdb"} 1.07\d*e\+10`))
+		Expect(metrics).To(MatchRegexp(`lvmd_pv_used_bytes{pv="/dev/sdb"} 4.3\d*e\+09`))
+		Expect(metrics).To(ContainSubstring(`lvmd_lv_size_bytes{lv="pool",vg="k8s"} 4.294967296e+09`))
+		Expect(metrics).To(ContainSubstring(`lvmd_lv_size_bytes{lv="thin",vg="k8s"} 1.073741824e+09`))
+		Expect(metrics).To(ContainSubstring(`lvmd_thinpool_data_percent{pool="pool",vg="k8s"} 12.5`))
+		Expect(metrics).To(ContainSubstring(`lvmd_thinpool_metadata_percent{pool="pool",vg="k8s"} 3`))
+	})
+
+	It("should record the calls by method and code", func() {
+		info := &grpc.UnaryServerInfo{FullMethod: "/lvm.LVM/CreateLV"}
+		m.UnaryInterceptor(ctx, nil, info, func(context.Context, interface{}) (interface{}, error) {
+			return nil, nil
+		})
+		m.UnaryInterceptor(ctx, nil, info, func(context.Context, interface{}) (interface{}, error) {
+			return nil, status.Error(codes.ResourceExhausted, "no space")
+		})
+		metrics := scrape()
+		Expect(metrics).To(ContainSubstring(`lvmd_grpc_requests_total{method="CreateLV"} 2`))
+		Expect(metrics).To(ContainSubstring(`lvmd_grpc_errors_total{code="ResourceExhausted",method="CreateLV"} 1`))
+		Expect(metrics).To(ContainSubstring(`lvmd_grpc_request_duration_seconds_count{method="CreateLV"} 2`))
+	})
+
+	It("should record the executions of commands", func() {
+		commands.SetObserver(m.ObserveCommand)
+		_, err := commands.ListVG(ctx)
+		Expect(err).To(BeNil())
+		_, err = commands.GetVG(ctx, "missing")
+		Expect(err).NotTo(BeNil())
+		// the scrape runs commands too
+		commands.SetObserver(nil)
+		metrics := scrape()
+		Expect(metrics).To(ContainSubstring(`lvmd_command_duration_seconds_count{command="vgs"} 2`))
+		Expect(metrics).To(ContainSubstring(`lvmd_command_failures_total{command="vgs"} 1`))
+	})
+})
@@ -0,0 +1,212 @@
+// Package monitor watches the usage of thin pools, reports when it crosses
+// the configured thresholds and extends the pools according to their
+// autoextend policy. A thin pool running out of data or metadata space
+// fails the writes of all its thin volumes.
+package monitor
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/zdnscloud/cement/log"
+	"golang.org/x/net/context"
+
+	"github.com/zdnscloud/lvmd/commands"
+	"github.com/zdnscloud/lvmd/parser"
+)
+
+// Kinds of space of a thin pool
+const (
+	KindData     = "data"
+	KindMetadata = "metadata"
+)
+
+// Config configures a Watcher, a threshold of 0 disables the check of that
+// kind of space
+type Config struct {
+	// Interval between two checks of all thin pools
+	Interval time.Duration
+	// DataThreshold and MetadataThreshold are the usage in percent at which
+	// a pool is reported
+	DataThreshold     float64
+	MetadataThreshold float64
+	// Autoextend is the policy of the thin pools created by lvmd which
+	// have no policy of their own
+	Autoextend commands.ThinPoolPolicy
+	// Lock serializes the extension of a pool with the other changes of
+	// its volume group, nothing is locked when it's nil
+	Lock func(ctx context.Context, vg string) (unlock func(), err error)
+}
+
+// Event reports that the usage of Kind space of a thin pool crossed
+// Threshold, upwards when Above is true, back below it otherwise
+type Event struct {
+	VolumeGroup string
+	Pool        string
+	Kind        string
+	Percent     float64
+	Threshold   float64
+	Above       bool
+}
+
+func (e Event) String() string {
+	direction := "dropped below"
+	if e.Above {
+		direction = "reached"
+	}
+	return fmt.Sprintf("%s usage of thin pool %s/%s %s %.2f%%: %.2f%%",
+		e.Kind, e.VolumeGroup, e.Pool, direction, e.Threshold, e.Percent)
+}
+
+// Watcher checks the thin pools of all volume groups periodically, each
+// crossing of a threshold is reported once to the handler
+type Watcher struct {
+	config  Config
+	handler func(Event)
+	// above holds the pools and kinds of space which are above threshold
+	above map[string]bool
+}
+
+// NewWatcher returns a watcher reporting events to handler
+func NewWatcher(config Config, handler func(Event)) *Watcher {
+	return &Watcher{
+		config:  config,
+		handler: handler,
+		above:   make(map[string]bool),
+	}
+}
+
+// Run checks the thin pools until ctx is done, a failed check is logged and
+// retried at the next interval
+func (w *Watcher) Run(ctx context.Context) {
+	ticker := time.NewTicker(w.config.Interval)
+	defer ticker.Stop()
+	for {
+		if err := w.check(ctx); err != nil && ctx.Err() == nil {
+			log.Warnf("check thin pools failed:%s", err.Error())
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (w *Watcher) check(ctx context.Context) error {
+	vgs, err := commands.ListVG(ctx)
+	if err != nil {
+		return err
+	}
+	seen := make(map[string]bool)
+	for _, vg := range vgs {
+		if vg.Name == "" {
+			continue
+		}
+		pools, err := commands.ListThinPools(ctx, vg.Name)
+		if err != nil {
+			return err
+		}
+		for _, pool := range pools {
+			if policy, ok := w.policy(pool); ok {
+				if pool, err = w.autoextend(ctx, vg.Name, pool, policy); err != nil {
+					return err
+				}
+			}
+			w.update(seen, vg.Name, pool.Name, KindData, pool.DataPercent, w.config.DataThreshold)
+			w.update(seen, vg.Name, pool.Name, KindMetadata, pool.MetadataPercent, w.config.MetadataThreshold)
+		}
+	}
+	// removed pools are reported again when they are recreated
+	for key := range w.above {
+		if !seen[key] {
+			delete(w.above, key)
+		}
+	}
+	return nil
+}
+
+// policy returns the autoextend policy of pool, ok is false when the pool
+// isn't extended by lvmd
+func (w *Watcher) policy(pool *parser.ThinPool) (commands.ThinPoolPolicy, bool) {
+	policy, ok := commands.ThinPoolPolicyFromTags(pool.Tags)
+	if !ok {
+		for _, tag := range pool.Tags {
+			if tag == commands.ManagedTag {
+				policy, ok = w.config.Autoextend, true
+			}
+		}
+	}
+	return policy, ok && policy.Enabled()
+}
+
+// autoextend extends the data and metadata of pool which reached the
+// threshold of policy and returns the usage of the extended pool. A failed
+// extension is logged and retried at the next check
+func (w *Watcher) autoextend(ctx context.Context, vg string, pool *parser.ThinPool, policy commands.ThinPoolPolicy) (*parser.ThinPool, error) {
+	threshold := float64(policy.Threshold)
+	data := pool.DataPercent >= threshold
+	metadata := pool.MetadataPercent >= threshold
+	if !data && !metadata {
+		return pool, nil
+	}
+	if w.config.Lock != nil {
+		unlock, err := w.config.Lock(ctx, vg)
+		if err != nil {
+			return nil, err
+		}
+		defer unlock()
+	}
+
+	if data {
+		if _, err := commands.ExtendThinPoolData(ctx, vg, pool.Name, policy.Percent); err != nil {
+			log.Warnf("extend data of thin pool %s/%s at %.2f%% usage failed:%s", vg, pool.Name, pool.DataPercent, err.Error())
+			data = false
+		}
+	}
+	if metadata {
+		if _, err := commands.ExtendThinPoolMetadata(ctx, vg, pool.Name, policy.Percent); err != nil {
+			log.Warnf("extend metadata of thin pool %s/%s at %.2f%% usage failed:%s", vg, pool.Name, pool.MetadataPercent, err.Error())
+			metadata = false
+		}
+	}
+
+	extended, err := commands.GetThinPoolStatus(ctx, vg, pool.Name)
+	if err != nil {
+		return nil, err
+	}
+	if data {
+		log.Infof("extended data of thin pool %s/%s at %.2f%% usage from %d to %d bytes",
+			vg, pool.Name, pool.DataPercent, pool.Size, extended.Size)
+	}
+	if metadata {
+		log.Infof("extended metadata of thin pool %s/%s at %.2f%% usage from %d to %d bytes",
+			vg, pool.Name, pool.MetadataPercent, pool.MetadataSize, extended.MetadataSize)
+	}
+	return extended, nil
+}
+
+func (w *Watcher) update(seen map[string]bool, vg, pool, kind string, percent, threshold float64) {
+	if threshold == 0 {
+		return
+	}
+	key := vg + "/" + pool + "/" + kind
+	seen[key] = true
+	above := percent >= threshold
+	if above == w.above[key] {
+		return
+	}
+	if above {
+		w.above[key] = true
+	} else {
+		delete(w.above, key)
+	}
+	w.handler(Event{
+		VolumeGroup: vg,
+		Pool:        pool,
+		Kind:        kind,
+		Percent:     percent,
+		Threshold:   threshold,
+		Above:       above,
+	})
+}
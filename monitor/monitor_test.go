@@ -0,0 +1,151 @@
+package monitor
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/zdnscloud/cement/log"
+	"golang.org/x/net/context"
+
+	"github.com/zdnscloud/lvmd/commands"
+	"github.com/zdnscloud/lvmd/commands/fake"
+	"github.com/zdnscloud/lvmd/parser"
+)
+
+const gib uint64 = 1024 * 1024 * 1024
+
+func TestMonitor(t *testing.T) {
+	log.InitLogger(log.Debug)
+	defer log.CloseLogger()
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Monitor Suite")
+}
+
+var _ = Describe("Watcher", func() {
+	var lvm *fake.LVM
+	var ctx context.Context
+	var events []Event
+	var watcher *Watcher
+
+	BeforeEach(func() {
+		lvm = fake.NewLVM()
+		lvm.AddBlock("/dev/sdb", 10*gib)
+		commands.SetExecutor(lvm)
+		ctx = context.Background()
+		events = nil
+		watcher = NewWatcher(Config{Interval: 10 * time.Millisecond, DataThreshold: 80, MetadataThreshold: 60}, func(e Event) {
+			events = append(events, e)
+		})
+
+		_, err := commands.CreatePV(ctx, "/dev/sdb")
+		Expect(err).To(BeNil())
+		_, err = commands.CreateVG(ctx, "k8s", "/dev/sdb", nil)
+		Expect(err).To(BeNil())
+		_, err = commands.CreateThinPool(ctx, "k8s", "pool", commands.ThinPoolOptions{Size: gib})
+		Expect(err).To(BeNil())
+	})
+
+	status := func() *parser.ThinPool {
+		pool, err := commands.GetThinPoolStatus(ctx, "k8s", "pool")
+		Expect(err).To(BeNil())
+		return pool
+	}
+
+	It("should report each crossing of a threshold once", func() {
+		Expect(watcher.check(ctx)).To(Succeed())
+		Expect(events).To(BeEmpty())
+
+		Expect(lvm.SetPoolUsage("k8s", "pool", 85, 10)).To(Succeed())
+		Expect(watcher.check(ctx)).To(Succeed())
+		Expect(watcher.check(ctx)).To(Succeed())
+		Expect(events).To(Equal([]Event{
+			{VolumeGroup: "k8s", Pool: "pool", Kind: KindData, Percent: 85, Threshold: 80, Above: true},
+		}))
+
+		Expect(lvm.SetPoolUsage("k8s", "pool", 50, 60)).To(Succeed())
+		Expect(watcher.check(ctx)).To(Succeed())
+		Expect(events[1:]).To(ConsistOf(
+			Event{VolumeGroup: "k8s", Pool: "pool", Kind: KindData, Percent: 50, Threshold: 80},
+			Event{VolumeGroup: "k8s", Pool: "pool", Kind: KindMetadata, Percent: 60, Threshold: 60, Above: true},
+		))
+	})
+
+	It("should skip disabled thresholds", func() {
+		watcher.config.MetadataThreshold = 0
+		Expect(lvm.SetPoolUsage("k8s", "pool", 10, 99)).To(Succeed())
+		Expect(watcher.check(ctx)).To(Succeed())
+		Expect(events).To(BeEmpty())
+	})
+
+	It("should extend managed pools with the default policy", func() {
+		watcher.config.Autoextend = commands.ThinPoolPolicy{Threshold: 70, Percent: 50}
+		Expect(lvm.SetPoolUsage("k8s", "pool", 90, 10)).To(Succeed())
+		Expect(watcher.check(ctx)).To(Succeed())
+		Expect(status().Size).To(Equal(gib + gib/2))
+		Expect(status().DataPercent).To(Equal(60.0))
+		// the pool was extended before it was reported
+		Expect(events).To(BeEmpty())
+
+		Expect(watcher.check(ctx)).To(Succeed())
+		Expect(status().Size).To(Equal(gib + gib/2))
+	})
+
+	It("should extend pools with their own policy", func() {
+		_, err := commands.SetThinPoolPolicy(ctx, "k8s", "pool", commands.ThinPoolPolicy{Threshold: 50, Percent: 100})
+		Expect(err).To(BeNil())
+		watcher.config.Autoextend = commands.ThinPoolPolicy{Threshold: 70, Percent: 50}
+		Expect(lvm.SetPoolUsage("k8s", "pool", 10, 55)).To(Succeed())
+		Expect(watcher.check(ctx)).To(Succeed())
+		Expect(status().Size).To(Equal(gib))
+		Expect(status().MetadataSize).To(Equal(2 * fake.ExtentSize))
+		Expect(status().MetadataPercent).To(Equal(27.5))
+
+		_, err = commands.SetThinPoolPolicy(ctx, "k8s", "pool", commands.ThinPoolPolicy{})
+		Expect(err).To(BeNil())
+		Expect(lvm.SetPoolUsage("k8s", "pool", 95, 95)).To(Succeed())
+		Expect(watcher.check(ctx)).To(Succeed())
+		Expect(status().Size).To(Equal(gib))
+	})
+
+	It("should leave pools it didn't create alone", func() {
+		_, err := commands.RemoveLV(ctx, "k8s", "pool")
+		Expect(err).To(BeNil())
+		_, _, err = lvm.Run(ctx, nil, "lvcreate", "-L", "1073741824b", "--thinpool", "pool", "k8s")
+		Expect(err).To(BeNil())
+		watcher.config.Autoextend = commands.ThinPoolPolicy{Threshold: 70, Percent: 50}
+		Expect(lvm.SetPoolUsage("k8s", "pool", 90, 10)).To(Succeed())
+		Expect(watcher.check(ctx)).To(Succeed())
+		Expect(status().Size).To(Equal(gib))
+	})
+
+	It("should bound extensions by the free space", func() {
+		watcher.config.Autoextend = commands.ThinPoolPolicy{Threshold: 70, Percent: 1000}
+		Expect(lvm.SetPoolUsage("k8s", "pool", 90, 10)).To(Succeed())
+		Expect(watcher.check(ctx)).To(Succeed())
+		vg, err := commands.GetVG(ctx, "k8s")
+		Expect(err).To(BeNil())
+		Expect(vg.FreeSize).To(BeZero())
+
+		// a full volume group leaves the pool as it is
+		Expect(lvm.SetPoolUsage("k8s", "pool", 90, 10)).To(Succeed())
+		Expect(watcher.check(ctx)).To(Succeed())
+		Expect(events).To(HaveLen(1))
+	})
+
+	It("should check until the context is done", func() {
+		Expect(lvm.SetPoolUsage("k8s", "pool", 90, 10)).To(Succeed())
+		reported := make(chan Event, 1)
+		watcher.handler = func(e Event) { reported <- e }
+		ctx, cancel := context.WithCancel(ctx)
+		done := make(chan struct{})
+		go func() {
+			watcher.Run(ctx)
+			close(done)
+		}()
+		Eventually(reported).Should(Receive())
+		cancel()
+		Eventually(done).Should(BeClosed())
+	})
+})
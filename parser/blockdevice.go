@@ -0,0 +1,189 @@
+package parser
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	pb "github.com/zdnscloud/lvmd/proto"
+)
+
+// BlockDevice is a block device reported by lsblk. Links and the physical
+// volume fields aren't reported by lsblk, they are filled in by the caller
+type BlockDevice struct {
+	Name           string
+	Path           string
+	Size           uint64
+	Type           string
+	Rotational     bool
+	Removable      bool
+	Model          string
+	Serial         string
+	WWN            string
+	Transport      string
+	PartitionTable string
+	Filesystem     string
+	Mountpoints    []string
+	// Parent is the disk of a partition, Holders are the devices stacked on
+	// the device like the volumes of a physical volume
+	Parent         string
+	Holders        []string
+	Links          []string
+	PhysicalVolume bool
+	VolumeGroup    string
+}
+
+func (d BlockDevice) ToProto() *pb.BlockDevice {
+	return &pb.BlockDevice{
+		Name:           d.Name,
+		Path:           d.Path,
+		Size:           d.Size,
+		Type:           d.Type,
+		Rotational:     d.Rotational,
+		Removable:      d.Removable,
+		Model:          d.Model,
+		Serial:         d.Serial,
+		Wwn:            d.WWN,
+		Transport:      d.Transport,
+		PartitionTable: d.PartitionTable,
+		Filesystem:     d.Filesystem,
+		Mountpoints:    d.Mountpoints,
+		Parent:         d.Parent,
+		Holders:        d.Holders,
+		Links:          d.Links,
+		PhysicalVolume: d.PhysicalVolume,
+		VolumeGroup:    d.VolumeGroup,
+	}
+}
+
+// utilValue accepts the values of the json reports of every util-linux
+// version, older versions report all values as strings while newer ones
+// use numbers, booleans and null
+type utilValue string
+
+func (v *utilValue) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, []byte("null")) {
+		*v = ""
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(data, &s); err == nil {
+		*v = utilValue(strings.TrimSpace(s))
+		return nil
+	}
+	var value interface{}
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	if err := decoder.Decode(&value); err != nil {
+		return err
+	}
+	switch value := value.(type) {
+	case bool:
+		if value {
+			*v = "1"
+		} else {
+			*v = "0"
+		}
+	case json.Number:
+		*v = utilValue(value.String())
+	default:
+		return fmt.Errorf("unexpected value %s", data)
+	}
+	return nil
+}
+
+type lsblkDevice struct {
+	Name       utilValue      `json:"name"`
+	Path       utilValue      `json:"path"`
+	Size       utilValue      `json:"size"`
+	Type       utilValue      `json:"type"`
+	Rota       utilValue      `json:"rota"`
+	RM         utilValue      `json:"rm"`
+	Model      utilValue      `json:"model"`
+	Serial     utilValue      `json:"serial"`
+	WWN        utilValue      `json:"wwn"`
+	Tran       utilValue      `json:"tran"`
+	PTType     utilValue      `json:"pttype"`
+	FSType     utilValue      `json:"fstype"`
+	Mountpoint utilValue      `json:"mountpoint"`
+	Children   []*lsblkDevice `json:"children"`
+}
+
+// ParseBlockDevices parses the tree reported by
+//
+//	lsblk --json --bytes --output NAME,PATH,SIZE,TYPE,ROTA,RM,MODEL,SERIAL,WWN,TRAN,PTTYPE,FSTYPE,MOUNTPOINT
+//
+// into a list of the devices, each reported once even when it is the
+// holder of several devices like a volume spanning physical volumes
+func ParseBlockDevices(data string) ([]*BlockDevice, error) {
+	var report struct {
+		BlockDevices []*lsblkDevice `json:"blockdevices"`
+	}
+	if err := json.Unmarshal([]byte(data), &report); err != nil {
+		return nil, fmt.Errorf("failed to parse lsblk report: %v", err)
+	}
+
+	var devices []*BlockDevice
+	byName := make(map[string]*BlockDevice)
+	var walk func(d *lsblkDevice, parent *BlockDevice) error
+	walk = func(d *lsblkDevice, parent *BlockDevice) error {
+		dev, ok := byName[string(d.Name)]
+		if !ok {
+			var err error
+			if dev, err = blockDeviceFromLsblk(d); err != nil {
+				return err
+			}
+			byName[dev.Name] = dev
+			devices = append(devices, dev)
+		}
+		if parent != nil {
+			if dev.Type == "part" {
+				dev.Parent = parent.Name
+			} else {
+				parent.Holders = append(parent.Holders, dev.Name)
+			}
+		}
+		// the devices stacked on a device were reported the first time
+		if ok {
+			return nil
+		}
+		for _, child := range d.Children {
+			if err := walk(child, dev); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	for _, d := range report.BlockDevices {
+		if err := walk(d, nil); err != nil {
+			return nil, err
+		}
+	}
+	return devices, nil
+}
+
+func blockDeviceFromLsblk(d *lsblkDevice) (*BlockDevice, error) {
+	size, err := parseOptionalUint(string(d.Size), 64)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse size of %s: %v", d.Name, err)
+	}
+	dev := &BlockDevice{
+		Name:           string(d.Name),
+		Path:           string(d.Path),
+		Size:           size,
+		Type:           string(d.Type),
+		Rotational:     d.Rota == "1",
+		Removable:      d.RM == "1",
+		Model:          string(d.Model),
+		Serial:         string(d.Serial),
+		WWN:            string(d.WWN),
+		Transport:      string(d.Tran),
+		PartitionTable: string(d.PTType),
+		Filesystem:     string(d.FSType),
+	}
+	if d.Mountpoint != "" {
+		dev.Mountpoints = []string{string(d.Mountpoint)}
+	}
+	return dev, nil
+}
@@ -273,27 +273,171 @@ type LV struct {
 	ActualDevMajNumber int32
 	ActualDevMinNumber int32
 	Tags               []string
+	Origin             string
+	SnapPercent        string
+	PoolLV             string
+	// usage of thin pools and thin volumes
+	DataPercent     float64
+	MetadataPercent float64
+	// the layout of the first segment and the devices of all segments, set
+	// by SetSegments
+	SegType    string
+	Stripes    uint32
+	StripeSize uint64
+	RegionSize uint64
+	Devices    []string
+	Segments   []Segment
+}
+
+// Segment is a segment of a logical volume as reported by lvs --segments,
+// Start and Size are in bytes
+type Segment struct {
+	LVUUID     string
+	Start      uint64
+	Size       uint64
+	Type       string
+	Stripes    uint32
+	StripeSize uint64
+	RegionSize uint64
+	Devices    []SegmentDevice
+}
+
+// SegmentDevice is a physical volume or hidden logical volume a segment is
+// on, starting at StartExtent
+type SegmentDevice struct {
+	Name        string
+	StartExtent uint64
+}
+
+// SetSegments sets the segments of the volume, the layout of the volume is
+// taken from its first segment
+func (lv *LV) SetSegments(segments []Segment) {
+	lv.Segments = segments
+	lv.SegType, lv.Stripes, lv.StripeSize, lv.RegionSize = "", 0, 0, 0
+	lv.Devices = nil
+	if len(segments) == 0 {
+		return
+	}
+	first := segments[0]
+	lv.SegType = first.Type
+	lv.Stripes = first.Stripes
+	lv.StripeSize = first.StripeSize
+	lv.RegionSize = first.RegionSize
+	seen := make(map[string]bool)
+	for _, seg := range segments {
+		for _, dev := range seg.Devices {
+			if !seen[dev.Name] {
+				seen[dev.Name] = true
+				lv.Devices = append(lv.Devices, dev.Name)
+			}
+		}
+	}
 }
 
 type VG struct {
-	Name     string
-	Size     uint64
-	FreeSize uint64
-	UUID     string
-	Tags     []string
+	Name            string
+	Size            uint64
+	FreeSize        uint64
+	UUID            string
+	Tags            []string
+	ExtentSize      uint64
+	ExtentCount     uint64
+	FreeExtentCount uint64
+	PVCount         uint32
+	LVCount         uint32
+	SnapCount       uint32
+	MaxLV           uint32
+	MaxPV           uint32
+	Attributes      VGAttributes
+	LockType        string
+}
+
+// VGAttributes is the decoded vg_attr of a volume group
+type VGAttributes struct {
+	Writeable  bool
+	Resizeable bool
+	Exported   bool
+	Partial    bool
+	Clustered  bool
+	Shared     bool
+}
+
+// ToProto returns lvm.VolumeGroup.Attributes representation of struct
+func (a VGAttributes) ToProto() *pb.VolumeGroup_Attributes {
+	return &pb.VolumeGroup_Attributes{
+		Writeable:  a.Writeable,
+		Resizeable: a.Resizeable,
+		Exported:   a.Exported,
+		Partial:    a.Partial,
+		Clustered:  a.Clustered,
+		Shared:     a.Shared,
+	}
 }
 
 type PV struct {
-	Name  string
-	UUID  string
-	Fmt   string
-	Size  uint64
-	Usize uint64
-	Fsize uint64
+	Name         string
+	UUID         string
+	Fmt          string
+	Size         uint64
+	Usize        uint64
+	Fsize        uint64
+	VGName       string
+	PECount      uint64
+	PEAllocCount uint64
+	Attributes   PVAttributes
+	DevSize      uint64
+	MdaCount     uint32
+	MdaSize      uint64
+	Tags         []string
+}
+
+// PVAttributes is the decoded pv_attr of a physical volume
+type PVAttributes struct {
+	Allocatable bool
+	Exported    bool
+	Missing     bool
+}
+
+// ToProto returns lvm.PVInfo.Attributes representation of struct
+func (a PVAttributes) ToProto() *pb.PVInfo_Attributes {
+	return &pb.PVInfo_Attributes{
+		Allocatable: a.Allocatable,
+		Exported:    a.Exported,
+		Missing:     a.Missing,
+	}
+}
+
+// ThinPool is the usage of a thin pool, VirtualSize and ThinVolumes sum up
+// the thin volumes in the pool
+type ThinPool struct {
+	Name            string
+	VolumeGroup     string
+	Size            uint64
+	MetadataSize    uint64
+	DataPercent     float64
+	MetadataPercent float64
+	ChunkSize       uint64
+	TransactionID   uint64
+	VirtualSize     uint64
+	ThinVolumes     uint32
+	Tags            []string
+}
+
+// OvercommitRatio returns the virtual size of the thin volumes relative to
+// the size of the pool, the pool is overcommitted above 1
+func (p ThinPool) OvercommitRatio() float64 {
+	if p.Size == 0 {
+		return 0
+	}
+	return float64(p.VirtualSize) / float64(p.Size)
 }
 
 // ToProto returns lvm.LogicalVolume representation of struct
 func (lv LV) ToProto() *pb.LogicalVolume {
+	var segments []*pb.LogicalVolume_Segment
+	for _, seg := range lv.Segments {
+		segments = append(segments, seg.ToProto())
+	}
 	return &pb.LogicalVolume{
 		Name:                 lv.Name,
 		Size:                 lv.Size,
@@ -303,38 +447,88 @@ func (lv LV) ToProto() *pb.LogicalVolume {
 		ActualDevMajorNumber: uint32(lv.ActualDevMajNumber),
 		ActualDevMinorNumber: uint32(lv.ActualDevMinNumber),
 		Tags:                 lv.Tags,
+		Origin:               lv.Origin,
+		SnapPercent:          lv.SnapPercent,
+		PoolLv:               lv.PoolLV,
+		DataPercent:          lv.DataPercent,
+		MetadataPercent:      lv.MetadataPercent,
+		Segtype:              lv.SegType,
+		Stripes:              lv.Stripes,
+		StripeSize:           lv.StripeSize,
+		RegionSize:           lv.RegionSize,
+		Devices:              lv.Devices,
+		Segments:             segments,
+	}
+}
+
+func (s Segment) ToProto() *pb.LogicalVolume_Segment {
+	devices := make([]*pb.LogicalVolume_Device, len(s.Devices))
+	for i, dev := range s.Devices {
+		devices[i] = &pb.LogicalVolume_Device{
+			Name:        dev.Name,
+			StartExtent: dev.StartExtent,
+		}
+	}
+	return &pb.LogicalVolume_Segment{
+		Start:      s.Start,
+		Size:       s.Size,
+		Segtype:    s.Type,
+		Stripes:    s.Stripes,
+		StripeSize: s.StripeSize,
+		RegionSize: s.RegionSize,
+		Devices:    devices,
 	}
 }
 
 func (vg VG) ToProto() *pb.VolumeGroup {
 	return &pb.VolumeGroup{
-		Name:     vg.Name,
-		Size:     vg.Size,
-		FreeSize: vg.FreeSize,
-		Uuid:     vg.UUID,
-		Tags:     vg.Tags,
+		Name:            vg.Name,
+		Size:            vg.Size,
+		FreeSize:        vg.FreeSize,
+		Uuid:            vg.UUID,
+		Tags:            vg.Tags,
+		ExtentSize:      vg.ExtentSize,
+		ExtentCount:     vg.ExtentCount,
+		FreeExtentCount: vg.FreeExtentCount,
+		PvCount:         vg.PVCount,
+		LvCount:         vg.LVCount,
+		SnapCount:       vg.SnapCount,
+		MaxLv:           vg.MaxLV,
+		MaxPv:           vg.MaxPV,
+		Attributes:      vg.Attributes.ToProto(),
+		LockType:        vg.LockType,
 	}
 }
 
 func (pv PV) ToProto() *pb.PVInfo {
 	return &pb.PVInfo{
-		Name:  pv.Name,
-		Uuid:  pv.UUID,
-		Fmt:   pv.Fmt,
-		Size:  pv.Size,
-		Usize: pv.Usize,
-		Fsize: pv.Fsize,
+		Name:         pv.Name,
+		Uuid:         pv.UUID,
+		Fmt:          pv.Fmt,
+		Size:         pv.Size,
+		Usize:        pv.Usize,
+		Fsize:        pv.Fsize,
+		VgName:       pv.VGName,
+		PeCount:      pv.PECount,
+		PeAllocCount: pv.PEAllocCount,
+		Attributes:   pv.Attributes.ToProto(),
+		DevSize:      pv.DevSize,
+		MdaCount:     pv.MdaCount,
+		MdaSize:      pv.MdaSize,
+		Tags:         pv.Tags,
 	}
 }
 
-func parse(line string, numComponents int) (map[string]string, error) {
+// parse splits a line reported with --nameprefixes into its fields, lines
+// with more than minComponents fields are accepted so reports can grow
+func parse(line string, minComponents int) (map[string]string, error) {
 	fields := map[string]string{}
 	if line == "" {
 		return fields, nil
 	}
 	components := strings.Split(line, separator)
-	if len(components) != numComponents {
-		return nil, fmt.Errorf("expected %d components, got %d", numComponents, len(components))
+	if len(components) < minComponents {
+		return nil, fmt.Errorf("expected at least %d components, got %d", minComponents, len(components))
 	}
 
 	for _, c := range components {
@@ -359,9 +553,8 @@ func parse(line string, numComponents int) (map[string]string, error) {
 
 // ParseLV parses a line from lvs
 func ParseLV(line string) (*LV, error) {
-	// lvs --units=b --separator="<:SEP:>" --nosuffix --noheadings -o lv_name,lv_size,lv_uuid,lv_attr,copy_percent,lv_kernel_major,lv_kernel_minor,lv_tags --nameprefixes -a
-	// todo: devices, lv_ancestors, lv_descendants, lv_major, lv_minor, mirror_log, modules, move_pv, origin, region_size
-	//       seg_count, seg_size, seg_start, seg_tags, segtype, snap_percent, stripes, stripe_size
+	// lvs --units=b --separator="<:SEP:>" --nosuffix --noheadings -o lv_name,lv_size,lv_uuid,lv_attr,copy_percent,lv_kernel_major,lv_kernel_minor,lv_tags,origin,snap_percent,pool_lv,data_percent,metadata_percent --nameprefixes -a
+	// the segments are reported separately, see ParseSegment
 	fields, err := parse(line, 8)
 	if err != nil {
 		return nil, err
@@ -370,7 +563,10 @@ func ParseLV(line string) (*LV, error) {
 	if len(fields) == 0 {
 		return &LV{}, nil
 	}
+	return lvFromFields(fields)
+}
 
+func lvFromFields(fields map[string]string) (*LV, error) {
 	size, err := strconv.ParseUint(fields["LVM2_LV_SIZE"], 10, 64)
 	if err != nil {
 		return nil, err
@@ -391,6 +587,17 @@ func ParseLV(line string) (*LV, error) {
 		return nil, err
 	}
 
+	// the usage is empty for volumes other than thin pools and thin volumes
+	var percents [2]float64
+	for i, field := range []string{"LVM2_DATA_PERCENT", "LVM2_METADATA_PERCENT"} {
+		if fields[field] == "" {
+			continue
+		}
+		if percents[i], err = strconv.ParseFloat(fields[field], 64); err != nil {
+			return nil, err
+		}
+	}
+
 	return &LV{
 		Name:               fields["LVM2_LV_NAME"],
 		Size:               size,
@@ -400,11 +607,82 @@ func ParseLV(line string) (*LV, error) {
 		ActualDevMajNumber: int32(kernelMajNumber),
 		ActualDevMinNumber: int32(kernelMinNumber),
 		Tags:               strings.Split(fields["LVM2_LV_TAGS"], ","),
+		Origin:             fields["LVM2_ORIGIN"],
+		SnapPercent:        fields["LVM2_SNAP_PERCENT"],
+		PoolLV:             fields["LVM2_POOL_LV"],
+		DataPercent:        percents[0],
+		MetadataPercent:    percents[1],
 	}, nil
 }
 
+// ParseSegment parses a line from lvs reporting the segments of volumes
+func ParseSegment(line string) (*Segment, error) {
+	// lvs --segments --units=b --separator="<:SEP:>" --nosuffix --noheadings -o lv_uuid,seg_start,seg_size,segtype,stripes,stripe_size,region_size,devices --nameprefixes -a
+	fields, err := parse(line, 8)
+	if err != nil {
+		return nil, err
+	}
+	if len(fields) == 0 {
+		return &Segment{}, nil
+	}
+	return segmentFromFields(fields)
+}
+
+func segmentFromFields(fields map[string]string) (*Segment, error) {
+	var err error
+	seg := &Segment{
+		LVUUID: fields["LVM2_LV_UUID"],
+		Type:   fields["LVM2_SEGTYPE"],
+	}
+	sizes := []struct {
+		field string
+		value *uint64
+	}{
+		{"LVM2_SEG_START", &seg.Start},
+		{"LVM2_SEG_SIZE", &seg.Size},
+		{"LVM2_STRIPE_SIZE", &seg.StripeSize},
+		{"LVM2_REGION_SIZE", &seg.RegionSize},
+	}
+	for _, s := range sizes {
+		if *s.value, err = strconv.ParseUint(fields[s.field], 10, 64); err != nil {
+			return nil, err
+		}
+	}
+	stripes, err := strconv.ParseUint(fields["LVM2_STRIPES"], 10, 32)
+	if err != nil {
+		return nil, err
+	}
+	seg.Stripes = uint32(stripes)
+	if seg.Devices, err = parseDevices(fields["LVM2_DEVICES"]); err != nil {
+		return nil, err
+	}
+	return seg, nil
+}
+
+// parseDevices parses devices like "/dev/sdb(0),/dev/sdc(0)", thin volumes
+// have no devices
+func parseDevices(devices string) ([]SegmentDevice, error) {
+	if devices == "" {
+		return nil, nil
+	}
+	var res []SegmentDevice
+	for _, dev := range strings.Split(devices, ",") {
+		idx := strings.LastIndex(dev, "(")
+		if idx == -1 || !strings.HasSuffix(dev, ")") {
+			return nil, fmt.Errorf("failed to parse device '%s'", dev)
+		}
+		extent, err := strconv.ParseUint(dev[idx+1:len(dev)-1], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse device '%s'", dev)
+		}
+		res = append(res, SegmentDevice{Name: dev[:idx], StartExtent: extent})
+	}
+	return res, nil
+}
+
 func ParseVG(line string) (*VG, error) {
-	// vgs --units=b --separator="<:SEP:>" --nosuffix --noheadings -o vg_name,vg_size,vg_free,vg_uuid,vg_tags --nameprefixes -a
+	// vgs --units=b --separator="<:SEP:>" --nosuffix --noheadings -o vg_name,vg_size,vg_free,vg_uuid,vg_tags,vg_extent_size,vg_extent_count,vg_free_count,pv_count,lv_count,snap_count,max_lv,max_pv,vg_attr,vg_lock_type --nameprefixes -a
+	// the fields after vg_tags are optional
 	fields, err := parse(line, 5)
 	if err != nil {
 		return nil, err
@@ -413,7 +691,10 @@ func ParseVG(line string) (*VG, error) {
 	if len(fields) == 0 {
 		return &VG{}, nil
 	}
+	return vgFromFields(fields)
+}
 
+func vgFromFields(fields map[string]string) (*VG, error) {
 	size, err := strconv.ParseUint(fields["LVM2_VG_SIZE"], 10, 64)
 	if err != nil {
 		return nil, err
@@ -424,17 +705,83 @@ func ParseVG(line string) (*VG, error) {
 		return nil, err
 	}
 
-	return &VG{
-		Name:     fields["LVM2_VG_NAME"],
-		Size:     size,
-		FreeSize: freeSize,
-		UUID:     fields["LVM2_VG_UUID"],
-		Tags:     strings.Split(fields["LVM2_VG_TAGS"], ","),
+	attrs, err := parseVGAttrs(fields["LVM2_VG_ATTR"])
+	if err != nil {
+		return nil, err
+	}
+
+	vg := &VG{
+		Name:       fields["LVM2_VG_NAME"],
+		Size:       size,
+		FreeSize:   freeSize,
+		UUID:       fields["LVM2_VG_UUID"],
+		Tags:       strings.Split(fields["LVM2_VG_TAGS"], ","),
+		Attributes: *attrs,
+		LockType:   fields["LVM2_VG_LOCK_TYPE"],
+	}
+	sizes := []struct {
+		field string
+		value *uint64
+	}{
+		{"LVM2_VG_EXTENT_SIZE", &vg.ExtentSize},
+		{"LVM2_VG_EXTENT_COUNT", &vg.ExtentCount},
+		{"LVM2_VG_FREE_COUNT", &vg.FreeExtentCount},
+	}
+	for _, s := range sizes {
+		if *s.value, err = parseOptionalUint(fields[s.field], 64); err != nil {
+			return nil, err
+		}
+	}
+	counts := []struct {
+		field string
+		value *uint32
+	}{
+		{"LVM2_PV_COUNT", &vg.PVCount},
+		{"LVM2_LV_COUNT", &vg.LVCount},
+		{"LVM2_SNAP_COUNT", &vg.SnapCount},
+		{"LVM2_MAX_LV", &vg.MaxLV},
+		{"LVM2_MAX_PV", &vg.MaxPV},
+	}
+	for _, c := range counts {
+		count, err := parseOptionalUint(fields[c.field], 32)
+		if err != nil {
+			return nil, err
+		}
+		*c.value = uint32(count)
+	}
+	return vg, nil
+}
+
+// parseOptionalUint parses fields which may not be reported, as 0
+func parseOptionalUint(value string, bitSize int) (uint64, error) {
+	if value == "" {
+		return 0, nil
+	}
+	return strconv.ParseUint(value, 10, bitSize)
+}
+
+// parseVGAttrs decodes vg_attr like "wz--n-", an empty vg_attr which
+// wasn't reported decodes to no attributes
+func parseVGAttrs(attrs string) (*VGAttributes, error) {
+	if attrs == "" {
+		return &VGAttributes{}, nil
+	}
+	if len(attrs) != 6 {
+		return nil, fmt.Errorf("incorrect vg attrs block size, expected 6, got %d in %s", len(attrs), attrs)
+	}
+	return &VGAttributes{
+		Writeable:  attrs[0] == 'w',
+		Resizeable: attrs[1] == 'z',
+		Exported:   attrs[2] == 'x',
+		Partial:    attrs[3] == 'p',
+		Clustered:  attrs[5] == 'c',
+		Shared:     attrs[5] == 's',
 	}, nil
 }
 
 func ParsePV(line string) (*PV, error) {
-	//pvs --units=b --separator="<:SEP:>" --nosuffix --noheadings -o pv_name,pv_size,pv_used,pv_free,pv_fmt,pv_uuid --nameprefixes -a
+	//pvs --units=b --separator="<:SEP:>" --nosuffix --noheadings -o pv_name,pv_size,pv_used,pv_free,pv_fmt,pv_uuid,vg_name,pv_pe_count,pv_pe_alloc_count,pv_attr,dev_size,pv_mda_count,pv_mda_size,pv_tags --nameprefixes -a
+	// the fields after pv_uuid are optional
 	fields, err := parse(line, 6)
 	if err != nil {
 		return nil, err
@@ -442,7 +789,10 @@ func ParsePV(line string) (*PV, error) {
 	if len(fields) == 0 {
 		return &PV{}, nil
 	}
+	return pvFromFields(fields)
+}
 
+func pvFromFields(fields map[string]string) (*PV, error) {
 	size, err := strconv.ParseUint(fields["LVM2_PV_SIZE"], 10, 64)
 	if err != nil {
 		return nil, err
@@ -455,16 +805,127 @@ func ParsePV(line string) (*PV, error) {
 	if err != nil {
 		return nil, err
 	}
-	return &PV{
-		Name:  fields["LVM2_PV_NAME"],
-		UUID:  fields["LVM2_PV_UUID"],
-		Fmt:   fields["LVM2_PV_FMT"],
-		Size:  size,
-		Usize: usize,
-		Fsize: fsize,
+	attrs, err := parsePVAttrs(fields["LVM2_PV_ATTR"])
+	if err != nil {
+		return nil, err
+	}
+	pv := &PV{
+		Name:       fields["LVM2_PV_NAME"],
+		UUID:       fields["LVM2_PV_UUID"],
+		Fmt:        fields["LVM2_PV_FMT"],
+		Size:       size,
+		Usize:      usize,
+		Fsize:      fsize,
+		VGName:     fields["LVM2_VG_NAME"],
+		Attributes: *attrs,
+		Tags:       strings.Split(fields["LVM2_PV_TAGS"], ","),
+	}
+	sizes := []struct {
+		field string
+		value *uint64
+	}{
+		{"LVM2_PV_PE_COUNT", &pv.PECount},
+		{"LVM2_PV_PE_ALLOC_COUNT", &pv.PEAllocCount},
+		{"LVM2_DEV_SIZE", &pv.DevSize},
+		{"LVM2_PV_MDA_SIZE", &pv.MdaSize},
+	}
+	for _, s := range sizes {
+		if *s.value, err = parseOptionalUint(fields[s.field], 64); err != nil {
+			return nil, err
+		}
+	}
+	mdaCount, err := parseOptionalUint(fields["LVM2_PV_MDA_COUNT"], 32)
+	if err != nil {
+		return nil, err
+	}
+	pv.MdaCount = uint32(mdaCount)
+	return pv, nil
+}
+
+// parsePVAttrs decodes pv_attr like "a--", an empty pv_attr which wasn't
+// reported decodes to no attributes
+func parsePVAttrs(attrs string) (*PVAttributes, error) {
+	if attrs == "" {
+		return &PVAttributes{}, nil
+	}
+	if len(attrs) != 3 {
+		return nil, fmt.Errorf("incorrect pv attrs block size, expected 3, got %d in %s", len(attrs), attrs)
+	}
+	return &PVAttributes{
+		Allocatable: attrs[0] == 'a',
+		Exported:    attrs[1] == 'x',
+		Missing:     attrs[2] == 'm',
 	}, nil
 }
 
+func (p ThinPool) ToProto() *pb.ThinPoolStatus {
+	return &pb.ThinPoolStatus{
+		Name:            p.Name,
+		VolumeGroup:     p.VolumeGroup,
+		Size:            p.Size,
+		MetadataSize:    p.MetadataSize,
+		DataPercent:     p.DataPercent,
+		MetadataPercent: p.MetadataPercent,
+		ChunkSize:       p.ChunkSize,
+		TransactionId:   p.TransactionID,
+		VirtualSize:     p.VirtualSize,
+		ThinVolumes:     p.ThinVolumes,
+		OvercommitRatio: p.OvercommitRatio(),
+	}
+}
+
+// ParseThinPool parses a line from lvs reporting a thin pool
+func ParseThinPool(line string) (*ThinPool, error) {
+	// lvs --units=b --separator="<:SEP:>" --nosuffix --noheadings -o lv_name,vg_name,lv_size,lv_metadata_size,data_percent,metadata_percent,chunk_size,transaction_id --nameprefixes
+	fields, err := parse(line, 8)
+	if err != nil {
+		return nil, err
+	}
+	if len(fields) == 0 {
+		return &ThinPool{}, nil
+	}
+	return thinPoolFromFields(fields)
+}
+
+func thinPoolFromFields(fields map[string]string) (*ThinPool, error) {
+	var err error
+	pool := &ThinPool{
+		Name:        fields["LVM2_LV_NAME"],
+		VolumeGroup: fields["LVM2_VG_NAME"],
+	}
+	sizes := []struct {
+		field string
+		value *uint64
+	}{
+		{"LVM2_LV_SIZE", &pool.Size},
+		{"LVM2_LV_METADATA_SIZE", &pool.MetadataSize},
+		{"LVM2_CHUNK_SIZE", &pool.ChunkSize},
+		{"LVM2_TRANSACTION_ID", &pool.TransactionID},
+	}
+	for _, s := range sizes {
+		if *s.value, err = strconv.ParseUint(fields[s.field], 10, 64); err != nil {
+			return nil, err
+		}
+	}
+	// the usage is empty while the pool isn't active
+	percents := []struct {
+		field string
+		value *float64
+	}{
+		{"LVM2_DATA_PERCENT", &pool.DataPercent},
+		{"LVM2_METADATA_PERCENT", &pool.MetadataPercent},
+	}
+	for _, p := range percents {
+		if fields[p.field] == "" {
+			continue
+		}
+		if *p.value, err = strconv.ParseFloat(fields[p.field], 64); err != nil {
+			return nil, err
+		}
+	}
+	return pool, nil
+}
+
 func parseAttrs(attrs string) (*LVAttributes, error) {
 	if len(attrs) != 10 {
 		return nil, fmt.Errorf("incorrect attrs block size, expected 10, got %d in %s", len(attrs), attrs)
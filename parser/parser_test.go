@@ -158,3 +158,240 @@ tags: "protected"
 		})
 	})
 })
+
+var _ = Describe("Thin Pool", func() {
+	const line = "LVM2_LV_NAME='pool'<:SEP:>LVM2_VG_NAME='k8s'<:SEP:>LVM2_LV_SIZE='2147483648'<:SEP:>LVM2_LV_METADATA_SIZE='4194304'<:SEP:>LVM2_DATA_PERCENT='42.50'<:SEP:>LVM2_METADATA_PERCENT='7.25'<:SEP:>LVM2_CHUNK_SIZE='65536'<:SEP:>LVM2_TRANSACTION_ID='3'"
+
+	It("should parse the usage", func() {
+		pool, err := ParseThinPool(line)
+		Expect(err).To(BeNil())
+		Expect(pool).To(Equal(&ThinPool{
+			Name:            "pool",
+			VolumeGroup:     "k8s",
+			Size:            2147483648,
+			MetadataSize:    4194304,
+			DataPercent:     42.5,
+			MetadataPercent: 7.25,
+			ChunkSize:       65536,
+			TransactionID:   3,
+		}))
+	})
+
+	It("should leave the usage of inactive pools empty", func() {
+		pool, err := ParseThinPool(strings.Replace(line, "'42.50'", "''", 1))
+		Expect(err).To(BeNil())
+		Expect(pool.DataPercent).To(BeZero())
+	})
+
+	It("should compute the overcommit ratio", func() {
+		Expect(ThinPool{Size: 2, VirtualSize: 5}.OvercommitRatio()).To(Equal(2.5))
+		Expect(ThinPool{}.OvercommitRatio()).To(BeZero())
+	})
+})
+
+var _ = Describe("Volume Group", func() {
+	const line = "LVM2_VG_NAME='k8s'<:SEP:>LVM2_VG_SIZE='21466447872'<:SEP:>LVM2_VG_FREE='4286578688'<:SEP:>LVM2_VG_UUID='u'<:SEP:>LVM2_VG_TAGS=''<:SEP:>LVM2_VG_EXTENT_SIZE='4194304'<:SEP:>LVM2_VG_EXTENT_COUNT='5118'<:SEP:>LVM2_VG_FREE_COUNT='1022'<:SEP:>LVM2_PV_COUNT='2'<:SEP:>LVM2_LV_COUNT='3'<:SEP:>LVM2_SNAP_COUNT='1'<:SEP:>LVM2_MAX_LV='0'<:SEP:>LVM2_MAX_PV='0'<:SEP:>LVM2_VG_ATTR='wz-pns'<:SEP:>LVM2_VG_LOCK_TYPE='sanlock'"
+
+	It("should parse the extents, counts and attributes", func() {
+		vg, err := ParseVG(line)
+		Expect(err).To(BeNil())
+		Expect(vg.ExtentSize).To(Equal(uint64(4194304)))
+		Expect(vg.ExtentCount).To(Equal(uint64(5118)))
+		Expect(vg.FreeExtentCount).To(Equal(uint64(1022)))
+		Expect(vg.PVCount).To(Equal(uint32(2)))
+		Expect(vg.LVCount).To(Equal(uint32(3)))
+		Expect(vg.SnapCount).To(Equal(uint32(1)))
+		Expect(vg.Attributes).To(Equal(VGAttributes{Writeable: true, Resizeable: true, Partial: true, Shared: true}))
+		Expect(vg.LockType).To(Equal("sanlock"))
+	})
+
+	It("should reject malformed attributes", func() {
+		_, err := ParseVG(strings.Replace(line, "'wz-pns'", "'wz'", 1))
+		Expect(err).NotTo(BeNil())
+	})
+})
+
+var _ = Describe("Physical Volume", func() {
+	const line = "LVM2_PV_NAME='/dev/sdb'<:SEP:>LVM2_PV_SIZE='10733223936'<:SEP:>LVM2_PV_USED='4294967296'<:SEP:>LVM2_PV_FREE='6438256640'<:SEP:>LVM2_PV_FMT='lvm2'<:SEP:>LVM2_PV_UUID='u'<:SEP:>LVM2_VG_NAME='k8s'<:SEP:>LVM2_PV_PE_COUNT='2559'<:SEP:>LVM2_PV_PE_ALLOC_COUNT='1024'<:SEP:>LVM2_PV_ATTR='a-m'<:SEP:>LVM2_DEV_SIZE='10737418240'<:SEP:>LVM2_PV_MDA_COUNT='1'<:SEP:>LVM2_PV_MDA_SIZE='1044480'<:SEP:>LVM2_PV_TAGS='ssd,fast'"
+
+	It("should parse the owner, extents and attributes", func() {
+		pv, err := ParsePV(line)
+		Expect(err).To(BeNil())
+		Expect(pv.VGName).To(Equal("k8s"))
+		Expect(pv.PECount).To(Equal(uint64(2559)))
+		Expect(pv.PEAllocCount).To(Equal(uint64(1024)))
+		Expect(pv.Attributes).To(Equal(PVAttributes{Allocatable: true, Missing: true}))
+		Expect(pv.DevSize).To(Equal(uint64(10737418240)))
+		Expect(pv.MdaCount).To(Equal(uint32(1)))
+		Expect(pv.MdaSize).To(Equal(uint64(1044480)))
+		Expect(pv.Tags).To(Equal([]string{"ssd", "fast"}))
+	})
+
+	It("should leave orphans without a volume group", func() {
+		pv, err := ParsePV(strings.Replace(line, "LVM2_VG_NAME='k8s'", "LVM2_VG_NAME=''", 1))
+		Expect(err).To(BeNil())
+		Expect(pv.VGName).To(BeEmpty())
+	})
+})
+
+var _ = Describe("Segment", func() {
+	const line = "LVM2_LV_UUID='v2jVj9'<:SEP:>LVM2_SEG_START='0'<:SEP:>LVM2_SEG_SIZE='1073741824'<:SEP:>LVM2_SEGTYPE='striped'<:SEP:>LVM2_STRIPES='2'<:SEP:>LVM2_STRIPE_SIZE='65536'<:SEP:>LVM2_REGION_SIZE='0'<:SEP:>LVM2_DEVICES='/dev/sdb(0),/dev/sdc(128)'"
+
+	It("should parse the layout and devices", func() {
+		seg, err := ParseSegment(line)
+		Expect(err).To(BeNil())
+		Expect(seg).To(Equal(&Segment{
+			LVUUID:     "v2jVj9",
+			Size:       1073741824,
+			Type:       "striped",
+			Stripes:    2,
+			StripeSize: 65536,
+			Devices:    []SegmentDevice{{Name: "/dev/sdb"}, {Name: "/dev/sdc", StartExtent: 128}},
+		}))
+	})
+
+	It("should accept segments without devices", func() {
+		seg, err := ParseSegment(strings.Replace(line, "'/dev/sdb(0),/dev/sdc(128)'", "''", 1))
+		Expect(err).To(BeNil())
+		Expect(seg.Devices).To(BeEmpty())
+	})
+
+	It("should reject malformed devices", func() {
+		_, err := ParseSegment(strings.Replace(line, "/dev/sdc(128)", "/dev/sdc", 1))
+		Expect(err).NotTo(BeNil())
+	})
+
+	It("should take the layout of a volume from its first segment", func() {
+		lv := &LV{}
+		lv.SetSegments([]Segment{
+			{Type: "raid1", Stripes: 2, RegionSize: 524288, Devices: []SegmentDevice{{Name: "r_rimage_0"}, {Name: "r_rimage_1"}}},
+			{Type: "raid1", Stripes: 2, RegionSize: 524288, Devices: []SegmentDevice{{Name: "r_rimage_0", StartExtent: 10}}},
+		})
+		Expect(lv.SegType).To(Equal("raid1"))
+		Expect(lv.Stripes).To(Equal(uint32(2)))
+		Expect(lv.RegionSize).To(Equal(uint64(524288)))
+		Expect(lv.Devices).To(Equal([]string{"r_rimage_0", "r_rimage_1"}))
+		Expect(lv.ToProto().Segments).To(HaveLen(2))
+	})
+
+	It("should parse json reports of segments", func() {
+		segs, err := ParseSegmentReport(`{"report":[{"seg":[{"lv_uuid":"u","seg_start":"0","seg_size":"4194304","segtype":"thin","stripes":"1","stripe_size":"0","region_size":"0","devices":""}]}]}`)
+		Expect(err).To(BeNil())
+		Expect(segs).To(Equal([]*Segment{{LVUUID: "u", Size: 4194304, Type: "thin", Stripes: 1}}))
+	})
+})
+
+var _ = Describe("Block Devices", func() {
+	// lsblk before 2.33 reports all values as strings
+	const report = `{
+   "blockdevices": [
+      {"name":"sda", "path":"/dev/sda", "size":"107374182400", "type":"disk", "rota":"1", "rm":"0", "model":"ST1000  ", "serial":"Z1W0", "wwn":"0x5000c500a1b2c3d4", "tran":"sata", "pttype":"gpt", "fstype":null, "mountpoint":null,
+         "children": [
+            {"name":"sda1", "path":"/dev/sda1", "size":"1073741824", "type":"part", "rota":"1", "rm":"0", "model":null, "serial":null, "wwn":"0x5000c500a1b2c3d4", "tran":null, "pttype":"gpt", "fstype":"ext4", "mountpoint":"/boot"},
+            {"name":"sda2", "path":"/dev/sda2", "size":"106300440576", "type":"part", "rota":"1", "rm":"0", "model":null, "serial":null, "wwn":"0x5000c500a1b2c3d4", "tran":null, "pttype":"gpt", "fstype":"LVM2_member", "mountpoint":null,
+               "children": [
+                  {"name":"k8s-data", "path":"/dev/mapper/k8s-data", "size":"4294967296", "type":"lvm", "rota":"1", "rm":"0", "model":null, "serial":null, "wwn":null, "tran":null, "pttype":null, "fstype":"xfs", "mountpoint":"/data"}
+               ]
+            }
+         ]
+      },
+      {"name":"sdb", "path":"/dev/sdb", "size":10737418240, "type":"disk", "rota":false, "rm":true, "model":null, "serial":null, "wwn":null, "tran":"usb", "pttype":null, "fstype":"LVM2_member", "mountpoint":null,
+         "children": [
+            {"name":"k8s-data", "path":"/dev/mapper/k8s-data", "size":4294967296, "type":"lvm", "rota":false, "rm":false, "model":null, "serial":null, "wwn":null, "tran":null, "pttype":null, "fstype":"xfs", "mountpoint":"/data"}
+         ]
+      }
+   ]
+}`
+
+	It("should flatten the tree of devices", func() {
+		devices, err := ParseBlockDevices(report)
+		Expect(err).To(BeNil())
+		Expect(devices).To(HaveLen(5))
+
+		sda := devices[0]
+		Expect(sda.Path).To(Equal("/dev/sda"))
+		Expect(sda.Size).To(Equal(uint64(107374182400)))
+		Expect(sda.Rotational).To(BeTrue())
+		Expect(sda.Removable).To(BeFalse())
+		Expect(sda.Model).To(Equal("ST1000"))
+		Expect(sda.PartitionTable).To(Equal("gpt"))
+		Expect(sda.Holders).To(BeEmpty())
+
+		Expect(devices[1].Parent).To(Equal("sda"))
+		Expect(devices[1].Mountpoints).To(Equal([]string{"/boot"}))
+		Expect(devices[2].Holders).To(Equal([]string{"k8s-data"}))
+		Expect(devices[3].Name).To(Equal("k8s-data"))
+	})
+
+	It("should accept the values of newer lsblk versions", func() {
+		devices, err := ParseBlockDevices(report)
+		Expect(err).To(BeNil())
+		sdb := devices[4]
+		Expect(sdb.Size).To(Equal(uint64(10737418240)))
+		Expect(sdb.Removable).To(BeTrue())
+		Expect(sdb.Rotational).To(BeFalse())
+		Expect(sdb.Model).To(BeEmpty())
+		Expect(sdb.Holders).To(Equal([]string{"k8s-data"}))
+	})
+})
+
+var _ = Describe("Signatures", func() {
+	It("should parse the signatures found by wipefs", func() {
+		sigs, err := ParseSignatures(`{
+   "signatures": [
+      {"offset":"0x438", "type":"ext4", "usage":"filesystem", "uuid":"5c1b0a3e", "label":null},
+      {"offset":"0x200", "type":"gpt", "usage":"partition table", "uuid":null, "label":null}
+   ]
+}`)
+		Expect(err).To(BeNil())
+		Expect(sigs).To(Equal([]*Signature{
+			{Offset: 0x438, Type: "ext4", Usage: UsageFilesystem, UUID: "5c1b0a3e"},
+			{Offset: 0x200, Type: "gpt", Usage: UsagePartitionTable},
+		}))
+	})
+
+	It("should accept devices without signatures", func() {
+		sigs, err := ParseSignatures("")
+		Expect(err).To(BeNil())
+		Expect(sigs).To(BeEmpty())
+	})
+})
+
+var _ = Describe("JSON report", func() {
+	const lvs = `
+  {
+      "report": [
+          {
+              "lv": [
+                  {"lv_name":"root", "lv_size":"10737418240", "lv_uuid":"v2jVj9-HTY0-G5IR-9zyc-lMqc-iPdg-cSxYs2", "lv_attr":"rwi-aor---", "copy_percent":"100.00", "lv_kernel_major":"252", "lv_kernel_minor":"4", "lv_tags":"host,it's<:SEP:>fine", "origin":"", "snap_percent":"", "pool_lv":""}
+              ]
+          }
+      ]
+  }
+`
+
+	It("should parse the volumes", func() {
+		vols, err := ParseLVReport(lvs)
+		Expect(err).To(BeNil())
+		Expect(vols).To(HaveLen(1))
+		Expect(vols[0].Name).To(Equal("root"))
+		Expect(vols[0].Size).To(Equal(uint64(10737418240)))
+		Expect(vols[0].Attributes.Type).To(Equal(VolumeTypeRAID))
+		Expect(vols[0].Tags).To(Equal([]string{"host", "it's<:SEP:>fine"}))
+	})
+
+	It("should parse volume groups and physical volumes", func() {
+		vgs, err := ParseVGReport(`{"report":[{"vg":[{"vg_name":"k8s","vg_size":"100","vg_free":"40","vg_uuid":"u","vg_tags":""}]}]}`)
+		Expect(err).To(BeNil())
+		Expect(vgs).To(Equal([]*VG{{Name: "k8s", Size: 100, FreeSize: 40, UUID: "u", Tags: []string{""}}}))
+
+		pvs, err := ParsePVReport(`{"report":[{"pv":[]}]}`)
+		Expect(err).To(BeNil())
+		Expect(pvs).To(BeEmpty())
+	})
+
+	It("should reject malformed reports", func() {
+		_, err := ParseLVReport(`  LVM2_LV_NAME='root'`)
+		Expect(err).NotTo(BeNil())
+	})
+})
@@ -0,0 +1,126 @@
+package parser
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// The json report of lvs looks like
+//
+//	{
+//	    "report": [
+//	        {
+//	            "lv": [
+//	                {"lv_name":"root", "lv_size":"10737418240", ...}
+//	            ]
+//	        }
+//	    ]
+//	}
+//
+// with the objects of vgs and pvs under "vg" and "pv". Names and tags are
+// quoted as json strings, so they may contain any character
+type report struct {
+	Report []map[string][]map[string]interface{} `json:"report"`
+}
+
+// parseReport returns the rows of kind in the json report of an lvm
+// reporting command, keyed like the fields reported with --nameprefixes
+func parseReport(data string, kind string) ([]map[string]string, error) {
+	var r report
+	decoder := json.NewDecoder(strings.NewReader(data))
+	// lvm reports all values as strings, numbers are accepted as well
+	decoder.UseNumber()
+	if err := decoder.Decode(&r); err != nil {
+		return nil, fmt.Errorf("failed to parse json report: %v", err)
+	}
+
+	var rows []map[string]string
+	for _, section := range r.Report {
+		for _, object := range section[kind] {
+			fields := make(map[string]string, len(object))
+			for key, value := range object {
+				fields["LVM2_"+strings.ToUpper(key)] = fmt.Sprint(value)
+			}
+			rows = append(rows, fields)
+		}
+	}
+	return rows, nil
+}
+
+// ParseLVReport parses the json report of lvs, with the fields of ParseLV
+func ParseLVReport(data string) ([]*LV, error) {
+	rows, err := parseReport(data, "lv")
+	if err != nil {
+		return nil, err
+	}
+	lvs := make([]*LV, len(rows))
+	for i, fields := range rows {
+		if lvs[i], err = lvFromFields(fields); err != nil {
+			return nil, err
+		}
+	}
+	return lvs, nil
+}
+
+// ParseVGReport parses the json report of vgs, with the fields of ParseVG
+func ParseVGReport(data string) ([]*VG, error) {
+	rows, err := parseReport(data, "vg")
+	if err != nil {
+		return nil, err
+	}
+	vgs := make([]*VG, len(rows))
+	for i, fields := range rows {
+		if vgs[i], err = vgFromFields(fields); err != nil {
+			return nil, err
+		}
+	}
+	return vgs, nil
+}
+
+// ParsePVReport parses the json report of pvs, with the fields of ParsePV
+func ParsePVReport(data string) ([]*PV, error) {
+	rows, err := parseReport(data, "pv")
+	if err != nil {
+		return nil, err
+	}
+	pvs := make([]*PV, len(rows))
+	for i, fields := range rows {
+		if pvs[i], err = pvFromFields(fields); err != nil {
+			return nil, err
+		}
+	}
+	return pvs, nil
+}
+
+// ParseSegmentReport parses the json report of lvs --segments, with the
+// fields of ParseSegment
+func ParseSegmentReport(data string) ([]*Segment, error) {
+	rows, err := parseReport(data, "seg")
+	if err != nil {
+		return nil, err
+	}
+	segments := make([]*Segment, len(rows))
+	for i, fields := range rows {
+		if segments[i], err = segmentFromFields(fields); err != nil {
+			return nil, err
+		}
+	}
+	return segments, nil
+}
+
+// ParseThinPoolReport parses the json report of lvs, with the fields of
+// ParseThinPool
+func ParseThinPoolReport(data string) ([]*ThinPool, error) {
+	rows, err := parseReport(data, "lv")
+	if err != nil {
+		return nil, err
+	}
+	pools := make([]*ThinPool, len(rows))
+	for i, fields := range rows {
+		if pools[i], err = thinPoolFromFields(fields); err != nil {
+			return nil, err
+		}
+	}
+	return pools, nil
+}
@@ -0,0 +1,78 @@
+package parser
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	pb "github.com/zdnscloud/lvmd/proto"
+)
+
+// Usages of signatures as reported by wipefs
+const (
+	UsageFilesystem     = "filesystem"
+	UsageRaid           = "raid"
+	UsageCrypto         = "crypto"
+	UsagePartitionTable = "partition table"
+	UsageOther          = "other"
+)
+
+// Signature is a filesystem, raid or partition table signature found on a
+// block device by wipefs. Physical volumes are raid members to wipefs
+type Signature struct {
+	Offset uint64
+	Type   string
+	Usage  string
+	UUID   string
+	Label  string
+}
+
+func (s Signature) ToProto() *pb.Signature {
+	return &pb.Signature{
+		Offset: s.Offset,
+		Type:   s.Type,
+		Usage:  s.Usage,
+		Uuid:   s.UUID,
+		Label:  s.Label,
+	}
+}
+
+// ParseSignatures parses the report of
+//
+//	wipefs --json --output OFFSET,TYPE,USAGE,UUID,LABEL
+//
+// which is empty rather than a json document when there are no signatures
+func ParseSignatures(data string) ([]*Signature, error) {
+	if strings.TrimSpace(data) == "" {
+		return nil, nil
+	}
+	var report struct {
+		Signatures []struct {
+			Offset utilValue `json:"offset"`
+			Type   utilValue `json:"type"`
+			Usage  utilValue `json:"usage"`
+			UUID   utilValue `json:"uuid"`
+			Label  utilValue `json:"label"`
+		} `json:"signatures"`
+	}
+	if err := json.Unmarshal([]byte(data), &report); err != nil {
+		return nil, fmt.Errorf("failed to parse wipefs report: %v", err)
+	}
+	signatures := make([]*Signature, len(report.Signatures))
+	for i, s := range report.Signatures {
+		// offsets are hexadecimal like 0x438
+		offset, err := strconv.ParseUint(string(s.Offset), 0, 64)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse offset '%s' of signature %s", s.Offset, s.Type)
+		}
+		signatures[i] = &Signature{
+			Offset: offset,
+			Type:   string(s.Type),
+			Usage:  string(s.Usage),
+			UUID:   string(s.UUID),
+			Label:  string(s.Label),
+		}
+	}
+	return signatures, nil
+}
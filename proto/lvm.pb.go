@@ -306,6 +306,143 @@ func (LogicalVolume_Attributes_Health) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_8cc5677814b58357, []int{0, 0, 5}
 }
 
+type CreateThinPoolRequest_Zeroing int32
+
+const (
+	CreateThinPoolRequest_ZEROING_DEFAULT  CreateThinPoolRequest_Zeroing = 0
+	CreateThinPoolRequest_ZEROING_ENABLED  CreateThinPoolRequest_Zeroing = 1
+	CreateThinPoolRequest_ZEROING_DISABLED CreateThinPoolRequest_Zeroing = 2
+)
+
+var CreateThinPoolRequest_Zeroing_name = map[int32]string{
+	0: "ZEROING_DEFAULT",
+	1: "ZEROING_ENABLED",
+	2: "ZEROING_DISABLED",
+}
+
+var CreateThinPoolRequest_Zeroing_value = map[string]int32{
+	"ZEROING_DEFAULT":  0,
+	"ZEROING_ENABLED":  1,
+	"ZEROING_DISABLED": 2,
+}
+
+func (x CreateThinPoolRequest_Zeroing) String() string {
+	return proto.EnumName(CreateThinPoolRequest_Zeroing_name, int32(x))
+}
+
+func (CreateThinPoolRequest_Zeroing) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_8cc5677814b58357, []int{6, 0}
+}
+
+type CreateThinPoolRequest_Discards int32
+
+const (
+	CreateThinPoolRequest_DISCARDS_DEFAULT    CreateThinPoolRequest_Discards = 0
+	CreateThinPoolRequest_DISCARDS_PASSDOWN   CreateThinPoolRequest_Discards = 1
+	CreateThinPoolRequest_DISCARDS_NOPASSDOWN CreateThinPoolRequest_Discards = 2
+	CreateThinPoolRequest_DISCARDS_IGNORE     CreateThinPoolRequest_Discards = 3
+)
+
+var CreateThinPoolRequest_Discards_name = map[int32]string{
+	0: "DISCARDS_DEFAULT",
+	1: "DISCARDS_PASSDOWN",
+	2: "DISCARDS_NOPASSDOWN",
+	3: "DISCARDS_IGNORE",
+}
+
+var CreateThinPoolRequest_Discards_value = map[string]int32{
+	"DISCARDS_DEFAULT":    0,
+	"DISCARDS_PASSDOWN":   1,
+	"DISCARDS_NOPASSDOWN": 2,
+	"DISCARDS_IGNORE":     3,
+}
+
+func (x CreateThinPoolRequest_Discards) String() string {
+	return proto.EnumName(CreateThinPoolRequest_Discards_name, int32(x))
+}
+
+func (CreateThinPoolRequest_Discards) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_8cc5677814b58357, []int{6, 1}
+}
+
+type ValidateReply_Reason_Kind int32
+
+const (
+	ValidateReply_Reason_UNKNOWN ValidateReply_Reason_Kind = 0
+	// a filesystem or other signature
+	ValidateReply_Reason_SIGNATURE       ValidateReply_Reason_Kind = 1
+	ValidateReply_Reason_PARTITION_TABLE ValidateReply_Reason_Kind = 2
+	// the block or one of its partitions is mounted
+	ValidateReply_Reason_MOUNTED ValidateReply_Reason_Kind = 3
+	// a device mapper or md device is stacked on the block
+	ValidateReply_Reason_HOLDER          ValidateReply_Reason_Kind = 4
+	ValidateReply_Reason_SWAP            ValidateReply_Reason_Kind = 5
+	ValidateReply_Reason_RAID_MEMBER     ValidateReply_Reason_Kind = 6
+	ValidateReply_Reason_PHYSICAL_VOLUME ValidateReply_Reason_Kind = 7
+	// the block is held open by another process
+	ValidateReply_Reason_OPEN ValidateReply_Reason_Kind = 8
+)
+
+var ValidateReply_Reason_Kind_name = map[int32]string{
+	0: "UNKNOWN",
+	1: "SIGNATURE",
+	2: "PARTITION_TABLE",
+	3: "MOUNTED",
+	4: "HOLDER",
+	5: "SWAP",
+	6: "RAID_MEMBER",
+	7: "PHYSICAL_VOLUME",
+	8: "OPEN",
+}
+
+var ValidateReply_Reason_Kind_value = map[string]int32{
+	"UNKNOWN":         0,
+	"SIGNATURE":       1,
+	"PARTITION_TABLE": 2,
+	"MOUNTED":         3,
+	"HOLDER":          4,
+	"SWAP":            5,
+	"RAID_MEMBER":     6,
+	"PHYSICAL_VOLUME": 7,
+	"OPEN":            8,
+}
+
+func (x ValidateReply_Reason_Kind) String() string {
+	return proto.EnumName(ValidateReply_Reason_Kind_name, int32(x))
+}
+
+func (ValidateReply_Reason_Kind) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_8cc5677814b58357, []int{51, 0, 0}
+}
+
+type WatchEvent_Type int32
+
+const (
+	WatchEvent_ADDED    WatchEvent_Type = 0
+	WatchEvent_MODIFIED WatchEvent_Type = 1
+	WatchEvent_DELETED  WatchEvent_Type = 2
+)
+
+var WatchEvent_Type_name = map[int32]string{
+	0: "ADDED",
+	1: "MODIFIED",
+	2: "DELETED",
+}
+
+var WatchEvent_Type_value = map[string]int32{
+	"ADDED":    0,
+	"MODIFIED": 1,
+	"DELETED":  2,
+}
+
+func (x WatchEvent_Type) String() string {
+	return proto.EnumName(WatchEvent_Type_name, int32(x))
+}
+
+func (WatchEvent_Type) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_8cc5677814b58357, []int{63, 0}
+}
+
 type LogicalVolume struct {
 	Name                 string                    `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	Size                 uint64                    `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
@@ -315,9 +452,24 @@ type LogicalVolume struct {
 	ActualDevMajorNumber uint32                    `protobuf:"varint,6,opt,name=actual_dev_major_number,json=actualDevMajorNumber,proto3" json:"actual_dev_major_number,omitempty"`
 	ActualDevMinorNumber uint32                    `protobuf:"varint,7,opt,name=actual_dev_minor_number,json=actualDevMinorNumber,proto3" json:"actual_dev_minor_number,omitempty"`
 	Tags                 []string                  `protobuf:"bytes,8,rep,name=tags,proto3" json:"tags,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                  `json:"-"`
-	XXX_unrecognized     []byte                    `json:"-"`
-	XXX_sizecache        int32                     `json:"-"`
+	Origin               string                    `protobuf:"bytes,9,opt,name=origin,proto3" json:"origin,omitempty"`
+	SnapPercent          string                    `protobuf:"bytes,10,opt,name=snap_percent,json=snapPercent,proto3" json:"snap_percent,omitempty"`
+	PoolLv               string                    `protobuf:"bytes,11,opt,name=pool_lv,json=poolLv,proto3" json:"pool_lv,omitempty"`
+	// usage of thin pools and thin volumes, 0 for other volumes
+	DataPercent     float64 `protobuf:"fixed64,12,opt,name=data_percent,json=dataPercent,proto3" json:"data_percent,omitempty"`
+	MetadataPercent float64 `protobuf:"fixed64,13,opt,name=metadata_percent,json=metadataPercent,proto3" json:"metadata_percent,omitempty"`
+	// layout of the first segment, the layout of each segment is in segments
+	Segtype    string `protobuf:"bytes,14,opt,name=segtype,proto3" json:"segtype,omitempty"`
+	Stripes    uint32 `protobuf:"varint,15,opt,name=stripes,proto3" json:"stripes,omitempty"`
+	StripeSize uint64 `protobuf:"varint,16,opt,name=stripe_size,json=stripeSize,proto3" json:"stripe_size,omitempty"`
+	RegionSize uint64 `protobuf:"varint,17,opt,name=region_size,json=regionSize,proto3" json:"region_size,omitempty"`
+	// devices of all segments, the physical volumes or the hidden volumes
+	// the volume is on
+	Devices              []string                 `protobuf:"bytes,18,rep,name=devices,proto3" json:"devices,omitempty"`
+	Segments             []*LogicalVolume_Segment `protobuf:"bytes,19,rep,name=segments,proto3" json:"segments,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
 }
 
 func (m *LogicalVolume) Reset()         { *m = LogicalVolume{} }
@@ -401,6 +553,83 @@ func (m *LogicalVolume) GetTags() []string {
 	return nil
 }
 
+func (m *LogicalVolume) GetOrigin() string {
+	if m != nil {
+		return m.Origin
+	}
+	return ""
+}
+
+func (m *LogicalVolume) GetSnapPercent() string {
+	if m != nil {
+		return m.SnapPercent
+	}
+	return ""
+}
+
+func (m *LogicalVolume) GetPoolLv() string {
+	if m != nil {
+		return m.PoolLv
+	}
+	return ""
+}
+
+func (m *LogicalVolume) GetDataPercent() float64 {
+	if m != nil {
+		return m.DataPercent
+	}
+	return 0
+}
+
+func (m *LogicalVolume) GetMetadataPercent() float64 {
+	if m != nil {
+		return m.MetadataPercent
+	}
+	return 0
+}
+
+func (m *LogicalVolume) GetSegtype() string {
+	if m != nil {
+		return m.Segtype
+	}
+	return ""
+}
+
+func (m *LogicalVolume) GetStripes() uint32 {
+	if m != nil {
+		return m.Stripes
+	}
+	return 0
+}
+
+func (m *LogicalVolume) GetStripeSize() uint64 {
+	if m != nil {
+		return m.StripeSize
+	}
+	return 0
+}
+
+func (m *LogicalVolume) GetRegionSize() uint64 {
+	if m != nil {
+		return m.RegionSize
+	}
+	return 0
+}
+
+func (m *LogicalVolume) GetDevices() []string {
+	if m != nil {
+		return m.Devices
+	}
+	return nil
+}
+
+func (m *LogicalVolume) GetSegments() []*LogicalVolume_Segment {
+	if m != nil {
+		return m.Segments
+	}
+	return nil
+}
+
 type LogicalVolume_Attributes struct {
 	Type                 LogicalVolume_Attributes_Type        `protobuf:"varint,1,opt,name=type,proto3,enum=lvm.LogicalVolume_Attributes_Type" json:"type,omitempty"`
 	Permissions          LogicalVolume_Attributes_Permissions `protobuf:"varint,2,opt,name=permissions,proto3,enum=lvm.LogicalVolume_Attributes_Permissions" json:"permissions,omitempty"`
@@ -512,12 +741,161 @@ func (m *LogicalVolume_Attributes) GetActivationSkipped() bool {
 	return false
 }
 
-type VolumeGroup struct {
+type LogicalVolume_Segment struct {
+	// offset of the segment in the volume
+	Start                uint64                  `protobuf:"varint,1,opt,name=start,proto3" json:"start,omitempty"`
+	Size                 uint64                  `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
+	Segtype              string                  `protobuf:"bytes,3,opt,name=segtype,proto3" json:"segtype,omitempty"`
+	Stripes              uint32                  `protobuf:"varint,4,opt,name=stripes,proto3" json:"stripes,omitempty"`
+	StripeSize           uint64                  `protobuf:"varint,5,opt,name=stripe_size,json=stripeSize,proto3" json:"stripe_size,omitempty"`
+	RegionSize           uint64                  `protobuf:"varint,6,opt,name=region_size,json=regionSize,proto3" json:"region_size,omitempty"`
+	Devices              []*LogicalVolume_Device `protobuf:"bytes,7,rep,name=devices,proto3" json:"devices,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
+	XXX_unrecognized     []byte                  `json:"-"`
+	XXX_sizecache        int32                   `json:"-"`
+}
+
+func (m *LogicalVolume_Segment) Reset()         { *m = LogicalVolume_Segment{} }
+func (m *LogicalVolume_Segment) String() string { return proto.CompactTextString(m) }
+func (*LogicalVolume_Segment) ProtoMessage()    {}
+func (*LogicalVolume_Segment) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8cc5677814b58357, []int{0, 1}
+}
+
+func (m *LogicalVolume_Segment) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LogicalVolume_Segment.Unmarshal(m, b)
+}
+func (m *LogicalVolume_Segment) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LogicalVolume_Segment.Marshal(b, m, deterministic)
+}
+func (m *LogicalVolume_Segment) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LogicalVolume_Segment.Merge(m, src)
+}
+func (m *LogicalVolume_Segment) XXX_Size() int {
+	return xxx_messageInfo_LogicalVolume_Segment.Size(m)
+}
+func (m *LogicalVolume_Segment) XXX_DiscardUnknown() {
+	xxx_messageInfo_LogicalVolume_Segment.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LogicalVolume_Segment proto.InternalMessageInfo
+
+func (m *LogicalVolume_Segment) GetStart() uint64 {
+	if m != nil {
+		return m.Start
+	}
+	return 0
+}
+
+func (m *LogicalVolume_Segment) GetSize() uint64 {
+	if m != nil {
+		return m.Size
+	}
+	return 0
+}
+
+func (m *LogicalVolume_Segment) GetSegtype() string {
+	if m != nil {
+		return m.Segtype
+	}
+	return ""
+}
+
+func (m *LogicalVolume_Segment) GetStripes() uint32 {
+	if m != nil {
+		return m.Stripes
+	}
+	return 0
+}
+
+func (m *LogicalVolume_Segment) GetStripeSize() uint64 {
+	if m != nil {
+		return m.StripeSize
+	}
+	return 0
+}
+
+func (m *LogicalVolume_Segment) GetRegionSize() uint64 {
+	if m != nil {
+		return m.RegionSize
+	}
+	return 0
+}
+
+func (m *LogicalVolume_Segment) GetDevices() []*LogicalVolume_Device {
+	if m != nil {
+		return m.Devices
+	}
+	return nil
+}
+
+// Device is a range of a physical volume or hidden volume used by a
+// segment, starting at start_extent
+type LogicalVolume_Device struct {
 	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Size                 uint64   `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
-	FreeSize             uint64   `protobuf:"varint,3,opt,name=free_size,json=freeSize,proto3" json:"free_size,omitempty"`
-	Uuid                 string   `protobuf:"bytes,4,opt,name=uuid,proto3" json:"uuid,omitempty"`
-	Tags                 []string `protobuf:"bytes,5,rep,name=tags,proto3" json:"tags,omitempty"`
+	StartExtent          uint64   `protobuf:"varint,2,opt,name=start_extent,json=startExtent,proto3" json:"start_extent,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *LogicalVolume_Device) Reset()         { *m = LogicalVolume_Device{} }
+func (m *LogicalVolume_Device) String() string { return proto.CompactTextString(m) }
+func (*LogicalVolume_Device) ProtoMessage()    {}
+func (*LogicalVolume_Device) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8cc5677814b58357, []int{0, 2}
+}
+
+func (m *LogicalVolume_Device) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LogicalVolume_Device.Unmarshal(m, b)
+}
+func (m *LogicalVolume_Device) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LogicalVolume_Device.Marshal(b, m, deterministic)
+}
+func (m *LogicalVolume_Device) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LogicalVolume_Device.Merge(m, src)
+}
+func (m *LogicalVolume_Device) XXX_Size() int {
+	return xxx_messageInfo_LogicalVolume_Device.Size(m)
+}
+func (m *LogicalVolume_Device) XXX_DiscardUnknown() {
+	xxx_messageInfo_LogicalVolume_Device.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LogicalVolume_Device proto.InternalMessageInfo
+
+func (m *LogicalVolume_Device) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *LogicalVolume_Device) GetStartExtent() uint64 {
+	if m != nil {
+		return m.StartExtent
+	}
+	return 0
+}
+
+type VolumeGroup struct {
+	Name            string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Size            uint64   `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
+	FreeSize        uint64   `protobuf:"varint,3,opt,name=free_size,json=freeSize,proto3" json:"free_size,omitempty"`
+	Uuid            string   `protobuf:"bytes,4,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	Tags            []string `protobuf:"bytes,5,rep,name=tags,proto3" json:"tags,omitempty"`
+	ExtentSize      uint64   `protobuf:"varint,6,opt,name=extent_size,json=extentSize,proto3" json:"extent_size,omitempty"`
+	ExtentCount     uint64   `protobuf:"varint,7,opt,name=extent_count,json=extentCount,proto3" json:"extent_count,omitempty"`
+	FreeExtentCount uint64   `protobuf:"varint,8,opt,name=free_extent_count,json=freeExtentCount,proto3" json:"free_extent_count,omitempty"`
+	PvCount         uint32   `protobuf:"varint,9,opt,name=pv_count,json=pvCount,proto3" json:"pv_count,omitempty"`
+	LvCount         uint32   `protobuf:"varint,10,opt,name=lv_count,json=lvCount,proto3" json:"lv_count,omitempty"`
+	SnapCount       uint32   `protobuf:"varint,11,opt,name=snap_count,json=snapCount,proto3" json:"snap_count,omitempty"`
+	// 0 means unlimited
+	MaxLv      uint32                  `protobuf:"varint,12,opt,name=max_lv,json=maxLv,proto3" json:"max_lv,omitempty"`
+	MaxPv      uint32                  `protobuf:"varint,13,opt,name=max_pv,json=maxPv,proto3" json:"max_pv,omitempty"`
+	Attributes *VolumeGroup_Attributes `protobuf:"bytes,14,opt,name=attributes,proto3" json:"attributes,omitempty"`
+	// the lvmlockd lock type like sanlock or dlm, empty for local groups
+	LockType             string   `protobuf:"bytes,15,opt,name=lock_type,json=lockType,proto3" json:"lock_type,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -583,67 +961,217 @@ func (m *VolumeGroup) GetTags() []string {
 	return nil
 }
 
-type ListLVRequest struct {
-	VolumeGroup          string   `protobuf:"bytes,1,opt,name=volume_group,json=volumeGroup,proto3" json:"volume_group,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *VolumeGroup) GetExtentSize() uint64 {
+	if m != nil {
+		return m.ExtentSize
+	}
+	return 0
 }
 
-func (m *ListLVRequest) Reset()         { *m = ListLVRequest{} }
-func (m *ListLVRequest) String() string { return proto.CompactTextString(m) }
-func (*ListLVRequest) ProtoMessage()    {}
-func (*ListLVRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8cc5677814b58357, []int{2}
+func (m *VolumeGroup) GetExtentCount() uint64 {
+	if m != nil {
+		return m.ExtentCount
+	}
+	return 0
 }
 
-func (m *ListLVRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ListLVRequest.Unmarshal(m, b)
+func (m *VolumeGroup) GetFreeExtentCount() uint64 {
+	if m != nil {
+		return m.FreeExtentCount
+	}
+	return 0
 }
-func (m *ListLVRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ListLVRequest.Marshal(b, m, deterministic)
+
+func (m *VolumeGroup) GetPvCount() uint32 {
+	if m != nil {
+		return m.PvCount
+	}
+	return 0
 }
-func (m *ListLVRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ListLVRequest.Merge(m, src)
+
+func (m *VolumeGroup) GetLvCount() uint32 {
+	if m != nil {
+		return m.LvCount
+	}
+	return 0
 }
-func (m *ListLVRequest) XXX_Size() int {
-	return xxx_messageInfo_ListLVRequest.Size(m)
+
+func (m *VolumeGroup) GetSnapCount() uint32 {
+	if m != nil {
+		return m.SnapCount
+	}
+	return 0
 }
-func (m *ListLVRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_ListLVRequest.DiscardUnknown(m)
+
+func (m *VolumeGroup) GetMaxLv() uint32 {
+	if m != nil {
+		return m.MaxLv
+	}
+	return 0
 }
 
-var xxx_messageInfo_ListLVRequest proto.InternalMessageInfo
+func (m *VolumeGroup) GetMaxPv() uint32 {
+	if m != nil {
+		return m.MaxPv
+	}
+	return 0
+}
 
-func (m *ListLVRequest) GetVolumeGroup() string {
+func (m *VolumeGroup) GetAttributes() *VolumeGroup_Attributes {
 	if m != nil {
-		return m.VolumeGroup
+		return m.Attributes
+	}
+	return nil
+}
+
+func (m *VolumeGroup) GetLockType() string {
+	if m != nil {
+		return m.LockType
 	}
 	return ""
 }
 
-type ListLVReply struct {
-	Volumes              []*LogicalVolume `protobuf:"bytes,1,rep,name=volumes,proto3" json:"volumes,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
-	XXX_unrecognized     []byte           `json:"-"`
-	XXX_sizecache        int32            `json:"-"`
+type VolumeGroup_Attributes struct {
+	Writeable  bool `protobuf:"varint,1,opt,name=writeable,proto3" json:"writeable,omitempty"`
+	Resizeable bool `protobuf:"varint,2,opt,name=resizeable,proto3" json:"resizeable,omitempty"`
+	Exported   bool `protobuf:"varint,3,opt,name=exported,proto3" json:"exported,omitempty"`
+	// some physical volumes of the group are missing
+	Partial              bool     `protobuf:"varint,4,opt,name=partial,proto3" json:"partial,omitempty"`
+	Clustered            bool     `protobuf:"varint,5,opt,name=clustered,proto3" json:"clustered,omitempty"`
+	Shared               bool     `protobuf:"varint,6,opt,name=shared,proto3" json:"shared,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ListLVReply) Reset()         { *m = ListLVReply{} }
-func (m *ListLVReply) String() string { return proto.CompactTextString(m) }
-func (*ListLVReply) ProtoMessage()    {}
-func (*ListLVReply) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8cc5677814b58357, []int{3}
+func (m *VolumeGroup_Attributes) Reset()         { *m = VolumeGroup_Attributes{} }
+func (m *VolumeGroup_Attributes) String() string { return proto.CompactTextString(m) }
+func (*VolumeGroup_Attributes) ProtoMessage()    {}
+func (*VolumeGroup_Attributes) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8cc5677814b58357, []int{1, 0}
 }
 
-func (m *ListLVReply) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ListLVReply.Unmarshal(m, b)
+func (m *VolumeGroup_Attributes) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_VolumeGroup_Attributes.Unmarshal(m, b)
 }
-func (m *ListLVReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ListLVReply.Marshal(b, m, deterministic)
+func (m *VolumeGroup_Attributes) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_VolumeGroup_Attributes.Marshal(b, m, deterministic)
 }
-func (m *ListLVReply) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ListLVReply.Merge(m, src)
+func (m *VolumeGroup_Attributes) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VolumeGroup_Attributes.Merge(m, src)
+}
+func (m *VolumeGroup_Attributes) XXX_Size() int {
+	return xxx_messageInfo_VolumeGroup_Attributes.Size(m)
+}
+func (m *VolumeGroup_Attributes) XXX_DiscardUnknown() {
+	xxx_messageInfo_VolumeGroup_Attributes.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VolumeGroup_Attributes proto.InternalMessageInfo
+
+func (m *VolumeGroup_Attributes) GetWriteable() bool {
+	if m != nil {
+		return m.Writeable
+	}
+	return false
+}
+
+func (m *VolumeGroup_Attributes) GetResizeable() bool {
+	if m != nil {
+		return m.Resizeable
+	}
+	return false
+}
+
+func (m *VolumeGroup_Attributes) GetExported() bool {
+	if m != nil {
+		return m.Exported
+	}
+	return false
+}
+
+func (m *VolumeGroup_Attributes) GetPartial() bool {
+	if m != nil {
+		return m.Partial
+	}
+	return false
+}
+
+func (m *VolumeGroup_Attributes) GetClustered() bool {
+	if m != nil {
+		return m.Clustered
+	}
+	return false
+}
+
+func (m *VolumeGroup_Attributes) GetShared() bool {
+	if m != nil {
+		return m.Shared
+	}
+	return false
+}
+
+type ListLVRequest struct {
+	VolumeGroup          string   `protobuf:"bytes,1,opt,name=volume_group,json=volumeGroup,proto3" json:"volume_group,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListLVRequest) Reset()         { *m = ListLVRequest{} }
+func (m *ListLVRequest) String() string { return proto.CompactTextString(m) }
+func (*ListLVRequest) ProtoMessage()    {}
+func (*ListLVRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8cc5677814b58357, []int{2}
+}
+
+func (m *ListLVRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListLVRequest.Unmarshal(m, b)
+}
+func (m *ListLVRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListLVRequest.Marshal(b, m, deterministic)
+}
+func (m *ListLVRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListLVRequest.Merge(m, src)
+}
+func (m *ListLVRequest) XXX_Size() int {
+	return xxx_messageInfo_ListLVRequest.Size(m)
+}
+func (m *ListLVRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListLVRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListLVRequest proto.InternalMessageInfo
+
+func (m *ListLVRequest) GetVolumeGroup() string {
+	if m != nil {
+		return m.VolumeGroup
+	}
+	return ""
+}
+
+type ListLVReply struct {
+	Volumes              []*LogicalVolume `protobuf:"bytes,1,rep,name=volumes,proto3" json:"volumes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *ListLVReply) Reset()         { *m = ListLVReply{} }
+func (m *ListLVReply) String() string { return proto.CompactTextString(m) }
+func (*ListLVReply) ProtoMessage()    {}
+func (*ListLVReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8cc5677814b58357, []int{3}
+}
+
+func (m *ListLVReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListLVReply.Unmarshal(m, b)
+}
+func (m *ListLVReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListLVReply.Marshal(b, m, deterministic)
+}
+func (m *ListLVReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListLVReply.Merge(m, src)
 }
 func (m *ListLVReply) XXX_Size() int {
 	return xxx_messageInfo_ListLVReply.Size(m)
@@ -733,10 +1261,12 @@ func (m *CreateLVRequest) GetTags() []string {
 }
 
 type CreateLVReply struct {
-	CommandOutput        string   `protobuf:"bytes,1,opt,name=command_output,json=commandOutput,proto3" json:"command_output,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	// raw output of the lvm commands, only meant for debugging
+	CommandOutput        string         `protobuf:"bytes,1,opt,name=command_output,json=commandOutput,proto3" json:"command_output,omitempty"`
+	Volume               *LogicalVolume `protobuf:"bytes,2,opt,name=volume,proto3" json:"volume,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
 }
 
 func (m *CreateLVReply) Reset()         { *m = CreateLVReply{} }
@@ -771,12 +1301,29 @@ func (m *CreateLVReply) GetCommandOutput() string {
 	return ""
 }
 
+func (m *CreateLVReply) GetVolume() *LogicalVolume {
+	if m != nil {
+		return m.Volume
+	}
+	return nil
+}
+
 type CreateThinPoolRequest struct {
-	VolumeGroup          string   `protobuf:"bytes,1,opt,name=volume_group,json=volumeGroup,proto3" json:"volume_group,omitempty"`
-	Pool                 string   `protobuf:"bytes,2,opt,name=pool,proto3" json:"pool,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	VolumeGroup string `protobuf:"bytes,1,opt,name=volume_group,json=volumeGroup,proto3" json:"volume_group,omitempty"`
+	Pool        string `protobuf:"bytes,2,opt,name=pool,proto3" json:"pool,omitempty"`
+	// size of the data volume, the pool takes percent_free of the free space
+	// of the volume group when it's 0, or all of it when both are 0
+	Size        uint64 `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
+	PercentFree uint32 `protobuf:"varint,4,opt,name=percent_free,json=percentFree,proto3" json:"percent_free,omitempty"`
+	// the following leave the choice to lvm when they are 0
+	MetadataSize uint64 `protobuf:"varint,5,opt,name=metadata_size,json=metadataSize,proto3" json:"metadata_size,omitempty"`
+	// a multiple of 64KiB between 64KiB and 1GiB
+	ChunkSize            uint64                         `protobuf:"varint,6,opt,name=chunk_size,json=chunkSize,proto3" json:"chunk_size,omitempty"`
+	Zeroing              CreateThinPoolRequest_Zeroing  `protobuf:"varint,7,opt,name=zeroing,proto3,enum=lvm.CreateThinPoolRequest_Zeroing" json:"zeroing,omitempty"`
+	Discards             CreateThinPoolRequest_Discards `protobuf:"varint,8,opt,name=discards,proto3,enum=lvm.CreateThinPoolRequest_Discards" json:"discards,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                       `json:"-"`
+	XXX_unrecognized     []byte                         `json:"-"`
+	XXX_sizecache        int32                          `json:"-"`
 }
 
 func (m *CreateThinPoolRequest) Reset()         { *m = CreateThinPoolRequest{} }
@@ -818,11 +1365,55 @@ func (m *CreateThinPoolRequest) GetPool() string {
 	return ""
 }
 
+func (m *CreateThinPoolRequest) GetSize() uint64 {
+	if m != nil {
+		return m.Size
+	}
+	return 0
+}
+
+func (m *CreateThinPoolRequest) GetPercentFree() uint32 {
+	if m != nil {
+		return m.PercentFree
+	}
+	return 0
+}
+
+func (m *CreateThinPoolRequest) GetMetadataSize() uint64 {
+	if m != nil {
+		return m.MetadataSize
+	}
+	return 0
+}
+
+func (m *CreateThinPoolRequest) GetChunkSize() uint64 {
+	if m != nil {
+		return m.ChunkSize
+	}
+	return 0
+}
+
+func (m *CreateThinPoolRequest) GetZeroing() CreateThinPoolRequest_Zeroing {
+	if m != nil {
+		return m.Zeroing
+	}
+	return CreateThinPoolRequest_ZEROING_DEFAULT
+}
+
+func (m *CreateThinPoolRequest) GetDiscards() CreateThinPoolRequest_Discards {
+	if m != nil {
+		return m.Discards
+	}
+	return CreateThinPoolRequest_DISCARDS_DEFAULT
+}
+
 type CreateThinPoolReply struct {
-	CommandOutput        string   `protobuf:"bytes,1,opt,name=command_output,json=commandOutput,proto3" json:"command_output,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	// raw output of the lvm commands, only meant for debugging
+	CommandOutput        string         `protobuf:"bytes,1,opt,name=command_output,json=commandOutput,proto3" json:"command_output,omitempty"`
+	Volume               *LogicalVolume `protobuf:"bytes,2,opt,name=volume,proto3" json:"volume,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
 }
 
 func (m *CreateThinPoolReply) Reset()         { *m = CreateThinPoolReply{} }
@@ -857,6 +1448,13 @@ func (m *CreateThinPoolReply) GetCommandOutput() string {
 	return ""
 }
 
+func (m *CreateThinPoolReply) GetVolume() *LogicalVolume {
+	if m != nil {
+		return m.Volume
+	}
+	return nil
+}
+
 type ChangeLVRequest struct {
 	VolumeGroup          string   `protobuf:"bytes,1,opt,name=volume_group,json=volumeGroup,proto3" json:"volume_group,omitempty"`
 	Name                 string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
@@ -905,10 +1503,12 @@ func (m *ChangeLVRequest) GetName() string {
 }
 
 type ChangeLVReply struct {
-	CommandOutput        string   `protobuf:"bytes,1,opt,name=command_output,json=commandOutput,proto3" json:"command_output,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	// raw output of the lvm commands, only meant for debugging
+	CommandOutput        string         `protobuf:"bytes,1,opt,name=command_output,json=commandOutput,proto3" json:"command_output,omitempty"`
+	Volume               *LogicalVolume `protobuf:"bytes,2,opt,name=volume,proto3" json:"volume,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
 }
 
 func (m *ChangeLVReply) Reset()         { *m = ChangeLVReply{} }
@@ -943,6 +1543,13 @@ func (m *ChangeLVReply) GetCommandOutput() string {
 	return ""
 }
 
+func (m *ChangeLVReply) GetVolume() *LogicalVolume {
+	if m != nil {
+		return m.Volume
+	}
+	return nil
+}
+
 type CreateThinLVRequest struct {
 	VolumeGroup          string   `protobuf:"bytes,1,opt,name=volume_group,json=volumeGroup,proto3" json:"volume_group,omitempty"`
 	Pool                 string   `protobuf:"bytes,2,opt,name=pool,proto3" json:"pool,omitempty"`
@@ -1023,10 +1630,12 @@ func (m *CreateThinLVRequest) GetTags() []string {
 }
 
 type CreateThinLVReply struct {
-	CommandOutput        string   `protobuf:"bytes,1,opt,name=command_output,json=commandOutput,proto3" json:"command_output,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	// raw output of the lvm commands, only meant for debugging
+	CommandOutput        string         `protobuf:"bytes,1,opt,name=command_output,json=commandOutput,proto3" json:"command_output,omitempty"`
+	Volume               *LogicalVolume `protobuf:"bytes,2,opt,name=volume,proto3" json:"volume,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
 }
 
 func (m *CreateThinLVReply) Reset()         { *m = CreateThinLVReply{} }
@@ -1061,6 +1670,13 @@ func (m *CreateThinLVReply) GetCommandOutput() string {
 	return ""
 }
 
+func (m *CreateThinLVReply) GetVolume() *LogicalVolume {
+	if m != nil {
+		return m.Volume
+	}
+	return nil
+}
+
 type RemoveLVRequest struct {
 	VolumeGroup          string   `protobuf:"bytes,1,opt,name=volume_group,json=volumeGroup,proto3" json:"volume_group,omitempty"`
 	Name                 string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
@@ -1148,8 +1764,12 @@ func (m *RemoveLVReply) GetCommandOutput() string {
 }
 
 type CloneLVRequest struct {
-	SourceName           string   `protobuf:"bytes,1,opt,name=source_name,json=sourceName,proto3" json:"source_name,omitempty"`
-	DestName             string   `protobuf:"bytes,2,opt,name=dest_name,json=destName,proto3" json:"dest_name,omitempty"`
+	// the destination volume must exist and be at least as large as the source
+	SourceName  string `protobuf:"bytes,1,opt,name=source_name,json=sourceName,proto3" json:"source_name,omitempty"`
+	DestName    string `protobuf:"bytes,2,opt,name=dest_name,json=destName,proto3" json:"dest_name,omitempty"`
+	VolumeGroup string `protobuf:"bytes,3,opt,name=volume_group,json=volumeGroup,proto3" json:"volume_group,omitempty"`
+	// volume group of the destination, defaults to volume_group
+	DestVolumeGroup      string   `protobuf:"bytes,4,opt,name=dest_volume_group,json=destVolumeGroup,proto3" json:"dest_volume_group,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -1194,1233 +1814,2866 @@ func (m *CloneLVRequest) GetDestName() string {
 	return ""
 }
 
-type CloneLVReply struct {
-	CommandOutput        string   `protobuf:"bytes,1,opt,name=command_output,json=commandOutput,proto3" json:"command_output,omitempty"`
+func (m *CloneLVRequest) GetVolumeGroup() string {
+	if m != nil {
+		return m.VolumeGroup
+	}
+	return ""
+}
+
+func (m *CloneLVRequest) GetDestVolumeGroup() string {
+	if m != nil {
+		return m.DestVolumeGroup
+	}
+	return ""
+}
+
+type CloneLVProgress struct {
+	// bytes of the source processed so far, including skipped zeroes
+	BytesCopied uint64 `protobuf:"varint,1,opt,name=bytes_copied,json=bytesCopied,proto3" json:"bytes_copied,omitempty"`
+	TotalBytes  uint64 `protobuf:"varint,2,opt,name=total_bytes,json=totalBytes,proto3" json:"total_bytes,omitempty"`
+	// zero regions not written because the destination already reads zeroes
+	BytesSkipped         uint64   `protobuf:"varint,3,opt,name=bytes_skipped,json=bytesSkipped,proto3" json:"bytes_skipped,omitempty"`
+	BytesPerSecond       uint64   `protobuf:"varint,4,opt,name=bytes_per_second,json=bytesPerSecond,proto3" json:"bytes_per_second,omitempty"`
+	Done                 bool     `protobuf:"varint,5,opt,name=done,proto3" json:"done,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *CloneLVReply) Reset()         { *m = CloneLVReply{} }
-func (m *CloneLVReply) String() string { return proto.CompactTextString(m) }
-func (*CloneLVReply) ProtoMessage()    {}
-func (*CloneLVReply) Descriptor() ([]byte, []int) {
+func (m *CloneLVProgress) Reset()         { *m = CloneLVProgress{} }
+func (m *CloneLVProgress) String() string { return proto.CompactTextString(m) }
+func (*CloneLVProgress) ProtoMessage()    {}
+func (*CloneLVProgress) Descriptor() ([]byte, []int) {
 	return fileDescriptor_8cc5677814b58357, []int{15}
 }
 
-func (m *CloneLVReply) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_CloneLVReply.Unmarshal(m, b)
+func (m *CloneLVProgress) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CloneLVProgress.Unmarshal(m, b)
 }
-func (m *CloneLVReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_CloneLVReply.Marshal(b, m, deterministic)
+func (m *CloneLVProgress) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CloneLVProgress.Marshal(b, m, deterministic)
 }
-func (m *CloneLVReply) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CloneLVReply.Merge(m, src)
+func (m *CloneLVProgress) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CloneLVProgress.Merge(m, src)
 }
-func (m *CloneLVReply) XXX_Size() int {
-	return xxx_messageInfo_CloneLVReply.Size(m)
+func (m *CloneLVProgress) XXX_Size() int {
+	return xxx_messageInfo_CloneLVProgress.Size(m)
 }
-func (m *CloneLVReply) XXX_DiscardUnknown() {
-	xxx_messageInfo_CloneLVReply.DiscardUnknown(m)
+func (m *CloneLVProgress) XXX_DiscardUnknown() {
+	xxx_messageInfo_CloneLVProgress.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_CloneLVReply proto.InternalMessageInfo
+var xxx_messageInfo_CloneLVProgress proto.InternalMessageInfo
 
-func (m *CloneLVReply) GetCommandOutput() string {
+func (m *CloneLVProgress) GetBytesCopied() uint64 {
 	if m != nil {
-		return m.CommandOutput
+		return m.BytesCopied
 	}
-	return ""
+	return 0
 }
 
-type ResizeLVRequest struct {
-	VolumeGroup          string   `protobuf:"bytes,1,opt,name=volume_group,json=volumeGroup,proto3" json:"volume_group,omitempty"`
-	Name                 string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Size                 uint64   `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
+func (m *CloneLVProgress) GetTotalBytes() uint64 {
+	if m != nil {
+		return m.TotalBytes
+	}
+	return 0
+}
+
+func (m *CloneLVProgress) GetBytesSkipped() uint64 {
+	if m != nil {
+		return m.BytesSkipped
+	}
+	return 0
+}
+
+func (m *CloneLVProgress) GetBytesPerSecond() uint64 {
+	if m != nil {
+		return m.BytesPerSecond
+	}
+	return 0
+}
+
+func (m *CloneLVProgress) GetDone() bool {
+	if m != nil {
+		return m.Done
+	}
+	return false
+}
+
+type CreateSnapshotRequest struct {
+	VolumeGroup string `protobuf:"bytes,1,opt,name=volume_group,json=volumeGroup,proto3" json:"volume_group,omitempty"`
+	Origin      string `protobuf:"bytes,2,opt,name=origin,proto3" json:"origin,omitempty"`
+	Name        string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	// size of the copy-on-write space of a thick snapshot, snapshots of thin
+	// volumes are sizeless and must leave it 0
+	Size                 uint64   `protobuf:"varint,4,opt,name=size,proto3" json:"size,omitempty"`
+	Tags                 []string `protobuf:"bytes,5,rep,name=tags,proto3" json:"tags,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ResizeLVRequest) Reset()         { *m = ResizeLVRequest{} }
-func (m *ResizeLVRequest) String() string { return proto.CompactTextString(m) }
-func (*ResizeLVRequest) ProtoMessage()    {}
-func (*ResizeLVRequest) Descriptor() ([]byte, []int) {
+func (m *CreateSnapshotRequest) Reset()         { *m = CreateSnapshotRequest{} }
+func (m *CreateSnapshotRequest) String() string { return proto.CompactTextString(m) }
+func (*CreateSnapshotRequest) ProtoMessage()    {}
+func (*CreateSnapshotRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_8cc5677814b58357, []int{16}
 }
 
-func (m *ResizeLVRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ResizeLVRequest.Unmarshal(m, b)
+func (m *CreateSnapshotRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateSnapshotRequest.Unmarshal(m, b)
 }
-func (m *ResizeLVRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ResizeLVRequest.Marshal(b, m, deterministic)
+func (m *CreateSnapshotRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateSnapshotRequest.Marshal(b, m, deterministic)
 }
-func (m *ResizeLVRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ResizeLVRequest.Merge(m, src)
+func (m *CreateSnapshotRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateSnapshotRequest.Merge(m, src)
 }
-func (m *ResizeLVRequest) XXX_Size() int {
-	return xxx_messageInfo_ResizeLVRequest.Size(m)
+func (m *CreateSnapshotRequest) XXX_Size() int {
+	return xxx_messageInfo_CreateSnapshotRequest.Size(m)
 }
-func (m *ResizeLVRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_ResizeLVRequest.DiscardUnknown(m)
+func (m *CreateSnapshotRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateSnapshotRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ResizeLVRequest proto.InternalMessageInfo
+var xxx_messageInfo_CreateSnapshotRequest proto.InternalMessageInfo
 
-func (m *ResizeLVRequest) GetVolumeGroup() string {
+func (m *CreateSnapshotRequest) GetVolumeGroup() string {
 	if m != nil {
 		return m.VolumeGroup
 	}
 	return ""
 }
 
-func (m *ResizeLVRequest) GetName() string {
+func (m *CreateSnapshotRequest) GetOrigin() string {
+	if m != nil {
+		return m.Origin
+	}
+	return ""
+}
+
+func (m *CreateSnapshotRequest) GetName() string {
 	if m != nil {
 		return m.Name
 	}
 	return ""
 }
 
-func (m *ResizeLVRequest) GetSize() uint64 {
+func (m *CreateSnapshotRequest) GetSize() uint64 {
 	if m != nil {
 		return m.Size
 	}
 	return 0
 }
 
-type ResizeLVReply struct {
-	CommandOutput        string   `protobuf:"bytes,1,opt,name=command_output,json=commandOutput,proto3" json:"command_output,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *CreateSnapshotRequest) GetTags() []string {
+	if m != nil {
+		return m.Tags
+	}
+	return nil
 }
 
-func (m *ResizeLVReply) Reset()         { *m = ResizeLVReply{} }
-func (m *ResizeLVReply) String() string { return proto.CompactTextString(m) }
-func (*ResizeLVReply) ProtoMessage()    {}
-func (*ResizeLVReply) Descriptor() ([]byte, []int) {
+type CreateSnapshotReply struct {
+	// raw output of the lvm commands, only meant for debugging
+	CommandOutput        string         `protobuf:"bytes,1,opt,name=command_output,json=commandOutput,proto3" json:"command_output,omitempty"`
+	Volume               *LogicalVolume `protobuf:"bytes,2,opt,name=volume,proto3" json:"volume,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *CreateSnapshotReply) Reset()         { *m = CreateSnapshotReply{} }
+func (m *CreateSnapshotReply) String() string { return proto.CompactTextString(m) }
+func (*CreateSnapshotReply) ProtoMessage()    {}
+func (*CreateSnapshotReply) Descriptor() ([]byte, []int) {
 	return fileDescriptor_8cc5677814b58357, []int{17}
 }
 
-func (m *ResizeLVReply) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ResizeLVReply.Unmarshal(m, b)
+func (m *CreateSnapshotReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateSnapshotReply.Unmarshal(m, b)
 }
-func (m *ResizeLVReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ResizeLVReply.Marshal(b, m, deterministic)
+func (m *CreateSnapshotReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateSnapshotReply.Marshal(b, m, deterministic)
 }
-func (m *ResizeLVReply) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ResizeLVReply.Merge(m, src)
+func (m *CreateSnapshotReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateSnapshotReply.Merge(m, src)
 }
-func (m *ResizeLVReply) XXX_Size() int {
-	return xxx_messageInfo_ResizeLVReply.Size(m)
+func (m *CreateSnapshotReply) XXX_Size() int {
+	return xxx_messageInfo_CreateSnapshotReply.Size(m)
 }
-func (m *ResizeLVReply) XXX_DiscardUnknown() {
-	xxx_messageInfo_ResizeLVReply.DiscardUnknown(m)
+func (m *CreateSnapshotReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateSnapshotReply.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ResizeLVReply proto.InternalMessageInfo
+var xxx_messageInfo_CreateSnapshotReply proto.InternalMessageInfo
 
-func (m *ResizeLVReply) GetCommandOutput() string {
+func (m *CreateSnapshotReply) GetCommandOutput() string {
 	if m != nil {
 		return m.CommandOutput
 	}
 	return ""
 }
 
-type ListVGRequest struct {
+func (m *CreateSnapshotReply) GetVolume() *LogicalVolume {
+	if m != nil {
+		return m.Volume
+	}
+	return nil
+}
+
+type ListSnapshotsRequest struct {
+	VolumeGroup string `protobuf:"bytes,1,opt,name=volume_group,json=volumeGroup,proto3" json:"volume_group,omitempty"`
+	// only list the snapshots of this volume when not empty
+	Origin               string   `protobuf:"bytes,2,opt,name=origin,proto3" json:"origin,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ListVGRequest) Reset()         { *m = ListVGRequest{} }
-func (m *ListVGRequest) String() string { return proto.CompactTextString(m) }
-func (*ListVGRequest) ProtoMessage()    {}
-func (*ListVGRequest) Descriptor() ([]byte, []int) {
+func (m *ListSnapshotsRequest) Reset()         { *m = ListSnapshotsRequest{} }
+func (m *ListSnapshotsRequest) String() string { return proto.CompactTextString(m) }
+func (*ListSnapshotsRequest) ProtoMessage()    {}
+func (*ListSnapshotsRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_8cc5677814b58357, []int{18}
 }
 
-func (m *ListVGRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ListVGRequest.Unmarshal(m, b)
+func (m *ListSnapshotsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListSnapshotsRequest.Unmarshal(m, b)
 }
-func (m *ListVGRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ListVGRequest.Marshal(b, m, deterministic)
+func (m *ListSnapshotsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListSnapshotsRequest.Marshal(b, m, deterministic)
 }
-func (m *ListVGRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ListVGRequest.Merge(m, src)
+func (m *ListSnapshotsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListSnapshotsRequest.Merge(m, src)
 }
-func (m *ListVGRequest) XXX_Size() int {
-	return xxx_messageInfo_ListVGRequest.Size(m)
+func (m *ListSnapshotsRequest) XXX_Size() int {
+	return xxx_messageInfo_ListSnapshotsRequest.Size(m)
 }
-func (m *ListVGRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_ListVGRequest.DiscardUnknown(m)
+func (m *ListSnapshotsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListSnapshotsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ListVGRequest proto.InternalMessageInfo
+var xxx_messageInfo_ListSnapshotsRequest proto.InternalMessageInfo
 
-type ListVGReply struct {
-	VolumeGroups         []*VolumeGroup `protobuf:"bytes,1,rep,name=volume_groups,json=volumeGroups,proto3" json:"volume_groups,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
-	XXX_unrecognized     []byte         `json:"-"`
-	XXX_sizecache        int32          `json:"-"`
+func (m *ListSnapshotsRequest) GetVolumeGroup() string {
+	if m != nil {
+		return m.VolumeGroup
+	}
+	return ""
 }
 
-func (m *ListVGReply) Reset()         { *m = ListVGReply{} }
-func (m *ListVGReply) String() string { return proto.CompactTextString(m) }
-func (*ListVGReply) ProtoMessage()    {}
-func (*ListVGReply) Descriptor() ([]byte, []int) {
+func (m *ListSnapshotsRequest) GetOrigin() string {
+	if m != nil {
+		return m.Origin
+	}
+	return ""
+}
+
+type ListSnapshotsReply struct {
+	Snapshots            []*LogicalVolume `protobuf:"bytes,1,rep,name=snapshots,proto3" json:"snapshots,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *ListSnapshotsReply) Reset()         { *m = ListSnapshotsReply{} }
+func (m *ListSnapshotsReply) String() string { return proto.CompactTextString(m) }
+func (*ListSnapshotsReply) ProtoMessage()    {}
+func (*ListSnapshotsReply) Descriptor() ([]byte, []int) {
 	return fileDescriptor_8cc5677814b58357, []int{19}
 }
 
-func (m *ListVGReply) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ListVGReply.Unmarshal(m, b)
+func (m *ListSnapshotsReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListSnapshotsReply.Unmarshal(m, b)
 }
-func (m *ListVGReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ListVGReply.Marshal(b, m, deterministic)
+func (m *ListSnapshotsReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListSnapshotsReply.Marshal(b, m, deterministic)
 }
-func (m *ListVGReply) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ListVGReply.Merge(m, src)
+func (m *ListSnapshotsReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListSnapshotsReply.Merge(m, src)
 }
-func (m *ListVGReply) XXX_Size() int {
-	return xxx_messageInfo_ListVGReply.Size(m)
+func (m *ListSnapshotsReply) XXX_Size() int {
+	return xxx_messageInfo_ListSnapshotsReply.Size(m)
 }
-func (m *ListVGReply) XXX_DiscardUnknown() {
-	xxx_messageInfo_ListVGReply.DiscardUnknown(m)
+func (m *ListSnapshotsReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListSnapshotsReply.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ListVGReply proto.InternalMessageInfo
+var xxx_messageInfo_ListSnapshotsReply proto.InternalMessageInfo
 
-func (m *ListVGReply) GetVolumeGroups() []*VolumeGroup {
+func (m *ListSnapshotsReply) GetSnapshots() []*LogicalVolume {
 	if m != nil {
-		return m.VolumeGroups
+		return m.Snapshots
 	}
 	return nil
 }
 
-type CreateVGRequest struct {
-	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	PhysicalVolume       string   `protobuf:"bytes,2,opt,name=physical_volume,json=physicalVolume,proto3" json:"physical_volume,omitempty"`
-	Tags                 []string `protobuf:"bytes,3,rep,name=tags,proto3" json:"tags,omitempty"`
+type MergeSnapshotRequest struct {
+	VolumeGroup          string   `protobuf:"bytes,1,opt,name=volume_group,json=volumeGroup,proto3" json:"volume_group,omitempty"`
+	Name                 string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *CreateVGRequest) Reset()         { *m = CreateVGRequest{} }
-func (m *CreateVGRequest) String() string { return proto.CompactTextString(m) }
-func (*CreateVGRequest) ProtoMessage()    {}
-func (*CreateVGRequest) Descriptor() ([]byte, []int) {
+func (m *MergeSnapshotRequest) Reset()         { *m = MergeSnapshotRequest{} }
+func (m *MergeSnapshotRequest) String() string { return proto.CompactTextString(m) }
+func (*MergeSnapshotRequest) ProtoMessage()    {}
+func (*MergeSnapshotRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_8cc5677814b58357, []int{20}
 }
 
-func (m *CreateVGRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_CreateVGRequest.Unmarshal(m, b)
+func (m *MergeSnapshotRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MergeSnapshotRequest.Unmarshal(m, b)
 }
-func (m *CreateVGRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_CreateVGRequest.Marshal(b, m, deterministic)
+func (m *MergeSnapshotRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MergeSnapshotRequest.Marshal(b, m, deterministic)
 }
-func (m *CreateVGRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CreateVGRequest.Merge(m, src)
+func (m *MergeSnapshotRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MergeSnapshotRequest.Merge(m, src)
 }
-func (m *CreateVGRequest) XXX_Size() int {
-	return xxx_messageInfo_CreateVGRequest.Size(m)
+func (m *MergeSnapshotRequest) XXX_Size() int {
+	return xxx_messageInfo_MergeSnapshotRequest.Size(m)
 }
-func (m *CreateVGRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_CreateVGRequest.DiscardUnknown(m)
+func (m *MergeSnapshotRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MergeSnapshotRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_CreateVGRequest proto.InternalMessageInfo
+var xxx_messageInfo_MergeSnapshotRequest proto.InternalMessageInfo
 
-func (m *CreateVGRequest) GetName() string {
+func (m *MergeSnapshotRequest) GetVolumeGroup() string {
 	if m != nil {
-		return m.Name
+		return m.VolumeGroup
 	}
 	return ""
 }
 
-func (m *CreateVGRequest) GetPhysicalVolume() string {
+func (m *MergeSnapshotRequest) GetName() string {
 	if m != nil {
-		return m.PhysicalVolume
+		return m.Name
 	}
 	return ""
 }
 
-func (m *CreateVGRequest) GetTags() []string {
-	if m != nil {
-		return m.Tags
-	}
-	return nil
-}
-
-type CreateVGReply struct {
-	CommandOutput        string   `protobuf:"bytes,1,opt,name=command_output,json=commandOutput,proto3" json:"command_output,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+type MergeSnapshotReply struct {
+	// raw output of the lvm commands, only meant for debugging
+	CommandOutput string `protobuf:"bytes,1,opt,name=command_output,json=commandOutput,proto3" json:"command_output,omitempty"`
+	// the origin after the merge, it still shows the merging snapshot when
+	// the merge is deferred until the next activation of the origin
+	Origin               *LogicalVolume `protobuf:"bytes,2,opt,name=origin,proto3" json:"origin,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
 }
 
-func (m *CreateVGReply) Reset()         { *m = CreateVGReply{} }
-func (m *CreateVGReply) String() string { return proto.CompactTextString(m) }
-func (*CreateVGReply) ProtoMessage()    {}
-func (*CreateVGReply) Descriptor() ([]byte, []int) {
+func (m *MergeSnapshotReply) Reset()         { *m = MergeSnapshotReply{} }
+func (m *MergeSnapshotReply) String() string { return proto.CompactTextString(m) }
+func (*MergeSnapshotReply) ProtoMessage()    {}
+func (*MergeSnapshotReply) Descriptor() ([]byte, []int) {
 	return fileDescriptor_8cc5677814b58357, []int{21}
 }
 
-func (m *CreateVGReply) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_CreateVGReply.Unmarshal(m, b)
+func (m *MergeSnapshotReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MergeSnapshotReply.Unmarshal(m, b)
 }
-func (m *CreateVGReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_CreateVGReply.Marshal(b, m, deterministic)
+func (m *MergeSnapshotReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MergeSnapshotReply.Marshal(b, m, deterministic)
 }
-func (m *CreateVGReply) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CreateVGReply.Merge(m, src)
+func (m *MergeSnapshotReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MergeSnapshotReply.Merge(m, src)
 }
-func (m *CreateVGReply) XXX_Size() int {
-	return xxx_messageInfo_CreateVGReply.Size(m)
+func (m *MergeSnapshotReply) XXX_Size() int {
+	return xxx_messageInfo_MergeSnapshotReply.Size(m)
 }
-func (m *CreateVGReply) XXX_DiscardUnknown() {
-	xxx_messageInfo_CreateVGReply.DiscardUnknown(m)
+func (m *MergeSnapshotReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_MergeSnapshotReply.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_CreateVGReply proto.InternalMessageInfo
+var xxx_messageInfo_MergeSnapshotReply proto.InternalMessageInfo
 
-func (m *CreateVGReply) GetCommandOutput() string {
+func (m *MergeSnapshotReply) GetCommandOutput() string {
 	if m != nil {
 		return m.CommandOutput
 	}
 	return ""
 }
 
-type RemoveVGRequest struct {
-	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *MergeSnapshotReply) GetOrigin() *LogicalVolume {
+	if m != nil {
+		return m.Origin
+	}
+	return nil
 }
 
-func (m *RemoveVGRequest) Reset()         { *m = RemoveVGRequest{} }
-func (m *RemoveVGRequest) String() string { return proto.CompactTextString(m) }
-func (*RemoveVGRequest) ProtoMessage()    {}
-func (*RemoveVGRequest) Descriptor() ([]byte, []int) {
+type ThinPoolStatus struct {
+	Name         string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	VolumeGroup  string `protobuf:"bytes,2,opt,name=volume_group,json=volumeGroup,proto3" json:"volume_group,omitempty"`
+	Size         uint64 `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
+	MetadataSize uint64 `protobuf:"varint,4,opt,name=metadata_size,json=metadataSize,proto3" json:"metadata_size,omitempty"`
+	// usage of the data and metadata, 0 while the pool isn't active
+	DataPercent     float64 `protobuf:"fixed64,5,opt,name=data_percent,json=dataPercent,proto3" json:"data_percent,omitempty"`
+	MetadataPercent float64 `protobuf:"fixed64,6,opt,name=metadata_percent,json=metadataPercent,proto3" json:"metadata_percent,omitempty"`
+	ChunkSize       uint64  `protobuf:"varint,7,opt,name=chunk_size,json=chunkSize,proto3" json:"chunk_size,omitempty"`
+	TransactionId   uint64  `protobuf:"varint,8,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	// sum of the sizes of the thin volumes in the pool
+	VirtualSize uint64 `protobuf:"varint,9,opt,name=virtual_size,json=virtualSize,proto3" json:"virtual_size,omitempty"`
+	ThinVolumes uint32 `protobuf:"varint,10,opt,name=thin_volumes,json=thinVolumes,proto3" json:"thin_volumes,omitempty"`
+	// virtual_size relative to size, the pool is overcommitted above 1
+	OvercommitRatio float64 `protobuf:"fixed64,11,opt,name=overcommit_ratio,json=overcommitRatio,proto3" json:"overcommit_ratio,omitempty"`
+	// autoextend policy set on the pool, unset when pools created by lvmd
+	// follow the policy of the daemon
+	Policy               *ThinPoolPolicy `protobuf:"bytes,12,opt,name=policy,proto3" json:"policy,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *ThinPoolStatus) Reset()         { *m = ThinPoolStatus{} }
+func (m *ThinPoolStatus) String() string { return proto.CompactTextString(m) }
+func (*ThinPoolStatus) ProtoMessage()    {}
+func (*ThinPoolStatus) Descriptor() ([]byte, []int) {
 	return fileDescriptor_8cc5677814b58357, []int{22}
 }
 
-func (m *RemoveVGRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_RemoveVGRequest.Unmarshal(m, b)
+func (m *ThinPoolStatus) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ThinPoolStatus.Unmarshal(m, b)
 }
-func (m *RemoveVGRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_RemoveVGRequest.Marshal(b, m, deterministic)
+func (m *ThinPoolStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ThinPoolStatus.Marshal(b, m, deterministic)
 }
-func (m *RemoveVGRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RemoveVGRequest.Merge(m, src)
+func (m *ThinPoolStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ThinPoolStatus.Merge(m, src)
 }
-func (m *RemoveVGRequest) XXX_Size() int {
-	return xxx_messageInfo_RemoveVGRequest.Size(m)
+func (m *ThinPoolStatus) XXX_Size() int {
+	return xxx_messageInfo_ThinPoolStatus.Size(m)
 }
-func (m *RemoveVGRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_RemoveVGRequest.DiscardUnknown(m)
+func (m *ThinPoolStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_ThinPoolStatus.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_RemoveVGRequest proto.InternalMessageInfo
+var xxx_messageInfo_ThinPoolStatus proto.InternalMessageInfo
 
-func (m *RemoveVGRequest) GetName() string {
+func (m *ThinPoolStatus) GetName() string {
 	if m != nil {
 		return m.Name
 	}
 	return ""
 }
 
-type RemoveVGReply struct {
-	CommandOutput        string   `protobuf:"bytes,1,opt,name=command_output,json=commandOutput,proto3" json:"command_output,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *ThinPoolStatus) GetVolumeGroup() string {
+	if m != nil {
+		return m.VolumeGroup
+	}
+	return ""
 }
 
-func (m *RemoveVGReply) Reset()         { *m = RemoveVGReply{} }
-func (m *RemoveVGReply) String() string { return proto.CompactTextString(m) }
-func (*RemoveVGReply) ProtoMessage()    {}
-func (*RemoveVGReply) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8cc5677814b58357, []int{23}
+func (m *ThinPoolStatus) GetSize() uint64 {
+	if m != nil {
+		return m.Size
+	}
+	return 0
 }
 
-func (m *RemoveVGReply) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_RemoveVGReply.Unmarshal(m, b)
+func (m *ThinPoolStatus) GetMetadataSize() uint64 {
+	if m != nil {
+		return m.MetadataSize
+	}
+	return 0
 }
-func (m *RemoveVGReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_RemoveVGReply.Marshal(b, m, deterministic)
+
+func (m *ThinPoolStatus) GetDataPercent() float64 {
+	if m != nil {
+		return m.DataPercent
+	}
+	return 0
 }
-func (m *RemoveVGReply) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RemoveVGReply.Merge(m, src)
+
+func (m *ThinPoolStatus) GetMetadataPercent() float64 {
+	if m != nil {
+		return m.MetadataPercent
+	}
+	return 0
 }
-func (m *RemoveVGReply) XXX_Size() int {
-	return xxx_messageInfo_RemoveVGReply.Size(m)
+
+func (m *ThinPoolStatus) GetChunkSize() uint64 {
+	if m != nil {
+		return m.ChunkSize
+	}
+	return 0
 }
-func (m *RemoveVGReply) XXX_DiscardUnknown() {
-	xxx_messageInfo_RemoveVGReply.DiscardUnknown(m)
+
+func (m *ThinPoolStatus) GetTransactionId() uint64 {
+	if m != nil {
+		return m.TransactionId
+	}
+	return 0
 }
 
-var xxx_messageInfo_RemoveVGReply proto.InternalMessageInfo
+func (m *ThinPoolStatus) GetVirtualSize() uint64 {
+	if m != nil {
+		return m.VirtualSize
+	}
+	return 0
+}
 
-func (m *RemoveVGReply) GetCommandOutput() string {
+func (m *ThinPoolStatus) GetThinVolumes() uint32 {
 	if m != nil {
-		return m.CommandOutput
+		return m.ThinVolumes
 	}
-	return ""
+	return 0
 }
 
-type ExtendVGRequest struct {
-	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	PhysicalVolume       string   `protobuf:"bytes,2,opt,name=physical_volume,json=physicalVolume,proto3" json:"physical_volume,omitempty"`
+func (m *ThinPoolStatus) GetOvercommitRatio() float64 {
+	if m != nil {
+		return m.OvercommitRatio
+	}
+	return 0
+}
+
+func (m *ThinPoolStatus) GetPolicy() *ThinPoolPolicy {
+	if m != nil {
+		return m.Policy
+	}
+	return nil
+}
+
+// ThinPoolPolicy makes lvmd extend the data or metadata of a thin pool by
+// extend_percent of its size when its usage reaches threshold percent, a
+// threshold of 0 disables autoextend. Extensions are bounded by the free
+// space of the volume group
+type ThinPoolPolicy struct {
+	Threshold            uint32   `protobuf:"varint,1,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	ExtendPercent        uint32   `protobuf:"varint,2,opt,name=extend_percent,json=extendPercent,proto3" json:"extend_percent,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ExtendVGRequest) Reset()         { *m = ExtendVGRequest{} }
-func (m *ExtendVGRequest) String() string { return proto.CompactTextString(m) }
-func (*ExtendVGRequest) ProtoMessage()    {}
-func (*ExtendVGRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8cc5677814b58357, []int{24}
+func (m *ThinPoolPolicy) Reset()         { *m = ThinPoolPolicy{} }
+func (m *ThinPoolPolicy) String() string { return proto.CompactTextString(m) }
+func (*ThinPoolPolicy) ProtoMessage()    {}
+func (*ThinPoolPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8cc5677814b58357, []int{23}
 }
 
-func (m *ExtendVGRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ExtendVGRequest.Unmarshal(m, b)
+func (m *ThinPoolPolicy) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ThinPoolPolicy.Unmarshal(m, b)
 }
-func (m *ExtendVGRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ExtendVGRequest.Marshal(b, m, deterministic)
+func (m *ThinPoolPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ThinPoolPolicy.Marshal(b, m, deterministic)
 }
-func (m *ExtendVGRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ExtendVGRequest.Merge(m, src)
+func (m *ThinPoolPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ThinPoolPolicy.Merge(m, src)
 }
-func (m *ExtendVGRequest) XXX_Size() int {
-	return xxx_messageInfo_ExtendVGRequest.Size(m)
+func (m *ThinPoolPolicy) XXX_Size() int {
+	return xxx_messageInfo_ThinPoolPolicy.Size(m)
 }
-func (m *ExtendVGRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_ExtendVGRequest.DiscardUnknown(m)
+func (m *ThinPoolPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_ThinPoolPolicy.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ExtendVGRequest proto.InternalMessageInfo
+var xxx_messageInfo_ThinPoolPolicy proto.InternalMessageInfo
 
-func (m *ExtendVGRequest) GetName() string {
+func (m *ThinPoolPolicy) GetThreshold() uint32 {
 	if m != nil {
-		return m.Name
+		return m.Threshold
 	}
-	return ""
+	return 0
 }
 
-func (m *ExtendVGRequest) GetPhysicalVolume() string {
+func (m *ThinPoolPolicy) GetExtendPercent() uint32 {
 	if m != nil {
-		return m.PhysicalVolume
+		return m.ExtendPercent
 	}
-	return ""
+	return 0
 }
 
-type ExtendVGReply struct {
-	CommandOutput        string   `protobuf:"bytes,1,opt,name=command_output,json=commandOutput,proto3" json:"command_output,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+type SetThinPoolPolicyRequest struct {
+	VolumeGroup          string          `protobuf:"bytes,1,opt,name=volume_group,json=volumeGroup,proto3" json:"volume_group,omitempty"`
+	Pool                 string          `protobuf:"bytes,2,opt,name=pool,proto3" json:"pool,omitempty"`
+	Policy               *ThinPoolPolicy `protobuf:"bytes,3,opt,name=policy,proto3" json:"policy,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
 }
 
-func (m *ExtendVGReply) Reset()         { *m = ExtendVGReply{} }
-func (m *ExtendVGReply) String() string { return proto.CompactTextString(m) }
-func (*ExtendVGReply) ProtoMessage()    {}
-func (*ExtendVGReply) Descriptor() ([]byte, []int) {
+func (m *SetThinPoolPolicyRequest) Reset()         { *m = SetThinPoolPolicyRequest{} }
+func (m *SetThinPoolPolicyRequest) String() string { return proto.CompactTextString(m) }
+func (*SetThinPoolPolicyRequest) ProtoMessage()    {}
+func (*SetThinPoolPolicyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8cc5677814b58357, []int{24}
+}
+
+func (m *SetThinPoolPolicyRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetThinPoolPolicyRequest.Unmarshal(m, b)
+}
+func (m *SetThinPoolPolicyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetThinPoolPolicyRequest.Marshal(b, m, deterministic)
+}
+func (m *SetThinPoolPolicyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetThinPoolPolicyRequest.Merge(m, src)
+}
+func (m *SetThinPoolPolicyRequest) XXX_Size() int {
+	return xxx_messageInfo_SetThinPoolPolicyRequest.Size(m)
+}
+func (m *SetThinPoolPolicyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetThinPoolPolicyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetThinPoolPolicyRequest proto.InternalMessageInfo
+
+func (m *SetThinPoolPolicyRequest) GetVolumeGroup() string {
+	if m != nil {
+		return m.VolumeGroup
+	}
+	return ""
+}
+
+func (m *SetThinPoolPolicyRequest) GetPool() string {
+	if m != nil {
+		return m.Pool
+	}
+	return ""
+}
+
+func (m *SetThinPoolPolicyRequest) GetPolicy() *ThinPoolPolicy {
+	if m != nil {
+		return m.Policy
+	}
+	return nil
+}
+
+type SetThinPoolPolicyReply struct {
+	// raw output of the lvm commands, only meant for debugging
+	CommandOutput        string          `protobuf:"bytes,1,opt,name=command_output,json=commandOutput,proto3" json:"command_output,omitempty"`
+	Status               *ThinPoolStatus `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *SetThinPoolPolicyReply) Reset()         { *m = SetThinPoolPolicyReply{} }
+func (m *SetThinPoolPolicyReply) String() string { return proto.CompactTextString(m) }
+func (*SetThinPoolPolicyReply) ProtoMessage()    {}
+func (*SetThinPoolPolicyReply) Descriptor() ([]byte, []int) {
 	return fileDescriptor_8cc5677814b58357, []int{25}
 }
 
-func (m *ExtendVGReply) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ExtendVGReply.Unmarshal(m, b)
+func (m *SetThinPoolPolicyReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetThinPoolPolicyReply.Unmarshal(m, b)
 }
-func (m *ExtendVGReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ExtendVGReply.Marshal(b, m, deterministic)
+func (m *SetThinPoolPolicyReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetThinPoolPolicyReply.Marshal(b, m, deterministic)
 }
-func (m *ExtendVGReply) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ExtendVGReply.Merge(m, src)
+func (m *SetThinPoolPolicyReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetThinPoolPolicyReply.Merge(m, src)
 }
-func (m *ExtendVGReply) XXX_Size() int {
-	return xxx_messageInfo_ExtendVGReply.Size(m)
+func (m *SetThinPoolPolicyReply) XXX_Size() int {
+	return xxx_messageInfo_SetThinPoolPolicyReply.Size(m)
 }
-func (m *ExtendVGReply) XXX_DiscardUnknown() {
-	xxx_messageInfo_ExtendVGReply.DiscardUnknown(m)
+func (m *SetThinPoolPolicyReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetThinPoolPolicyReply.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ExtendVGReply proto.InternalMessageInfo
+var xxx_messageInfo_SetThinPoolPolicyReply proto.InternalMessageInfo
 
-func (m *ExtendVGReply) GetCommandOutput() string {
+func (m *SetThinPoolPolicyReply) GetCommandOutput() string {
 	if m != nil {
 		return m.CommandOutput
 	}
 	return ""
 }
 
-type AddTagLVRequest struct {
+func (m *SetThinPoolPolicyReply) GetStatus() *ThinPoolStatus {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+type GetThinPoolStatusRequest struct {
 	VolumeGroup          string   `protobuf:"bytes,1,opt,name=volume_group,json=volumeGroup,proto3" json:"volume_group,omitempty"`
-	Name                 string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Tags                 []string `protobuf:"bytes,3,rep,name=tags,proto3" json:"tags,omitempty"`
+	Pool                 string   `protobuf:"bytes,2,opt,name=pool,proto3" json:"pool,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *AddTagLVRequest) Reset()         { *m = AddTagLVRequest{} }
-func (m *AddTagLVRequest) String() string { return proto.CompactTextString(m) }
-func (*AddTagLVRequest) ProtoMessage()    {}
-func (*AddTagLVRequest) Descriptor() ([]byte, []int) {
+func (m *GetThinPoolStatusRequest) Reset()         { *m = GetThinPoolStatusRequest{} }
+func (m *GetThinPoolStatusRequest) String() string { return proto.CompactTextString(m) }
+func (*GetThinPoolStatusRequest) ProtoMessage()    {}
+func (*GetThinPoolStatusRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_8cc5677814b58357, []int{26}
 }
 
-func (m *AddTagLVRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_AddTagLVRequest.Unmarshal(m, b)
+func (m *GetThinPoolStatusRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetThinPoolStatusRequest.Unmarshal(m, b)
 }
-func (m *AddTagLVRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_AddTagLVRequest.Marshal(b, m, deterministic)
+func (m *GetThinPoolStatusRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetThinPoolStatusRequest.Marshal(b, m, deterministic)
 }
-func (m *AddTagLVRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_AddTagLVRequest.Merge(m, src)
+func (m *GetThinPoolStatusRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetThinPoolStatusRequest.Merge(m, src)
 }
-func (m *AddTagLVRequest) XXX_Size() int {
-	return xxx_messageInfo_AddTagLVRequest.Size(m)
+func (m *GetThinPoolStatusRequest) XXX_Size() int {
+	return xxx_messageInfo_GetThinPoolStatusRequest.Size(m)
 }
-func (m *AddTagLVRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_AddTagLVRequest.DiscardUnknown(m)
+func (m *GetThinPoolStatusRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetThinPoolStatusRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_AddTagLVRequest proto.InternalMessageInfo
+var xxx_messageInfo_GetThinPoolStatusRequest proto.InternalMessageInfo
 
-func (m *AddTagLVRequest) GetVolumeGroup() string {
+func (m *GetThinPoolStatusRequest) GetVolumeGroup() string {
 	if m != nil {
 		return m.VolumeGroup
 	}
 	return ""
 }
 
-func (m *AddTagLVRequest) GetName() string {
+func (m *GetThinPoolStatusRequest) GetPool() string {
 	if m != nil {
-		return m.Name
+		return m.Pool
 	}
 	return ""
 }
 
-func (m *AddTagLVRequest) GetTags() []string {
+type GetThinPoolStatusReply struct {
+	Status               *ThinPoolStatus `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *GetThinPoolStatusReply) Reset()         { *m = GetThinPoolStatusReply{} }
+func (m *GetThinPoolStatusReply) String() string { return proto.CompactTextString(m) }
+func (*GetThinPoolStatusReply) ProtoMessage()    {}
+func (*GetThinPoolStatusReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8cc5677814b58357, []int{27}
+}
+
+func (m *GetThinPoolStatusReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetThinPoolStatusReply.Unmarshal(m, b)
+}
+func (m *GetThinPoolStatusReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetThinPoolStatusReply.Marshal(b, m, deterministic)
+}
+func (m *GetThinPoolStatusReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetThinPoolStatusReply.Merge(m, src)
+}
+func (m *GetThinPoolStatusReply) XXX_Size() int {
+	return xxx_messageInfo_GetThinPoolStatusReply.Size(m)
+}
+func (m *GetThinPoolStatusReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetThinPoolStatusReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetThinPoolStatusReply proto.InternalMessageInfo
+
+func (m *GetThinPoolStatusReply) GetStatus() *ThinPoolStatus {
 	if m != nil {
-		return m.Tags
+		return m.Status
 	}
 	return nil
 }
 
-type AddTagLVReply struct {
-	CommandOutput        string   `protobuf:"bytes,1,opt,name=command_output,json=commandOutput,proto3" json:"command_output,omitempty"`
+type ResizeLVRequest struct {
+	VolumeGroup string `protobuf:"bytes,1,opt,name=volume_group,json=volumeGroup,proto3" json:"volume_group,omitempty"`
+	Name        string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Size        uint64 `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
+	// only resize the volume and leave growing its filesystem to the caller,
+	// volumes with a filesystem still can't be shrunk
+	BlockOnly            bool     `protobuf:"varint,4,opt,name=block_only,json=blockOnly,proto3" json:"block_only,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *AddTagLVReply) Reset()         { *m = AddTagLVReply{} }
-func (m *AddTagLVReply) String() string { return proto.CompactTextString(m) }
-func (*AddTagLVReply) ProtoMessage()    {}
-func (*AddTagLVReply) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8cc5677814b58357, []int{27}
+func (m *ResizeLVRequest) Reset()         { *m = ResizeLVRequest{} }
+func (m *ResizeLVRequest) String() string { return proto.CompactTextString(m) }
+func (*ResizeLVRequest) ProtoMessage()    {}
+func (*ResizeLVRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8cc5677814b58357, []int{28}
 }
 
-func (m *AddTagLVReply) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_AddTagLVReply.Unmarshal(m, b)
+func (m *ResizeLVRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ResizeLVRequest.Unmarshal(m, b)
 }
-func (m *AddTagLVReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_AddTagLVReply.Marshal(b, m, deterministic)
+func (m *ResizeLVRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ResizeLVRequest.Marshal(b, m, deterministic)
 }
-func (m *AddTagLVReply) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_AddTagLVReply.Merge(m, src)
+func (m *ResizeLVRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResizeLVRequest.Merge(m, src)
 }
-func (m *AddTagLVReply) XXX_Size() int {
-	return xxx_messageInfo_AddTagLVReply.Size(m)
+func (m *ResizeLVRequest) XXX_Size() int {
+	return xxx_messageInfo_ResizeLVRequest.Size(m)
 }
-func (m *AddTagLVReply) XXX_DiscardUnknown() {
-	xxx_messageInfo_AddTagLVReply.DiscardUnknown(m)
+func (m *ResizeLVRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResizeLVRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_AddTagLVReply proto.InternalMessageInfo
+var xxx_messageInfo_ResizeLVRequest proto.InternalMessageInfo
 
-func (m *AddTagLVReply) GetCommandOutput() string {
+func (m *ResizeLVRequest) GetVolumeGroup() string {
+	if m != nil {
+		return m.VolumeGroup
+	}
+	return ""
+}
+
+func (m *ResizeLVRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *ResizeLVRequest) GetSize() uint64 {
+	if m != nil {
+		return m.Size
+	}
+	return 0
+}
+
+func (m *ResizeLVRequest) GetBlockOnly() bool {
+	if m != nil {
+		return m.BlockOnly
+	}
+	return false
+}
+
+type ResizeLVReply struct {
+	// raw output of the lvm commands, only meant for debugging
+	CommandOutput        string         `protobuf:"bytes,1,opt,name=command_output,json=commandOutput,proto3" json:"command_output,omitempty"`
+	Volume               *LogicalVolume `protobuf:"bytes,2,opt,name=volume,proto3" json:"volume,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *ResizeLVReply) Reset()         { *m = ResizeLVReply{} }
+func (m *ResizeLVReply) String() string { return proto.CompactTextString(m) }
+func (*ResizeLVReply) ProtoMessage()    {}
+func (*ResizeLVReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8cc5677814b58357, []int{29}
+}
+
+func (m *ResizeLVReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ResizeLVReply.Unmarshal(m, b)
+}
+func (m *ResizeLVReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ResizeLVReply.Marshal(b, m, deterministic)
+}
+func (m *ResizeLVReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResizeLVReply.Merge(m, src)
+}
+func (m *ResizeLVReply) XXX_Size() int {
+	return xxx_messageInfo_ResizeLVReply.Size(m)
+}
+func (m *ResizeLVReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResizeLVReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResizeLVReply proto.InternalMessageInfo
+
+func (m *ResizeLVReply) GetCommandOutput() string {
 	if m != nil {
 		return m.CommandOutput
 	}
 	return ""
 }
 
-type RemoveTagLVRequest struct {
-	VolumeGroup          string   `protobuf:"bytes,1,opt,name=volume_group,json=volumeGroup,proto3" json:"volume_group,omitempty"`
-	Name                 string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Tags                 []string `protobuf:"bytes,3,rep,name=tags,proto3" json:"tags,omitempty"`
+func (m *ResizeLVReply) GetVolume() *LogicalVolume {
+	if m != nil {
+		return m.Volume
+	}
+	return nil
+}
+
+type ListVGRequest struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *RemoveTagLVRequest) Reset()         { *m = RemoveTagLVRequest{} }
-func (m *RemoveTagLVRequest) String() string { return proto.CompactTextString(m) }
-func (*RemoveTagLVRequest) ProtoMessage()    {}
-func (*RemoveTagLVRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8cc5677814b58357, []int{28}
+func (m *ListVGRequest) Reset()         { *m = ListVGRequest{} }
+func (m *ListVGRequest) String() string { return proto.CompactTextString(m) }
+func (*ListVGRequest) ProtoMessage()    {}
+func (*ListVGRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8cc5677814b58357, []int{30}
 }
 
-func (m *RemoveTagLVRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_RemoveTagLVRequest.Unmarshal(m, b)
+func (m *ListVGRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListVGRequest.Unmarshal(m, b)
+}
+func (m *ListVGRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListVGRequest.Marshal(b, m, deterministic)
+}
+func (m *ListVGRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListVGRequest.Merge(m, src)
+}
+func (m *ListVGRequest) XXX_Size() int {
+	return xxx_messageInfo_ListVGRequest.Size(m)
+}
+func (m *ListVGRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListVGRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListVGRequest proto.InternalMessageInfo
+
+type ListVGReply struct {
+	VolumeGroups         []*VolumeGroup `protobuf:"bytes,1,rep,name=volume_groups,json=volumeGroups,proto3" json:"volume_groups,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *ListVGReply) Reset()         { *m = ListVGReply{} }
+func (m *ListVGReply) String() string { return proto.CompactTextString(m) }
+func (*ListVGReply) ProtoMessage()    {}
+func (*ListVGReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8cc5677814b58357, []int{31}
+}
+
+func (m *ListVGReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListVGReply.Unmarshal(m, b)
+}
+func (m *ListVGReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListVGReply.Marshal(b, m, deterministic)
+}
+func (m *ListVGReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListVGReply.Merge(m, src)
+}
+func (m *ListVGReply) XXX_Size() int {
+	return xxx_messageInfo_ListVGReply.Size(m)
+}
+func (m *ListVGReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListVGReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListVGReply proto.InternalMessageInfo
+
+func (m *ListVGReply) GetVolumeGroups() []*VolumeGroup {
+	if m != nil {
+		return m.VolumeGroups
+	}
+	return nil
+}
+
+type CreateVGRequest struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	PhysicalVolume       string   `protobuf:"bytes,2,opt,name=physical_volume,json=physicalVolume,proto3" json:"physical_volume,omitempty"`
+	Tags                 []string `protobuf:"bytes,3,rep,name=tags,proto3" json:"tags,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CreateVGRequest) Reset()         { *m = CreateVGRequest{} }
+func (m *CreateVGRequest) String() string { return proto.CompactTextString(m) }
+func (*CreateVGRequest) ProtoMessage()    {}
+func (*CreateVGRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8cc5677814b58357, []int{32}
+}
+
+func (m *CreateVGRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateVGRequest.Unmarshal(m, b)
+}
+func (m *CreateVGRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateVGRequest.Marshal(b, m, deterministic)
+}
+func (m *CreateVGRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateVGRequest.Merge(m, src)
+}
+func (m *CreateVGRequest) XXX_Size() int {
+	return xxx_messageInfo_CreateVGRequest.Size(m)
+}
+func (m *CreateVGRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateVGRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateVGRequest proto.InternalMessageInfo
+
+func (m *CreateVGRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *CreateVGRequest) GetPhysicalVolume() string {
+	if m != nil {
+		return m.PhysicalVolume
+	}
+	return ""
+}
+
+func (m *CreateVGRequest) GetTags() []string {
+	if m != nil {
+		return m.Tags
+	}
+	return nil
+}
+
+type CreateVGReply struct {
+	// raw output of the lvm commands, only meant for debugging
+	CommandOutput        string       `protobuf:"bytes,1,opt,name=command_output,json=commandOutput,proto3" json:"command_output,omitempty"`
+	VolumeGroup          *VolumeGroup `protobuf:"bytes,2,opt,name=volume_group,json=volumeGroup,proto3" json:"volume_group,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *CreateVGReply) Reset()         { *m = CreateVGReply{} }
+func (m *CreateVGReply) String() string { return proto.CompactTextString(m) }
+func (*CreateVGReply) ProtoMessage()    {}
+func (*CreateVGReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8cc5677814b58357, []int{33}
+}
+
+func (m *CreateVGReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateVGReply.Unmarshal(m, b)
+}
+func (m *CreateVGReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateVGReply.Marshal(b, m, deterministic)
+}
+func (m *CreateVGReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateVGReply.Merge(m, src)
+}
+func (m *CreateVGReply) XXX_Size() int {
+	return xxx_messageInfo_CreateVGReply.Size(m)
+}
+func (m *CreateVGReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateVGReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateVGReply proto.InternalMessageInfo
+
+func (m *CreateVGReply) GetCommandOutput() string {
+	if m != nil {
+		return m.CommandOutput
+	}
+	return ""
+}
+
+func (m *CreateVGReply) GetVolumeGroup() *VolumeGroup {
+	if m != nil {
+		return m.VolumeGroup
+	}
+	return nil
+}
+
+type RemoveVGRequest struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RemoveVGRequest) Reset()         { *m = RemoveVGRequest{} }
+func (m *RemoveVGRequest) String() string { return proto.CompactTextString(m) }
+func (*RemoveVGRequest) ProtoMessage()    {}
+func (*RemoveVGRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8cc5677814b58357, []int{34}
+}
+
+func (m *RemoveVGRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RemoveVGRequest.Unmarshal(m, b)
+}
+func (m *RemoveVGRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RemoveVGRequest.Marshal(b, m, deterministic)
+}
+func (m *RemoveVGRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemoveVGRequest.Merge(m, src)
+}
+func (m *RemoveVGRequest) XXX_Size() int {
+	return xxx_messageInfo_RemoveVGRequest.Size(m)
+}
+func (m *RemoveVGRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemoveVGRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RemoveVGRequest proto.InternalMessageInfo
+
+func (m *RemoveVGRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+type RemoveVGReply struct {
+	CommandOutput        string   `protobuf:"bytes,1,opt,name=command_output,json=commandOutput,proto3" json:"command_output,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RemoveVGReply) Reset()         { *m = RemoveVGReply{} }
+func (m *RemoveVGReply) String() string { return proto.CompactTextString(m) }
+func (*RemoveVGReply) ProtoMessage()    {}
+func (*RemoveVGReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8cc5677814b58357, []int{35}
+}
+
+func (m *RemoveVGReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RemoveVGReply.Unmarshal(m, b)
+}
+func (m *RemoveVGReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RemoveVGReply.Marshal(b, m, deterministic)
+}
+func (m *RemoveVGReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemoveVGReply.Merge(m, src)
+}
+func (m *RemoveVGReply) XXX_Size() int {
+	return xxx_messageInfo_RemoveVGReply.Size(m)
+}
+func (m *RemoveVGReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemoveVGReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RemoveVGReply proto.InternalMessageInfo
+
+func (m *RemoveVGReply) GetCommandOutput() string {
+	if m != nil {
+		return m.CommandOutput
+	}
+	return ""
+}
+
+type ExtendVGRequest struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	PhysicalVolume       string   `protobuf:"bytes,2,opt,name=physical_volume,json=physicalVolume,proto3" json:"physical_volume,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ExtendVGRequest) Reset()         { *m = ExtendVGRequest{} }
+func (m *ExtendVGRequest) String() string { return proto.CompactTextString(m) }
+func (*ExtendVGRequest) ProtoMessage()    {}
+func (*ExtendVGRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8cc5677814b58357, []int{36}
+}
+
+func (m *ExtendVGRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExtendVGRequest.Unmarshal(m, b)
+}
+func (m *ExtendVGRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExtendVGRequest.Marshal(b, m, deterministic)
+}
+func (m *ExtendVGRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExtendVGRequest.Merge(m, src)
+}
+func (m *ExtendVGRequest) XXX_Size() int {
+	return xxx_messageInfo_ExtendVGRequest.Size(m)
+}
+func (m *ExtendVGRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExtendVGRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExtendVGRequest proto.InternalMessageInfo
+
+func (m *ExtendVGRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *ExtendVGRequest) GetPhysicalVolume() string {
+	if m != nil {
+		return m.PhysicalVolume
+	}
+	return ""
+}
+
+type ExtendVGReply struct {
+	// raw output of the lvm commands, only meant for debugging
+	CommandOutput        string       `protobuf:"bytes,1,opt,name=command_output,json=commandOutput,proto3" json:"command_output,omitempty"`
+	VolumeGroup          *VolumeGroup `protobuf:"bytes,2,opt,name=volume_group,json=volumeGroup,proto3" json:"volume_group,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *ExtendVGReply) Reset()         { *m = ExtendVGReply{} }
+func (m *ExtendVGReply) String() string { return proto.CompactTextString(m) }
+func (*ExtendVGReply) ProtoMessage()    {}
+func (*ExtendVGReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8cc5677814b58357, []int{37}
+}
+
+func (m *ExtendVGReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExtendVGReply.Unmarshal(m, b)
+}
+func (m *ExtendVGReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExtendVGReply.Marshal(b, m, deterministic)
+}
+func (m *ExtendVGReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExtendVGReply.Merge(m, src)
+}
+func (m *ExtendVGReply) XXX_Size() int {
+	return xxx_messageInfo_ExtendVGReply.Size(m)
+}
+func (m *ExtendVGReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExtendVGReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExtendVGReply proto.InternalMessageInfo
+
+func (m *ExtendVGReply) GetCommandOutput() string {
+	if m != nil {
+		return m.CommandOutput
+	}
+	return ""
+}
+
+func (m *ExtendVGReply) GetVolumeGroup() *VolumeGroup {
+	if m != nil {
+		return m.VolumeGroup
+	}
+	return nil
+}
+
+type AddTagLVRequest struct {
+	VolumeGroup          string   `protobuf:"bytes,1,opt,name=volume_group,json=volumeGroup,proto3" json:"volume_group,omitempty"`
+	Name                 string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Tags                 []string `protobuf:"bytes,3,rep,name=tags,proto3" json:"tags,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AddTagLVRequest) Reset()         { *m = AddTagLVRequest{} }
+func (m *AddTagLVRequest) String() string { return proto.CompactTextString(m) }
+func (*AddTagLVRequest) ProtoMessage()    {}
+func (*AddTagLVRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8cc5677814b58357, []int{38}
+}
+
+func (m *AddTagLVRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AddTagLVRequest.Unmarshal(m, b)
+}
+func (m *AddTagLVRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AddTagLVRequest.Marshal(b, m, deterministic)
+}
+func (m *AddTagLVRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddTagLVRequest.Merge(m, src)
+}
+func (m *AddTagLVRequest) XXX_Size() int {
+	return xxx_messageInfo_AddTagLVRequest.Size(m)
+}
+func (m *AddTagLVRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddTagLVRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AddTagLVRequest proto.InternalMessageInfo
+
+func (m *AddTagLVRequest) GetVolumeGroup() string {
+	if m != nil {
+		return m.VolumeGroup
+	}
+	return ""
+}
+
+func (m *AddTagLVRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *AddTagLVRequest) GetTags() []string {
+	if m != nil {
+		return m.Tags
+	}
+	return nil
+}
+
+type AddTagLVReply struct {
+	// raw output of the lvm commands, only meant for debugging
+	CommandOutput        string         `protobuf:"bytes,1,opt,name=command_output,json=commandOutput,proto3" json:"command_output,omitempty"`
+	Volume               *LogicalVolume `protobuf:"bytes,2,opt,name=volume,proto3" json:"volume,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *AddTagLVReply) Reset()         { *m = AddTagLVReply{} }
+func (m *AddTagLVReply) String() string { return proto.CompactTextString(m) }
+func (*AddTagLVReply) ProtoMessage()    {}
+func (*AddTagLVReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8cc5677814b58357, []int{39}
+}
+
+func (m *AddTagLVReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AddTagLVReply.Unmarshal(m, b)
+}
+func (m *AddTagLVReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AddTagLVReply.Marshal(b, m, deterministic)
+}
+func (m *AddTagLVReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddTagLVReply.Merge(m, src)
+}
+func (m *AddTagLVReply) XXX_Size() int {
+	return xxx_messageInfo_AddTagLVReply.Size(m)
+}
+func (m *AddTagLVReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddTagLVReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AddTagLVReply proto.InternalMessageInfo
+
+func (m *AddTagLVReply) GetCommandOutput() string {
+	if m != nil {
+		return m.CommandOutput
+	}
+	return ""
+}
+
+func (m *AddTagLVReply) GetVolume() *LogicalVolume {
+	if m != nil {
+		return m.Volume
+	}
+	return nil
+}
+
+type RemoveTagLVRequest struct {
+	VolumeGroup          string   `protobuf:"bytes,1,opt,name=volume_group,json=volumeGroup,proto3" json:"volume_group,omitempty"`
+	Name                 string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Tags                 []string `protobuf:"bytes,3,rep,name=tags,proto3" json:"tags,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RemoveTagLVRequest) Reset()         { *m = RemoveTagLVRequest{} }
+func (m *RemoveTagLVRequest) String() string { return proto.CompactTextString(m) }
+func (*RemoveTagLVRequest) ProtoMessage()    {}
+func (*RemoveTagLVRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8cc5677814b58357, []int{40}
+}
+
+func (m *RemoveTagLVRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RemoveTagLVRequest.Unmarshal(m, b)
+}
+func (m *RemoveTagLVRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RemoveTagLVRequest.Marshal(b, m, deterministic)
+}
+func (m *RemoveTagLVRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemoveTagLVRequest.Merge(m, src)
+}
+func (m *RemoveTagLVRequest) XXX_Size() int {
+	return xxx_messageInfo_RemoveTagLVRequest.Size(m)
+}
+func (m *RemoveTagLVRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemoveTagLVRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RemoveTagLVRequest proto.InternalMessageInfo
+
+func (m *RemoveTagLVRequest) GetVolumeGroup() string {
+	if m != nil {
+		return m.VolumeGroup
+	}
+	return ""
+}
+
+func (m *RemoveTagLVRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *RemoveTagLVRequest) GetTags() []string {
+	if m != nil {
+		return m.Tags
+	}
+	return nil
+}
+
+type RemoveTagLVReply struct {
+	// raw output of the lvm commands, only meant for debugging
+	CommandOutput        string         `protobuf:"bytes,1,opt,name=command_output,json=commandOutput,proto3" json:"command_output,omitempty"`
+	Volume               *LogicalVolume `protobuf:"bytes,2,opt,name=volume,proto3" json:"volume,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *RemoveTagLVReply) Reset()         { *m = RemoveTagLVReply{} }
+func (m *RemoveTagLVReply) String() string { return proto.CompactTextString(m) }
+func (*RemoveTagLVReply) ProtoMessage()    {}
+func (*RemoveTagLVReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8cc5677814b58357, []int{41}
+}
+
+func (m *RemoveTagLVReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RemoveTagLVReply.Unmarshal(m, b)
+}
+func (m *RemoveTagLVReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RemoveTagLVReply.Marshal(b, m, deterministic)
+}
+func (m *RemoveTagLVReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemoveTagLVReply.Merge(m, src)
+}
+func (m *RemoveTagLVReply) XXX_Size() int {
+	return xxx_messageInfo_RemoveTagLVReply.Size(m)
+}
+func (m *RemoveTagLVReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemoveTagLVReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RemoveTagLVReply proto.InternalMessageInfo
+
+func (m *RemoveTagLVReply) GetCommandOutput() string {
+	if m != nil {
+		return m.CommandOutput
+	}
+	return ""
+}
+
+func (m *RemoveTagLVReply) GetVolume() *LogicalVolume {
+	if m != nil {
+		return m.Volume
+	}
+	return nil
+}
+
+type CreatePVRequest struct {
+	Block                string   `protobuf:"bytes,1,opt,name=block,proto3" json:"block,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CreatePVRequest) Reset()         { *m = CreatePVRequest{} }
+func (m *CreatePVRequest) String() string { return proto.CompactTextString(m) }
+func (*CreatePVRequest) ProtoMessage()    {}
+func (*CreatePVRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8cc5677814b58357, []int{42}
+}
+
+func (m *CreatePVRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreatePVRequest.Unmarshal(m, b)
+}
+func (m *CreatePVRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreatePVRequest.Marshal(b, m, deterministic)
+}
+func (m *CreatePVRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreatePVRequest.Merge(m, src)
+}
+func (m *CreatePVRequest) XXX_Size() int {
+	return xxx_messageInfo_CreatePVRequest.Size(m)
+}
+func (m *CreatePVRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreatePVRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreatePVRequest proto.InternalMessageInfo
+
+func (m *CreatePVRequest) GetBlock() string {
+	if m != nil {
+		return m.Block
+	}
+	return ""
+}
+
+type CreatePVReply struct {
+	// raw output of the lvm commands, only meant for debugging
+	CommandOutput        string   `protobuf:"bytes,1,opt,name=command_output,json=commandOutput,proto3" json:"command_output,omitempty"`
+	Pvinfo               *PVInfo  `protobuf:"bytes,2,opt,name=pvinfo,proto3" json:"pvinfo,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CreatePVReply) Reset()         { *m = CreatePVReply{} }
+func (m *CreatePVReply) String() string { return proto.CompactTextString(m) }
+func (*CreatePVReply) ProtoMessage()    {}
+func (*CreatePVReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8cc5677814b58357, []int{43}
+}
+
+func (m *CreatePVReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreatePVReply.Unmarshal(m, b)
+}
+func (m *CreatePVReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreatePVReply.Marshal(b, m, deterministic)
+}
+func (m *CreatePVReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreatePVReply.Merge(m, src)
+}
+func (m *CreatePVReply) XXX_Size() int {
+	return xxx_messageInfo_CreatePVReply.Size(m)
+}
+func (m *CreatePVReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreatePVReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreatePVReply proto.InternalMessageInfo
+
+func (m *CreatePVReply) GetCommandOutput() string {
+	if m != nil {
+		return m.CommandOutput
+	}
+	return ""
+}
+
+func (m *CreatePVReply) GetPvinfo() *PVInfo {
+	if m != nil {
+		return m.Pvinfo
+	}
+	return nil
+}
+
+type RemovePVRequest struct {
+	Block                string   `protobuf:"bytes,1,opt,name=block,proto3" json:"block,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RemovePVRequest) Reset()         { *m = RemovePVRequest{} }
+func (m *RemovePVRequest) String() string { return proto.CompactTextString(m) }
+func (*RemovePVRequest) ProtoMessage()    {}
+func (*RemovePVRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8cc5677814b58357, []int{44}
+}
+
+func (m *RemovePVRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RemovePVRequest.Unmarshal(m, b)
+}
+func (m *RemovePVRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RemovePVRequest.Marshal(b, m, deterministic)
+}
+func (m *RemovePVRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemovePVRequest.Merge(m, src)
+}
+func (m *RemovePVRequest) XXX_Size() int {
+	return xxx_messageInfo_RemovePVRequest.Size(m)
+}
+func (m *RemovePVRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemovePVRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RemovePVRequest proto.InternalMessageInfo
+
+func (m *RemovePVRequest) GetBlock() string {
+	if m != nil {
+		return m.Block
+	}
+	return ""
+}
+
+type RemovePVReply struct {
+	CommandOutput        string   `protobuf:"bytes,1,opt,name=command_output,json=commandOutput,proto3" json:"command_output,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RemovePVReply) Reset()         { *m = RemovePVReply{} }
+func (m *RemovePVReply) String() string { return proto.CompactTextString(m) }
+func (*RemovePVReply) ProtoMessage()    {}
+func (*RemovePVReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8cc5677814b58357, []int{45}
+}
+
+func (m *RemovePVReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RemovePVReply.Unmarshal(m, b)
+}
+func (m *RemovePVReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RemovePVReply.Marshal(b, m, deterministic)
+}
+func (m *RemovePVReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemovePVReply.Merge(m, src)
+}
+func (m *RemovePVReply) XXX_Size() int {
+	return xxx_messageInfo_RemovePVReply.Size(m)
+}
+func (m *RemovePVReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemovePVReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RemovePVReply proto.InternalMessageInfo
+
+func (m *RemovePVReply) GetCommandOutput() string {
+	if m != nil {
+		return m.CommandOutput
+	}
+	return ""
+}
+
+type ListPVRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListPVRequest) Reset()         { *m = ListPVRequest{} }
+func (m *ListPVRequest) String() string { return proto.CompactTextString(m) }
+func (*ListPVRequest) ProtoMessage()    {}
+func (*ListPVRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8cc5677814b58357, []int{46}
+}
+
+func (m *ListPVRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListPVRequest.Unmarshal(m, b)
+}
+func (m *ListPVRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListPVRequest.Marshal(b, m, deterministic)
+}
+func (m *ListPVRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListPVRequest.Merge(m, src)
+}
+func (m *ListPVRequest) XXX_Size() int {
+	return xxx_messageInfo_ListPVRequest.Size(m)
+}
+func (m *ListPVRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListPVRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListPVRequest proto.InternalMessageInfo
+
+type ListPVReply struct {
+	Pvinfos              []*PVInfo `protobuf:"bytes,1,rep,name=pvinfos,proto3" json:"pvinfos,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *ListPVReply) Reset()         { *m = ListPVReply{} }
+func (m *ListPVReply) String() string { return proto.CompactTextString(m) }
+func (*ListPVReply) ProtoMessage()    {}
+func (*ListPVReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8cc5677814b58357, []int{47}
+}
+
+func (m *ListPVReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListPVReply.Unmarshal(m, b)
+}
+func (m *ListPVReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListPVReply.Marshal(b, m, deterministic)
+}
+func (m *ListPVReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListPVReply.Merge(m, src)
+}
+func (m *ListPVReply) XXX_Size() int {
+	return xxx_messageInfo_ListPVReply.Size(m)
+}
+func (m *ListPVReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListPVReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListPVReply proto.InternalMessageInfo
+
+func (m *ListPVReply) GetPvinfos() []*PVInfo {
+	if m != nil {
+		return m.Pvinfos
+	}
+	return nil
+}
+
+type PVInfo struct {
+	Name  string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Uuid  string `protobuf:"bytes,2,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	Fmt   string `protobuf:"bytes,3,opt,name=fmt,proto3" json:"fmt,omitempty"`
+	Size  uint64 `protobuf:"varint,4,opt,name=size,proto3" json:"size,omitempty"`
+	Usize uint64 `protobuf:"varint,5,opt,name=usize,proto3" json:"usize,omitempty"`
+	Fsize uint64 `protobuf:"varint,6,opt,name=fsize,proto3" json:"fsize,omitempty"`
+	// empty for physical volumes which aren't in a volume group
+	VgName       string             `protobuf:"bytes,7,opt,name=vg_name,json=vgName,proto3" json:"vg_name,omitempty"`
+	PeCount      uint64             `protobuf:"varint,8,opt,name=pe_count,json=peCount,proto3" json:"pe_count,omitempty"`
+	PeAllocCount uint64             `protobuf:"varint,9,opt,name=pe_alloc_count,json=peAllocCount,proto3" json:"pe_alloc_count,omitempty"`
+	Attributes   *PVInfo_Attributes `protobuf:"bytes,10,opt,name=attributes,proto3" json:"attributes,omitempty"`
+	// size of the underlying device
+	DevSize              uint64   `protobuf:"varint,11,opt,name=dev_size,json=devSize,proto3" json:"dev_size,omitempty"`
+	MdaCount             uint32   `protobuf:"varint,12,opt,name=mda_count,json=mdaCount,proto3" json:"mda_count,omitempty"`
+	MdaSize              uint64   `protobuf:"varint,13,opt,name=mda_size,json=mdaSize,proto3" json:"mda_size,omitempty"`
+	Tags                 []string `protobuf:"bytes,14,rep,name=tags,proto3" json:"tags,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PVInfo) Reset()         { *m = PVInfo{} }
+func (m *PVInfo) String() string { return proto.CompactTextString(m) }
+func (*PVInfo) ProtoMessage()    {}
+func (*PVInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8cc5677814b58357, []int{48}
+}
+
+func (m *PVInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PVInfo.Unmarshal(m, b)
+}
+func (m *PVInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PVInfo.Marshal(b, m, deterministic)
+}
+func (m *PVInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PVInfo.Merge(m, src)
+}
+func (m *PVInfo) XXX_Size() int {
+	return xxx_messageInfo_PVInfo.Size(m)
+}
+func (m *PVInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_PVInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PVInfo proto.InternalMessageInfo
+
+func (m *PVInfo) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *PVInfo) GetUuid() string {
+	if m != nil {
+		return m.Uuid
+	}
+	return ""
+}
+
+func (m *PVInfo) GetFmt() string {
+	if m != nil {
+		return m.Fmt
+	}
+	return ""
+}
+
+func (m *PVInfo) GetSize() uint64 {
+	if m != nil {
+		return m.Size
+	}
+	return 0
+}
+
+func (m *PVInfo) GetUsize() uint64 {
+	if m != nil {
+		return m.Usize
+	}
+	return 0
+}
+
+func (m *PVInfo) GetFsize() uint64 {
+	if m != nil {
+		return m.Fsize
+	}
+	return 0
+}
+
+func (m *PVInfo) GetVgName() string {
+	if m != nil {
+		return m.VgName
+	}
+	return ""
+}
+
+func (m *PVInfo) GetPeCount() uint64 {
+	if m != nil {
+		return m.PeCount
+	}
+	return 0
+}
+
+func (m *PVInfo) GetPeAllocCount() uint64 {
+	if m != nil {
+		return m.PeAllocCount
+	}
+	return 0
+}
+
+func (m *PVInfo) GetAttributes() *PVInfo_Attributes {
+	if m != nil {
+		return m.Attributes
+	}
+	return nil
+}
+
+func (m *PVInfo) GetDevSize() uint64 {
+	if m != nil {
+		return m.DevSize
+	}
+	return 0
+}
+
+func (m *PVInfo) GetMdaCount() uint32 {
+	if m != nil {
+		return m.MdaCount
+	}
+	return 0
+}
+
+func (m *PVInfo) GetMdaSize() uint64 {
+	if m != nil {
+		return m.MdaSize
+	}
+	return 0
+}
+
+func (m *PVInfo) GetTags() []string {
+	if m != nil {
+		return m.Tags
+	}
+	return nil
+}
+
+type PVInfo_Attributes struct {
+	Allocatable          bool     `protobuf:"varint,1,opt,name=allocatable,proto3" json:"allocatable,omitempty"`
+	Exported             bool     `protobuf:"varint,2,opt,name=exported,proto3" json:"exported,omitempty"`
+	Missing              bool     `protobuf:"varint,3,opt,name=missing,proto3" json:"missing,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PVInfo_Attributes) Reset()         { *m = PVInfo_Attributes{} }
+func (m *PVInfo_Attributes) String() string { return proto.CompactTextString(m) }
+func (*PVInfo_Attributes) ProtoMessage()    {}
+func (*PVInfo_Attributes) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8cc5677814b58357, []int{48, 0}
+}
+
+func (m *PVInfo_Attributes) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PVInfo_Attributes.Unmarshal(m, b)
+}
+func (m *PVInfo_Attributes) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PVInfo_Attributes.Marshal(b, m, deterministic)
+}
+func (m *PVInfo_Attributes) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PVInfo_Attributes.Merge(m, src)
+}
+func (m *PVInfo_Attributes) XXX_Size() int {
+	return xxx_messageInfo_PVInfo_Attributes.Size(m)
+}
+func (m *PVInfo_Attributes) XXX_DiscardUnknown() {
+	xxx_messageInfo_PVInfo_Attributes.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PVInfo_Attributes proto.InternalMessageInfo
+
+func (m *PVInfo_Attributes) GetAllocatable() bool {
+	if m != nil {
+		return m.Allocatable
+	}
+	return false
+}
+
+func (m *PVInfo_Attributes) GetExported() bool {
+	if m != nil {
+		return m.Exported
+	}
+	return false
+}
+
+func (m *PVInfo_Attributes) GetMissing() bool {
+	if m != nil {
+		return m.Missing
+	}
+	return false
+}
+
+type ValidateRequest struct {
+	Block                string   `protobuf:"bytes,1,opt,name=block,proto3" json:"block,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ValidateRequest) Reset()         { *m = ValidateRequest{} }
+func (m *ValidateRequest) String() string { return proto.CompactTextString(m) }
+func (*ValidateRequest) ProtoMessage()    {}
+func (*ValidateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8cc5677814b58357, []int{49}
+}
+
+func (m *ValidateRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ValidateRequest.Unmarshal(m, b)
+}
+func (m *ValidateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ValidateRequest.Marshal(b, m, deterministic)
+}
+func (m *ValidateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidateRequest.Merge(m, src)
+}
+func (m *ValidateRequest) XXX_Size() int {
+	return xxx_messageInfo_ValidateRequest.Size(m)
+}
+func (m *ValidateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidateRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidateRequest proto.InternalMessageInfo
+
+func (m *ValidateRequest) GetBlock() string {
+	if m != nil {
+		return m.Block
+	}
+	return ""
+}
+
+// Signature is a signature found on a block device by wipefs
+type Signature struct {
+	// offset of the signature on the device in bytes
+	Offset uint64 `protobuf:"varint,1,opt,name=offset,proto3" json:"offset,omitempty"`
+	// ext4, gpt, LVM2_member, ...
+	Type string `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	// filesystem, raid, crypto, partition table or other
+	Usage                string   `protobuf:"bytes,3,opt,name=usage,proto3" json:"usage,omitempty"`
+	Uuid                 string   `protobuf:"bytes,4,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	Label                string   `protobuf:"bytes,5,opt,name=label,proto3" json:"label,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Signature) Reset()         { *m = Signature{} }
+func (m *Signature) String() string { return proto.CompactTextString(m) }
+func (*Signature) ProtoMessage()    {}
+func (*Signature) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8cc5677814b58357, []int{50}
+}
+
+func (m *Signature) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Signature.Unmarshal(m, b)
+}
+func (m *Signature) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Signature.Marshal(b, m, deterministic)
+}
+func (m *Signature) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Signature.Merge(m, src)
+}
+func (m *Signature) XXX_Size() int {
+	return xxx_messageInfo_Signature.Size(m)
+}
+func (m *Signature) XXX_DiscardUnknown() {
+	xxx_messageInfo_Signature.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Signature proto.InternalMessageInfo
+
+func (m *Signature) GetOffset() uint64 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
+func (m *Signature) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *Signature) GetUsage() string {
+	if m != nil {
+		return m.Usage
+	}
+	return ""
+}
+
+func (m *Signature) GetUuid() string {
+	if m != nil {
+		return m.Uuid
+	}
+	return ""
+}
+
+func (m *Signature) GetLabel() string {
+	if m != nil {
+		return m.Label
+	}
+	return ""
+}
+
+type ValidateReply struct {
+	// whether the block can be used for a new physical volume, when it
+	// can't, reasons explains why
+	Validate             bool                    `protobuf:"varint,1,opt,name=validate,proto3" json:"validate,omitempty"`
+	Reasons              []*ValidateReply_Reason `protobuf:"bytes,2,rep,name=reasons,proto3" json:"reasons,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
+	XXX_unrecognized     []byte                  `json:"-"`
+	XXX_sizecache        int32                   `json:"-"`
+}
+
+func (m *ValidateReply) Reset()         { *m = ValidateReply{} }
+func (m *ValidateReply) String() string { return proto.CompactTextString(m) }
+func (*ValidateReply) ProtoMessage()    {}
+func (*ValidateReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8cc5677814b58357, []int{51}
+}
+
+func (m *ValidateReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ValidateReply.Unmarshal(m, b)
+}
+func (m *ValidateReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ValidateReply.Marshal(b, m, deterministic)
+}
+func (m *ValidateReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidateReply.Merge(m, src)
+}
+func (m *ValidateReply) XXX_Size() int {
+	return xxx_messageInfo_ValidateReply.Size(m)
+}
+func (m *ValidateReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidateReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidateReply proto.InternalMessageInfo
+
+func (m *ValidateReply) GetValidate() bool {
+	if m != nil {
+		return m.Validate
+	}
+	return false
+}
+
+func (m *ValidateReply) GetReasons() []*ValidateReply_Reason {
+	if m != nil {
+		return m.Reasons
+	}
+	return nil
+}
+
+type ValidateReply_Reason struct {
+	Kind ValidateReply_Reason_Kind `protobuf:"varint,1,opt,name=kind,proto3,enum=lvm.ValidateReply_Reason_Kind" json:"kind,omitempty"`
+	// the signature type, mountpoint, holder, swap device or volume group,
+	// by kind
+	Detail string `protobuf:"bytes,2,opt,name=detail,proto3" json:"detail,omitempty"`
+	// the signature of SIGNATURE, PARTITION_TABLE, SWAP and RAID_MEMBER
+	Signature            *Signature `protobuf:"bytes,3,opt,name=signature,proto3" json:"signature,omitempty"`
+	Message              string     `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *ValidateReply_Reason) Reset()         { *m = ValidateReply_Reason{} }
+func (m *ValidateReply_Reason) String() string { return proto.CompactTextString(m) }
+func (*ValidateReply_Reason) ProtoMessage()    {}
+func (*ValidateReply_Reason) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8cc5677814b58357, []int{51, 0}
+}
+
+func (m *ValidateReply_Reason) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ValidateReply_Reason.Unmarshal(m, b)
+}
+func (m *ValidateReply_Reason) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ValidateReply_Reason.Marshal(b, m, deterministic)
+}
+func (m *ValidateReply_Reason) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidateReply_Reason.Merge(m, src)
+}
+func (m *ValidateReply_Reason) XXX_Size() int {
+	return xxx_messageInfo_ValidateReply_Reason.Size(m)
+}
+func (m *ValidateReply_Reason) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidateReply_Reason.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidateReply_Reason proto.InternalMessageInfo
+
+func (m *ValidateReply_Reason) GetKind() ValidateReply_Reason_Kind {
+	if m != nil {
+		return m.Kind
+	}
+	return ValidateReply_Reason_UNKNOWN
+}
+
+func (m *ValidateReply_Reason) GetDetail() string {
+	if m != nil {
+		return m.Detail
+	}
+	return ""
+}
+
+func (m *ValidateReply_Reason) GetSignature() *Signature {
+	if m != nil {
+		return m.Signature
+	}
+	return nil
+}
+
+func (m *ValidateReply_Reason) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+type DestoryRequest struct {
+	Block string `protobuf:"bytes,1,opt,name=block,proto3" json:"block,omitempty"`
+	// only list the signatures which would be erased
+	DryRun               bool     `protobuf:"varint,2,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DestoryRequest) Reset()         { *m = DestoryRequest{} }
+func (m *DestoryRequest) String() string { return proto.CompactTextString(m) }
+func (*DestoryRequest) ProtoMessage()    {}
+func (*DestoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8cc5677814b58357, []int{52}
+}
+
+func (m *DestoryRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DestoryRequest.Unmarshal(m, b)
 }
-func (m *RemoveTagLVRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_RemoveTagLVRequest.Marshal(b, m, deterministic)
+func (m *DestoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DestoryRequest.Marshal(b, m, deterministic)
 }
-func (m *RemoveTagLVRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RemoveTagLVRequest.Merge(m, src)
+func (m *DestoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DestoryRequest.Merge(m, src)
 }
-func (m *RemoveTagLVRequest) XXX_Size() int {
-	return xxx_messageInfo_RemoveTagLVRequest.Size(m)
+func (m *DestoryRequest) XXX_Size() int {
+	return xxx_messageInfo_DestoryRequest.Size(m)
 }
-func (m *RemoveTagLVRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_RemoveTagLVRequest.DiscardUnknown(m)
+func (m *DestoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DestoryRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_RemoveTagLVRequest proto.InternalMessageInfo
-
-func (m *RemoveTagLVRequest) GetVolumeGroup() string {
-	if m != nil {
-		return m.VolumeGroup
-	}
-	return ""
-}
+var xxx_messageInfo_DestoryRequest proto.InternalMessageInfo
 
-func (m *RemoveTagLVRequest) GetName() string {
+func (m *DestoryRequest) GetBlock() string {
 	if m != nil {
-		return m.Name
+		return m.Block
 	}
 	return ""
 }
 
-func (m *RemoveTagLVRequest) GetTags() []string {
+func (m *DestoryRequest) GetDryRun() bool {
 	if m != nil {
-		return m.Tags
+		return m.DryRun
 	}
-	return nil
+	return false
 }
 
-type RemoveTagLVReply struct {
-	CommandOutput        string   `protobuf:"bytes,1,opt,name=command_output,json=commandOutput,proto3" json:"command_output,omitempty"`
+type DestoryReply struct {
+	CommandOutput string `protobuf:"bytes,1,opt,name=command_output,json=commandOutput,proto3" json:"command_output,omitempty"`
+	// the signatures erased, or which would be erased with dry_run
+	Signatures []*Signature `protobuf:"bytes,2,rep,name=signatures,proto3" json:"signatures,omitempty"`
+	// the directory the erased signatures were saved to for
+	// RestoreSignatures, empty when backups are disabled
+	BackupDir            string   `protobuf:"bytes,3,opt,name=backup_dir,json=backupDir,proto3" json:"backup_dir,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *RemoveTagLVReply) Reset()         { *m = RemoveTagLVReply{} }
-func (m *RemoveTagLVReply) String() string { return proto.CompactTextString(m) }
-func (*RemoveTagLVReply) ProtoMessage()    {}
-func (*RemoveTagLVReply) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8cc5677814b58357, []int{29}
+func (m *DestoryReply) Reset()         { *m = DestoryReply{} }
+func (m *DestoryReply) String() string { return proto.CompactTextString(m) }
+func (*DestoryReply) ProtoMessage()    {}
+func (*DestoryReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8cc5677814b58357, []int{53}
 }
 
-func (m *RemoveTagLVReply) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_RemoveTagLVReply.Unmarshal(m, b)
+func (m *DestoryReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DestoryReply.Unmarshal(m, b)
 }
-func (m *RemoveTagLVReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_RemoveTagLVReply.Marshal(b, m, deterministic)
+func (m *DestoryReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DestoryReply.Marshal(b, m, deterministic)
 }
-func (m *RemoveTagLVReply) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RemoveTagLVReply.Merge(m, src)
+func (m *DestoryReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DestoryReply.Merge(m, src)
 }
-func (m *RemoveTagLVReply) XXX_Size() int {
-	return xxx_messageInfo_RemoveTagLVReply.Size(m)
+func (m *DestoryReply) XXX_Size() int {
+	return xxx_messageInfo_DestoryReply.Size(m)
 }
-func (m *RemoveTagLVReply) XXX_DiscardUnknown() {
-	xxx_messageInfo_RemoveTagLVReply.DiscardUnknown(m)
+func (m *DestoryReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_DestoryReply.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_RemoveTagLVReply proto.InternalMessageInfo
+var xxx_messageInfo_DestoryReply proto.InternalMessageInfo
 
-func (m *RemoveTagLVReply) GetCommandOutput() string {
+func (m *DestoryReply) GetCommandOutput() string {
 	if m != nil {
 		return m.CommandOutput
 	}
 	return ""
 }
 
-type CreatePVRequest struct {
+func (m *DestoryReply) GetSignatures() []*Signature {
+	if m != nil {
+		return m.Signatures
+	}
+	return nil
+}
+
+func (m *DestoryReply) GetBackupDir() string {
+	if m != nil {
+		return m.BackupDir
+	}
+	return ""
+}
+
+type RestoreSignaturesRequest struct {
 	Block                string   `protobuf:"bytes,1,opt,name=block,proto3" json:"block,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *CreatePVRequest) Reset()         { *m = CreatePVRequest{} }
-func (m *CreatePVRequest) String() string { return proto.CompactTextString(m) }
-func (*CreatePVRequest) ProtoMessage()    {}
-func (*CreatePVRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8cc5677814b58357, []int{30}
+func (m *RestoreSignaturesRequest) Reset()         { *m = RestoreSignaturesRequest{} }
+func (m *RestoreSignaturesRequest) String() string { return proto.CompactTextString(m) }
+func (*RestoreSignaturesRequest) ProtoMessage()    {}
+func (*RestoreSignaturesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8cc5677814b58357, []int{54}
 }
 
-func (m *CreatePVRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_CreatePVRequest.Unmarshal(m, b)
+func (m *RestoreSignaturesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RestoreSignaturesRequest.Unmarshal(m, b)
 }
-func (m *CreatePVRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_CreatePVRequest.Marshal(b, m, deterministic)
+func (m *RestoreSignaturesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RestoreSignaturesRequest.Marshal(b, m, deterministic)
 }
-func (m *CreatePVRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CreatePVRequest.Merge(m, src)
+func (m *RestoreSignaturesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RestoreSignaturesRequest.Merge(m, src)
 }
-func (m *CreatePVRequest) XXX_Size() int {
-	return xxx_messageInfo_CreatePVRequest.Size(m)
+func (m *RestoreSignaturesRequest) XXX_Size() int {
+	return xxx_messageInfo_RestoreSignaturesRequest.Size(m)
 }
-func (m *CreatePVRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_CreatePVRequest.DiscardUnknown(m)
+func (m *RestoreSignaturesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RestoreSignaturesRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_CreatePVRequest proto.InternalMessageInfo
+var xxx_messageInfo_RestoreSignaturesRequest proto.InternalMessageInfo
 
-func (m *CreatePVRequest) GetBlock() string {
+func (m *RestoreSignaturesRequest) GetBlock() string {
 	if m != nil {
 		return m.Block
 	}
 	return ""
 }
 
-type CreatePVReply struct {
-	CommandOutput        string   `protobuf:"bytes,1,opt,name=command_output,json=commandOutput,proto3" json:"command_output,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+type RestoreSignaturesReply struct {
+	CommandOutput string `protobuf:"bytes,1,opt,name=command_output,json=commandOutput,proto3" json:"command_output,omitempty"`
+	// the signatures on the block after the restore
+	Signatures           []*Signature `protobuf:"bytes,2,rep,name=signatures,proto3" json:"signatures,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
 }
 
-func (m *CreatePVReply) Reset()         { *m = CreatePVReply{} }
-func (m *CreatePVReply) String() string { return proto.CompactTextString(m) }
-func (*CreatePVReply) ProtoMessage()    {}
-func (*CreatePVReply) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8cc5677814b58357, []int{31}
+func (m *RestoreSignaturesReply) Reset()         { *m = RestoreSignaturesReply{} }
+func (m *RestoreSignaturesReply) String() string { return proto.CompactTextString(m) }
+func (*RestoreSignaturesReply) ProtoMessage()    {}
+func (*RestoreSignaturesReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8cc5677814b58357, []int{55}
 }
 
-func (m *CreatePVReply) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_CreatePVReply.Unmarshal(m, b)
+func (m *RestoreSignaturesReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RestoreSignaturesReply.Unmarshal(m, b)
 }
-func (m *CreatePVReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_CreatePVReply.Marshal(b, m, deterministic)
+func (m *RestoreSignaturesReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RestoreSignaturesReply.Marshal(b, m, deterministic)
 }
-func (m *CreatePVReply) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CreatePVReply.Merge(m, src)
+func (m *RestoreSignaturesReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RestoreSignaturesReply.Merge(m, src)
 }
-func (m *CreatePVReply) XXX_Size() int {
-	return xxx_messageInfo_CreatePVReply.Size(m)
+func (m *RestoreSignaturesReply) XXX_Size() int {
+	return xxx_messageInfo_RestoreSignaturesReply.Size(m)
 }
-func (m *CreatePVReply) XXX_DiscardUnknown() {
-	xxx_messageInfo_CreatePVReply.DiscardUnknown(m)
+func (m *RestoreSignaturesReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_RestoreSignaturesReply.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_CreatePVReply proto.InternalMessageInfo
+var xxx_messageInfo_RestoreSignaturesReply proto.InternalMessageInfo
 
-func (m *CreatePVReply) GetCommandOutput() string {
+func (m *RestoreSignaturesReply) GetCommandOutput() string {
 	if m != nil {
 		return m.CommandOutput
 	}
 	return ""
 }
 
-type RemovePVRequest struct {
+func (m *RestoreSignaturesReply) GetSignatures() []*Signature {
+	if m != nil {
+		return m.Signatures
+	}
+	return nil
+}
+
+type MatchRequest struct {
 	Block                string   `protobuf:"bytes,1,opt,name=block,proto3" json:"block,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *RemovePVRequest) Reset()         { *m = RemovePVRequest{} }
-func (m *RemovePVRequest) String() string { return proto.CompactTextString(m) }
-func (*RemovePVRequest) ProtoMessage()    {}
-func (*RemovePVRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8cc5677814b58357, []int{32}
+func (m *MatchRequest) Reset()         { *m = MatchRequest{} }
+func (m *MatchRequest) String() string { return proto.CompactTextString(m) }
+func (*MatchRequest) ProtoMessage()    {}
+func (*MatchRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8cc5677814b58357, []int{56}
 }
 
-func (m *RemovePVRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_RemovePVRequest.Unmarshal(m, b)
+func (m *MatchRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MatchRequest.Unmarshal(m, b)
 }
-func (m *RemovePVRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_RemovePVRequest.Marshal(b, m, deterministic)
+func (m *MatchRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MatchRequest.Marshal(b, m, deterministic)
 }
-func (m *RemovePVRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RemovePVRequest.Merge(m, src)
+func (m *MatchRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MatchRequest.Merge(m, src)
 }
-func (m *RemovePVRequest) XXX_Size() int {
-	return xxx_messageInfo_RemovePVRequest.Size(m)
+func (m *MatchRequest) XXX_Size() int {
+	return xxx_messageInfo_MatchRequest.Size(m)
 }
-func (m *RemovePVRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_RemovePVRequest.DiscardUnknown(m)
+func (m *MatchRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MatchRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_RemovePVRequest proto.InternalMessageInfo
+var xxx_messageInfo_MatchRequest proto.InternalMessageInfo
 
-func (m *RemovePVRequest) GetBlock() string {
+func (m *MatchRequest) GetBlock() string {
 	if m != nil {
 		return m.Block
 	}
 	return ""
 }
 
-type RemovePVReply struct {
-	CommandOutput        string   `protobuf:"bytes,1,opt,name=command_output,json=commandOutput,proto3" json:"command_output,omitempty"`
+type MatchReply struct {
+	// the volume group of the block, use volume_group instead
+	CommandOutput string `protobuf:"bytes,1,opt,name=command_output,json=commandOutput,proto3" json:"command_output,omitempty"`
+	// empty when the block isn't a physical volume in a volume group
+	VolumeGroup          string   `protobuf:"bytes,2,opt,name=volume_group,json=volumeGroup,proto3" json:"volume_group,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *RemovePVReply) Reset()         { *m = RemovePVReply{} }
-func (m *RemovePVReply) String() string { return proto.CompactTextString(m) }
-func (*RemovePVReply) ProtoMessage()    {}
-func (*RemovePVReply) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8cc5677814b58357, []int{33}
+func (m *MatchReply) Reset()         { *m = MatchReply{} }
+func (m *MatchReply) String() string { return proto.CompactTextString(m) }
+func (*MatchReply) ProtoMessage()    {}
+func (*MatchReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8cc5677814b58357, []int{57}
 }
 
-func (m *RemovePVReply) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_RemovePVReply.Unmarshal(m, b)
+func (m *MatchReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MatchReply.Unmarshal(m, b)
 }
-func (m *RemovePVReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_RemovePVReply.Marshal(b, m, deterministic)
+func (m *MatchReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MatchReply.Marshal(b, m, deterministic)
 }
-func (m *RemovePVReply) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RemovePVReply.Merge(m, src)
+func (m *MatchReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MatchReply.Merge(m, src)
 }
-func (m *RemovePVReply) XXX_Size() int {
-	return xxx_messageInfo_RemovePVReply.Size(m)
+func (m *MatchReply) XXX_Size() int {
+	return xxx_messageInfo_MatchReply.Size(m)
 }
-func (m *RemovePVReply) XXX_DiscardUnknown() {
-	xxx_messageInfo_RemovePVReply.DiscardUnknown(m)
+func (m *MatchReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_MatchReply.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_RemovePVReply proto.InternalMessageInfo
+var xxx_messageInfo_MatchReply proto.InternalMessageInfo
 
-func (m *RemovePVReply) GetCommandOutput() string {
+func (m *MatchReply) GetCommandOutput() string {
 	if m != nil {
 		return m.CommandOutput
 	}
 	return ""
 }
 
-type ListPVRequest struct {
+func (m *MatchReply) GetVolumeGroup() string {
+	if m != nil {
+		return m.VolumeGroup
+	}
+	return ""
+}
+
+type GetPVNumReply struct {
+	// the number of physical volumes as a string, use pv_count instead
+	CommandOutput        string   `protobuf:"bytes,1,opt,name=command_output,json=commandOutput,proto3" json:"command_output,omitempty"`
+	PvCount              uint32   `protobuf:"varint,2,opt,name=pv_count,json=pvCount,proto3" json:"pv_count,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ListPVRequest) Reset()         { *m = ListPVRequest{} }
-func (m *ListPVRequest) String() string { return proto.CompactTextString(m) }
-func (*ListPVRequest) ProtoMessage()    {}
-func (*ListPVRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8cc5677814b58357, []int{34}
+func (m *GetPVNumReply) Reset()         { *m = GetPVNumReply{} }
+func (m *GetPVNumReply) String() string { return proto.CompactTextString(m) }
+func (*GetPVNumReply) ProtoMessage()    {}
+func (*GetPVNumReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8cc5677814b58357, []int{58}
 }
 
-func (m *ListPVRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ListPVRequest.Unmarshal(m, b)
+func (m *GetPVNumReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetPVNumReply.Unmarshal(m, b)
 }
-func (m *ListPVRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ListPVRequest.Marshal(b, m, deterministic)
+func (m *GetPVNumReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetPVNumReply.Marshal(b, m, deterministic)
 }
-func (m *ListPVRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ListPVRequest.Merge(m, src)
+func (m *GetPVNumReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetPVNumReply.Merge(m, src)
 }
-func (m *ListPVRequest) XXX_Size() int {
-	return xxx_messageInfo_ListPVRequest.Size(m)
+func (m *GetPVNumReply) XXX_Size() int {
+	return xxx_messageInfo_GetPVNumReply.Size(m)
 }
-func (m *ListPVRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_ListPVRequest.DiscardUnknown(m)
+func (m *GetPVNumReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetPVNumReply.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ListPVRequest proto.InternalMessageInfo
+var xxx_messageInfo_GetPVNumReply proto.InternalMessageInfo
 
-type ListPVReply struct {
-	Pvinfos              []*PVInfo `protobuf:"bytes,1,rep,name=pvinfos,proto3" json:"pvinfos,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
-	XXX_unrecognized     []byte    `json:"-"`
-	XXX_sizecache        int32     `json:"-"`
+func (m *GetPVNumReply) GetCommandOutput() string {
+	if m != nil {
+		return m.CommandOutput
+	}
+	return ""
+}
+
+func (m *GetPVNumReply) GetPvCount() uint32 {
+	if m != nil {
+		return m.PvCount
+	}
+	return 0
+}
+
+type ListBlockDevicesRequest struct {
+	// filters, devices smaller than min_size are left out and, when
+	// path_globs are given, devices matching none of them by path or link
+	MinSize              uint64   `protobuf:"varint,1,opt,name=min_size,json=minSize,proto3" json:"min_size,omitempty"`
+	ExcludeRemovable     bool     `protobuf:"varint,2,opt,name=exclude_removable,json=excludeRemovable,proto3" json:"exclude_removable,omitempty"`
+	PathGlobs            []string `protobuf:"bytes,3,rep,name=path_globs,json=pathGlobs,proto3" json:"path_globs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ListPVReply) Reset()         { *m = ListPVReply{} }
-func (m *ListPVReply) String() string { return proto.CompactTextString(m) }
-func (*ListPVReply) ProtoMessage()    {}
-func (*ListPVReply) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8cc5677814b58357, []int{35}
+func (m *ListBlockDevicesRequest) Reset()         { *m = ListBlockDevicesRequest{} }
+func (m *ListBlockDevicesRequest) String() string { return proto.CompactTextString(m) }
+func (*ListBlockDevicesRequest) ProtoMessage()    {}
+func (*ListBlockDevicesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8cc5677814b58357, []int{59}
 }
 
-func (m *ListPVReply) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ListPVReply.Unmarshal(m, b)
+func (m *ListBlockDevicesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListBlockDevicesRequest.Unmarshal(m, b)
 }
-func (m *ListPVReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ListPVReply.Marshal(b, m, deterministic)
+func (m *ListBlockDevicesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListBlockDevicesRequest.Marshal(b, m, deterministic)
 }
-func (m *ListPVReply) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ListPVReply.Merge(m, src)
+func (m *ListBlockDevicesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListBlockDevicesRequest.Merge(m, src)
 }
-func (m *ListPVReply) XXX_Size() int {
-	return xxx_messageInfo_ListPVReply.Size(m)
+func (m *ListBlockDevicesRequest) XXX_Size() int {
+	return xxx_messageInfo_ListBlockDevicesRequest.Size(m)
 }
-func (m *ListPVReply) XXX_DiscardUnknown() {
-	xxx_messageInfo_ListPVReply.DiscardUnknown(m)
+func (m *ListBlockDevicesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListBlockDevicesRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ListPVReply proto.InternalMessageInfo
+var xxx_messageInfo_ListBlockDevicesRequest proto.InternalMessageInfo
 
-func (m *ListPVReply) GetPvinfos() []*PVInfo {
+func (m *ListBlockDevicesRequest) GetMinSize() uint64 {
 	if m != nil {
-		return m.Pvinfos
+		return m.MinSize
+	}
+	return 0
+}
+
+func (m *ListBlockDevicesRequest) GetExcludeRemovable() bool {
+	if m != nil {
+		return m.ExcludeRemovable
+	}
+	return false
+}
+
+func (m *ListBlockDevicesRequest) GetPathGlobs() []string {
+	if m != nil {
+		return m.PathGlobs
 	}
 	return nil
 }
 
-type PVInfo struct {
-	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Uuid                 string   `protobuf:"bytes,2,opt,name=uuid,proto3" json:"uuid,omitempty"`
-	Fmt                  string   `protobuf:"bytes,3,opt,name=fmt,proto3" json:"fmt,omitempty"`
-	Size                 uint64   `protobuf:"varint,4,opt,name=size,proto3" json:"size,omitempty"`
-	Usize                uint64   `protobuf:"varint,5,opt,name=usize,proto3" json:"usize,omitempty"`
-	Fsize                uint64   `protobuf:"varint,6,opt,name=fsize,proto3" json:"fsize,omitempty"`
+type BlockDevice struct {
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Path string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	Size uint64 `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
+	// disk, part, lvm, ... as reported by lsblk
+	Type           string   `protobuf:"bytes,4,opt,name=type,proto3" json:"type,omitempty"`
+	Rotational     bool     `protobuf:"varint,5,opt,name=rotational,proto3" json:"rotational,omitempty"`
+	Removable      bool     `protobuf:"varint,6,opt,name=removable,proto3" json:"removable,omitempty"`
+	Model          string   `protobuf:"bytes,7,opt,name=model,proto3" json:"model,omitempty"`
+	Serial         string   `protobuf:"bytes,8,opt,name=serial,proto3" json:"serial,omitempty"`
+	Wwn            string   `protobuf:"bytes,9,opt,name=wwn,proto3" json:"wwn,omitempty"`
+	Transport      string   `protobuf:"bytes,10,opt,name=transport,proto3" json:"transport,omitempty"`
+	PartitionTable string   `protobuf:"bytes,11,opt,name=partition_table,json=partitionTable,proto3" json:"partition_table,omitempty"`
+	Filesystem     string   `protobuf:"bytes,12,opt,name=filesystem,proto3" json:"filesystem,omitempty"`
+	Mountpoints    []string `protobuf:"bytes,13,rep,name=mountpoints,proto3" json:"mountpoints,omitempty"`
+	// the disk of a partition
+	Parent string `protobuf:"bytes,14,opt,name=parent,proto3" json:"parent,omitempty"`
+	// the devices stacked on the device, like the volumes on a physical volume
+	Holders []string `protobuf:"bytes,15,rep,name=holders,proto3" json:"holders,omitempty"`
+	// the udev links like /dev/disk/by-id/... and /dev/disk/by-path/...
+	Links                []string `protobuf:"bytes,16,rep,name=links,proto3" json:"links,omitempty"`
+	PhysicalVolume       bool     `protobuf:"varint,17,opt,name=physical_volume,json=physicalVolume,proto3" json:"physical_volume,omitempty"`
+	VolumeGroup          string   `protobuf:"bytes,18,opt,name=volume_group,json=volumeGroup,proto3" json:"volume_group,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *PVInfo) Reset()         { *m = PVInfo{} }
-func (m *PVInfo) String() string { return proto.CompactTextString(m) }
-func (*PVInfo) ProtoMessage()    {}
-func (*PVInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8cc5677814b58357, []int{36}
+func (m *BlockDevice) Reset()         { *m = BlockDevice{} }
+func (m *BlockDevice) String() string { return proto.CompactTextString(m) }
+func (*BlockDevice) ProtoMessage()    {}
+func (*BlockDevice) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8cc5677814b58357, []int{60}
 }
 
-func (m *PVInfo) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_PVInfo.Unmarshal(m, b)
+func (m *BlockDevice) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BlockDevice.Unmarshal(m, b)
 }
-func (m *PVInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_PVInfo.Marshal(b, m, deterministic)
+func (m *BlockDevice) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BlockDevice.Marshal(b, m, deterministic)
 }
-func (m *PVInfo) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PVInfo.Merge(m, src)
+func (m *BlockDevice) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlockDevice.Merge(m, src)
 }
-func (m *PVInfo) XXX_Size() int {
-	return xxx_messageInfo_PVInfo.Size(m)
+func (m *BlockDevice) XXX_Size() int {
+	return xxx_messageInfo_BlockDevice.Size(m)
 }
-func (m *PVInfo) XXX_DiscardUnknown() {
-	xxx_messageInfo_PVInfo.DiscardUnknown(m)
+func (m *BlockDevice) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlockDevice.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_PVInfo proto.InternalMessageInfo
+var xxx_messageInfo_BlockDevice proto.InternalMessageInfo
 
-func (m *PVInfo) GetName() string {
+func (m *BlockDevice) GetName() string {
 	if m != nil {
 		return m.Name
 	}
 	return ""
 }
 
-func (m *PVInfo) GetUuid() string {
+func (m *BlockDevice) GetPath() string {
 	if m != nil {
-		return m.Uuid
+		return m.Path
 	}
 	return ""
 }
 
-func (m *PVInfo) GetFmt() string {
+func (m *BlockDevice) GetSize() uint64 {
 	if m != nil {
-		return m.Fmt
+		return m.Size
 	}
-	return ""
+	return 0
 }
 
-func (m *PVInfo) GetSize() uint64 {
+func (m *BlockDevice) GetType() string {
 	if m != nil {
-		return m.Size
+		return m.Type
 	}
-	return 0
+	return ""
 }
 
-func (m *PVInfo) GetUsize() uint64 {
+func (m *BlockDevice) GetRotational() bool {
 	if m != nil {
-		return m.Usize
+		return m.Rotational
 	}
-	return 0
+	return false
 }
 
-func (m *PVInfo) GetFsize() uint64 {
+func (m *BlockDevice) GetRemovable() bool {
 	if m != nil {
-		return m.Fsize
+		return m.Removable
 	}
-	return 0
+	return false
 }
 
-type ValidateRequest struct {
-	Block                string   `protobuf:"bytes,1,opt,name=block,proto3" json:"block,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *BlockDevice) GetModel() string {
+	if m != nil {
+		return m.Model
+	}
+	return ""
 }
 
-func (m *ValidateRequest) Reset()         { *m = ValidateRequest{} }
-func (m *ValidateRequest) String() string { return proto.CompactTextString(m) }
-func (*ValidateRequest) ProtoMessage()    {}
-func (*ValidateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8cc5677814b58357, []int{37}
+func (m *BlockDevice) GetSerial() string {
+	if m != nil {
+		return m.Serial
+	}
+	return ""
 }
 
-func (m *ValidateRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ValidateRequest.Unmarshal(m, b)
-}
-func (m *ValidateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ValidateRequest.Marshal(b, m, deterministic)
-}
-func (m *ValidateRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ValidateRequest.Merge(m, src)
-}
-func (m *ValidateRequest) XXX_Size() int {
-	return xxx_messageInfo_ValidateRequest.Size(m)
-}
-func (m *ValidateRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_ValidateRequest.DiscardUnknown(m)
+func (m *BlockDevice) GetWwn() string {
+	if m != nil {
+		return m.Wwn
+	}
+	return ""
 }
 
-var xxx_messageInfo_ValidateRequest proto.InternalMessageInfo
-
-func (m *ValidateRequest) GetBlock() string {
+func (m *BlockDevice) GetTransport() string {
 	if m != nil {
-		return m.Block
+		return m.Transport
 	}
 	return ""
 }
 
-type ValidateReply struct {
-	Validate             bool     `protobuf:"varint,1,opt,name=validate,proto3" json:"validate,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *BlockDevice) GetPartitionTable() string {
+	if m != nil {
+		return m.PartitionTable
+	}
+	return ""
 }
 
-func (m *ValidateReply) Reset()         { *m = ValidateReply{} }
-func (m *ValidateReply) String() string { return proto.CompactTextString(m) }
-func (*ValidateReply) ProtoMessage()    {}
-func (*ValidateReply) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8cc5677814b58357, []int{38}
+func (m *BlockDevice) GetFilesystem() string {
+	if m != nil {
+		return m.Filesystem
+	}
+	return ""
 }
 
-func (m *ValidateReply) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ValidateReply.Unmarshal(m, b)
-}
-func (m *ValidateReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ValidateReply.Marshal(b, m, deterministic)
-}
-func (m *ValidateReply) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ValidateReply.Merge(m, src)
-}
-func (m *ValidateReply) XXX_Size() int {
-	return xxx_messageInfo_ValidateReply.Size(m)
-}
-func (m *ValidateReply) XXX_DiscardUnknown() {
-	xxx_messageInfo_ValidateReply.DiscardUnknown(m)
+func (m *BlockDevice) GetMountpoints() []string {
+	if m != nil {
+		return m.Mountpoints
+	}
+	return nil
 }
 
-var xxx_messageInfo_ValidateReply proto.InternalMessageInfo
-
-func (m *ValidateReply) GetValidate() bool {
+func (m *BlockDevice) GetParent() string {
 	if m != nil {
-		return m.Validate
+		return m.Parent
 	}
-	return false
+	return ""
 }
 
-type DestoryRequest struct {
-	Block                string   `protobuf:"bytes,1,opt,name=block,proto3" json:"block,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *BlockDevice) GetHolders() []string {
+	if m != nil {
+		return m.Holders
+	}
+	return nil
 }
 
-func (m *DestoryRequest) Reset()         { *m = DestoryRequest{} }
-func (m *DestoryRequest) String() string { return proto.CompactTextString(m) }
-func (*DestoryRequest) ProtoMessage()    {}
-func (*DestoryRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8cc5677814b58357, []int{39}
+func (m *BlockDevice) GetLinks() []string {
+	if m != nil {
+		return m.Links
+	}
+	return nil
 }
 
-func (m *DestoryRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_DestoryRequest.Unmarshal(m, b)
-}
-func (m *DestoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_DestoryRequest.Marshal(b, m, deterministic)
-}
-func (m *DestoryRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DestoryRequest.Merge(m, src)
-}
-func (m *DestoryRequest) XXX_Size() int {
-	return xxx_messageInfo_DestoryRequest.Size(m)
-}
-func (m *DestoryRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_DestoryRequest.DiscardUnknown(m)
+func (m *BlockDevice) GetPhysicalVolume() bool {
+	if m != nil {
+		return m.PhysicalVolume
+	}
+	return false
 }
 
-var xxx_messageInfo_DestoryRequest proto.InternalMessageInfo
-
-func (m *DestoryRequest) GetBlock() string {
+func (m *BlockDevice) GetVolumeGroup() string {
 	if m != nil {
-		return m.Block
+		return m.VolumeGroup
 	}
 	return ""
 }
 
-type DestoryReply struct {
-	CommandOutput        string   `protobuf:"bytes,1,opt,name=command_output,json=commandOutput,proto3" json:"command_output,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+type ListBlockDevicesReply struct {
+	Devices              []*BlockDevice `protobuf:"bytes,1,rep,name=devices,proto3" json:"devices,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
 }
 
-func (m *DestoryReply) Reset()         { *m = DestoryReply{} }
-func (m *DestoryReply) String() string { return proto.CompactTextString(m) }
-func (*DestoryReply) ProtoMessage()    {}
-func (*DestoryReply) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8cc5677814b58357, []int{40}
+func (m *ListBlockDevicesReply) Reset()         { *m = ListBlockDevicesReply{} }
+func (m *ListBlockDevicesReply) String() string { return proto.CompactTextString(m) }
+func (*ListBlockDevicesReply) ProtoMessage()    {}
+func (*ListBlockDevicesReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8cc5677814b58357, []int{61}
 }
 
-func (m *DestoryReply) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_DestoryReply.Unmarshal(m, b)
+func (m *ListBlockDevicesReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListBlockDevicesReply.Unmarshal(m, b)
 }
-func (m *DestoryReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_DestoryReply.Marshal(b, m, deterministic)
+func (m *ListBlockDevicesReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListBlockDevicesReply.Marshal(b, m, deterministic)
 }
-func (m *DestoryReply) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DestoryReply.Merge(m, src)
+func (m *ListBlockDevicesReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListBlockDevicesReply.Merge(m, src)
 }
-func (m *DestoryReply) XXX_Size() int {
-	return xxx_messageInfo_DestoryReply.Size(m)
+func (m *ListBlockDevicesReply) XXX_Size() int {
+	return xxx_messageInfo_ListBlockDevicesReply.Size(m)
 }
-func (m *DestoryReply) XXX_DiscardUnknown() {
-	xxx_messageInfo_DestoryReply.DiscardUnknown(m)
+func (m *ListBlockDevicesReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListBlockDevicesReply.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DestoryReply proto.InternalMessageInfo
+var xxx_messageInfo_ListBlockDevicesReply proto.InternalMessageInfo
 
-func (m *DestoryReply) GetCommandOutput() string {
+func (m *ListBlockDevicesReply) GetDevices() []*BlockDevice {
 	if m != nil {
-		return m.CommandOutput
+		return m.Devices
 	}
-	return ""
+	return nil
 }
 
-type MatchRequest struct {
-	Block                string   `protobuf:"bytes,1,opt,name=block,proto3" json:"block,omitempty"`
+type WatchRequest struct {
+	// resume after the event with resource_version, 0 starts with the current
+	// inventory as ADDED events. A version which is too old, or from a
+	// previous run of lvmd, fails the call with OUT_OF_RANGE and the client
+	// starts over with 0
+	ResourceVersion      uint64   `protobuf:"varint,1,opt,name=resource_version,json=resourceVersion,proto3" json:"resource_version,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *MatchRequest) Reset()         { *m = MatchRequest{} }
-func (m *MatchRequest) String() string { return proto.CompactTextString(m) }
-func (*MatchRequest) ProtoMessage()    {}
-func (*MatchRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8cc5677814b58357, []int{41}
+func (m *WatchRequest) Reset()         { *m = WatchRequest{} }
+func (m *WatchRequest) String() string { return proto.CompactTextString(m) }
+func (*WatchRequest) ProtoMessage()    {}
+func (*WatchRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8cc5677814b58357, []int{62}
 }
 
-func (m *MatchRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_MatchRequest.Unmarshal(m, b)
+func (m *WatchRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WatchRequest.Unmarshal(m, b)
 }
-func (m *MatchRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_MatchRequest.Marshal(b, m, deterministic)
+func (m *WatchRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WatchRequest.Marshal(b, m, deterministic)
 }
-func (m *MatchRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MatchRequest.Merge(m, src)
+func (m *WatchRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WatchRequest.Merge(m, src)
 }
-func (m *MatchRequest) XXX_Size() int {
-	return xxx_messageInfo_MatchRequest.Size(m)
+func (m *WatchRequest) XXX_Size() int {
+	return xxx_messageInfo_WatchRequest.Size(m)
 }
-func (m *MatchRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_MatchRequest.DiscardUnknown(m)
+func (m *WatchRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_WatchRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MatchRequest proto.InternalMessageInfo
+var xxx_messageInfo_WatchRequest proto.InternalMessageInfo
 
-func (m *MatchRequest) GetBlock() string {
+func (m *WatchRequest) GetResourceVersion() uint64 {
 	if m != nil {
-		return m.Block
+		return m.ResourceVersion
 	}
-	return ""
+	return 0
 }
 
-type MatchReply struct {
-	CommandOutput        string   `protobuf:"bytes,1,opt,name=command_output,json=commandOutput,proto3" json:"command_output,omitempty"`
+type WatchEvent struct {
+	Type            WatchEvent_Type `protobuf:"varint,1,opt,name=type,proto3,enum=lvm.WatchEvent_Type" json:"type,omitempty"`
+	ResourceVersion uint64          `protobuf:"varint,2,opt,name=resource_version,json=resourceVersion,proto3" json:"resource_version,omitempty"`
+	// Types that are valid to be assigned to Object:
+	//	*WatchEvent_LogicalVolume
+	//	*WatchEvent_VolumeGroup
+	//	*WatchEvent_PhysicalVolume
+	Object isWatchEvent_Object `protobuf_oneof:"object"`
+	// volume group of logical_volume
+	VolumeGroupName      string   `protobuf:"bytes,6,opt,name=volume_group_name,json=volumeGroupName,proto3" json:"volume_group_name,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *MatchReply) Reset()         { *m = MatchReply{} }
-func (m *MatchReply) String() string { return proto.CompactTextString(m) }
-func (*MatchReply) ProtoMessage()    {}
-func (*MatchReply) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8cc5677814b58357, []int{42}
+func (m *WatchEvent) Reset()         { *m = WatchEvent{} }
+func (m *WatchEvent) String() string { return proto.CompactTextString(m) }
+func (*WatchEvent) ProtoMessage()    {}
+func (*WatchEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8cc5677814b58357, []int{63}
 }
 
-func (m *MatchReply) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_MatchReply.Unmarshal(m, b)
+func (m *WatchEvent) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WatchEvent.Unmarshal(m, b)
 }
-func (m *MatchReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_MatchReply.Marshal(b, m, deterministic)
+func (m *WatchEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WatchEvent.Marshal(b, m, deterministic)
 }
-func (m *MatchReply) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MatchReply.Merge(m, src)
+func (m *WatchEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WatchEvent.Merge(m, src)
 }
-func (m *MatchReply) XXX_Size() int {
-	return xxx_messageInfo_MatchReply.Size(m)
+func (m *WatchEvent) XXX_Size() int {
+	return xxx_messageInfo_WatchEvent.Size(m)
 }
-func (m *MatchReply) XXX_DiscardUnknown() {
-	xxx_messageInfo_MatchReply.DiscardUnknown(m)
+func (m *WatchEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_WatchEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WatchEvent proto.InternalMessageInfo
+
+func (m *WatchEvent) GetType() WatchEvent_Type {
+	if m != nil {
+		return m.Type
+	}
+	return WatchEvent_ADDED
 }
 
-var xxx_messageInfo_MatchReply proto.InternalMessageInfo
-
-func (m *MatchReply) GetCommandOutput() string {
+func (m *WatchEvent) GetResourceVersion() uint64 {
 	if m != nil {
-		return m.CommandOutput
+		return m.ResourceVersion
 	}
-	return ""
+	return 0
 }
 
-type GetPVNumReply struct {
-	CommandOutput        string   `protobuf:"bytes,1,opt,name=command_output,json=commandOutput,proto3" json:"command_output,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+type isWatchEvent_Object interface {
+	isWatchEvent_Object()
 }
 
-func (m *GetPVNumReply) Reset()         { *m = GetPVNumReply{} }
-func (m *GetPVNumReply) String() string { return proto.CompactTextString(m) }
-func (*GetPVNumReply) ProtoMessage()    {}
-func (*GetPVNumReply) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8cc5677814b58357, []int{43}
+type WatchEvent_LogicalVolume struct {
+	LogicalVolume *LogicalVolume `protobuf:"bytes,3,opt,name=logical_volume,json=logicalVolume,proto3,oneof"`
 }
 
-func (m *GetPVNumReply) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetPVNumReply.Unmarshal(m, b)
+type WatchEvent_VolumeGroup struct {
+	VolumeGroup *VolumeGroup `protobuf:"bytes,4,opt,name=volume_group,json=volumeGroup,proto3,oneof"`
 }
-func (m *GetPVNumReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetPVNumReply.Marshal(b, m, deterministic)
+
+type WatchEvent_PhysicalVolume struct {
+	PhysicalVolume *PVInfo `protobuf:"bytes,5,opt,name=physical_volume,json=physicalVolume,proto3,oneof"`
 }
-func (m *GetPVNumReply) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetPVNumReply.Merge(m, src)
+
+func (*WatchEvent_LogicalVolume) isWatchEvent_Object() {}
+
+func (*WatchEvent_VolumeGroup) isWatchEvent_Object() {}
+
+func (*WatchEvent_PhysicalVolume) isWatchEvent_Object() {}
+
+func (m *WatchEvent) GetObject() isWatchEvent_Object {
+	if m != nil {
+		return m.Object
+	}
+	return nil
 }
-func (m *GetPVNumReply) XXX_Size() int {
-	return xxx_messageInfo_GetPVNumReply.Size(m)
+
+func (m *WatchEvent) GetLogicalVolume() *LogicalVolume {
+	if x, ok := m.GetObject().(*WatchEvent_LogicalVolume); ok {
+		return x.LogicalVolume
+	}
+	return nil
 }
-func (m *GetPVNumReply) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetPVNumReply.DiscardUnknown(m)
+
+func (m *WatchEvent) GetVolumeGroup() *VolumeGroup {
+	if x, ok := m.GetObject().(*WatchEvent_VolumeGroup); ok {
+		return x.VolumeGroup
+	}
+	return nil
 }
 
-var xxx_messageInfo_GetPVNumReply proto.InternalMessageInfo
+func (m *WatchEvent) GetPhysicalVolume() *PVInfo {
+	if x, ok := m.GetObject().(*WatchEvent_PhysicalVolume); ok {
+		return x.PhysicalVolume
+	}
+	return nil
+}
 
-func (m *GetPVNumReply) GetCommandOutput() string {
+func (m *WatchEvent) GetVolumeGroupName() string {
 	if m != nil {
-		return m.CommandOutput
+		return m.VolumeGroupName
 	}
 	return ""
 }
 
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*WatchEvent) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*WatchEvent_LogicalVolume)(nil),
+		(*WatchEvent_VolumeGroup)(nil),
+		(*WatchEvent_PhysicalVolume)(nil),
+	}
+}
+
 func init() {
 	proto.RegisterEnum("lvm.LogicalVolume_Attributes_Type", LogicalVolume_Attributes_Type_name, LogicalVolume_Attributes_Type_value)
 	proto.RegisterEnum("lvm.LogicalVolume_Attributes_Permissions", LogicalVolume_Attributes_Permissions_name, LogicalVolume_Attributes_Permissions_value)
@@ -2428,9 +4681,16 @@ func init() {
 	proto.RegisterEnum("lvm.LogicalVolume_Attributes_State", LogicalVolume_Attributes_State_name, LogicalVolume_Attributes_State_value)
 	proto.RegisterEnum("lvm.LogicalVolume_Attributes_TargetType", LogicalVolume_Attributes_TargetType_name, LogicalVolume_Attributes_TargetType_value)
 	proto.RegisterEnum("lvm.LogicalVolume_Attributes_Health", LogicalVolume_Attributes_Health_name, LogicalVolume_Attributes_Health_value)
+	proto.RegisterEnum("lvm.CreateThinPoolRequest_Zeroing", CreateThinPoolRequest_Zeroing_name, CreateThinPoolRequest_Zeroing_value)
+	proto.RegisterEnum("lvm.CreateThinPoolRequest_Discards", CreateThinPoolRequest_Discards_name, CreateThinPoolRequest_Discards_value)
+	proto.RegisterEnum("lvm.ValidateReply_Reason_Kind", ValidateReply_Reason_Kind_name, ValidateReply_Reason_Kind_value)
+	proto.RegisterEnum("lvm.WatchEvent_Type", WatchEvent_Type_name, WatchEvent_Type_value)
 	proto.RegisterType((*LogicalVolume)(nil), "lvm.LogicalVolume")
 	proto.RegisterType((*LogicalVolume_Attributes)(nil), "lvm.LogicalVolume.Attributes")
+	proto.RegisterType((*LogicalVolume_Segment)(nil), "lvm.LogicalVolume.Segment")
+	proto.RegisterType((*LogicalVolume_Device)(nil), "lvm.LogicalVolume.Device")
 	proto.RegisterType((*VolumeGroup)(nil), "lvm.VolumeGroup")
+	proto.RegisterType((*VolumeGroup_Attributes)(nil), "lvm.VolumeGroup.Attributes")
 	proto.RegisterType((*ListLVRequest)(nil), "lvm.ListLVRequest")
 	proto.RegisterType((*ListLVReply)(nil), "lvm.ListLVReply")
 	proto.RegisterType((*CreateLVRequest)(nil), "lvm.CreateLVRequest")
@@ -2444,7 +4704,19 @@ func init() {
 	proto.RegisterType((*RemoveLVRequest)(nil), "lvm.RemoveLVRequest")
 	proto.RegisterType((*RemoveLVReply)(nil), "lvm.RemoveLVReply")
 	proto.RegisterType((*CloneLVRequest)(nil), "lvm.CloneLVRequest")
-	proto.RegisterType((*CloneLVReply)(nil), "lvm.CloneLVReply")
+	proto.RegisterType((*CloneLVProgress)(nil), "lvm.CloneLVProgress")
+	proto.RegisterType((*CreateSnapshotRequest)(nil), "lvm.CreateSnapshotRequest")
+	proto.RegisterType((*CreateSnapshotReply)(nil), "lvm.CreateSnapshotReply")
+	proto.RegisterType((*ListSnapshotsRequest)(nil), "lvm.ListSnapshotsRequest")
+	proto.RegisterType((*ListSnapshotsReply)(nil), "lvm.ListSnapshotsReply")
+	proto.RegisterType((*MergeSnapshotRequest)(nil), "lvm.MergeSnapshotRequest")
+	proto.RegisterType((*MergeSnapshotReply)(nil), "lvm.MergeSnapshotReply")
+	proto.RegisterType((*ThinPoolStatus)(nil), "lvm.ThinPoolStatus")
+	proto.RegisterType((*ThinPoolPolicy)(nil), "lvm.ThinPoolPolicy")
+	proto.RegisterType((*SetThinPoolPolicyRequest)(nil), "lvm.SetThinPoolPolicyRequest")
+	proto.RegisterType((*SetThinPoolPolicyReply)(nil), "lvm.SetThinPoolPolicyReply")
+	proto.RegisterType((*GetThinPoolStatusRequest)(nil), "lvm.GetThinPoolStatusRequest")
+	proto.RegisterType((*GetThinPoolStatusReply)(nil), "lvm.GetThinPoolStatusReply")
 	proto.RegisterType((*ResizeLVRequest)(nil), "lvm.ResizeLVRequest")
 	proto.RegisterType((*ResizeLVReply)(nil), "lvm.ResizeLVReply")
 	proto.RegisterType((*ListVGRequest)(nil), "lvm.ListVGRequest")
@@ -2466,143 +4738,285 @@ func init() {
 	proto.RegisterType((*ListPVRequest)(nil), "lvm.ListPVRequest")
 	proto.RegisterType((*ListPVReply)(nil), "lvm.ListPVReply")
 	proto.RegisterType((*PVInfo)(nil), "lvm.PVInfo")
+	proto.RegisterType((*PVInfo_Attributes)(nil), "lvm.PVInfo.Attributes")
 	proto.RegisterType((*ValidateRequest)(nil), "lvm.ValidateRequest")
+	proto.RegisterType((*Signature)(nil), "lvm.Signature")
 	proto.RegisterType((*ValidateReply)(nil), "lvm.ValidateReply")
+	proto.RegisterType((*ValidateReply_Reason)(nil), "lvm.ValidateReply.Reason")
 	proto.RegisterType((*DestoryRequest)(nil), "lvm.DestoryRequest")
 	proto.RegisterType((*DestoryReply)(nil), "lvm.DestoryReply")
+	proto.RegisterType((*RestoreSignaturesRequest)(nil), "lvm.RestoreSignaturesRequest")
+	proto.RegisterType((*RestoreSignaturesReply)(nil), "lvm.RestoreSignaturesReply")
 	proto.RegisterType((*MatchRequest)(nil), "lvm.MatchRequest")
 	proto.RegisterType((*MatchReply)(nil), "lvm.MatchReply")
 	proto.RegisterType((*GetPVNumReply)(nil), "lvm.GetPVNumReply")
+	proto.RegisterType((*ListBlockDevicesRequest)(nil), "lvm.ListBlockDevicesRequest")
+	proto.RegisterType((*BlockDevice)(nil), "lvm.BlockDevice")
+	proto.RegisterType((*ListBlockDevicesReply)(nil), "lvm.ListBlockDevicesReply")
+	proto.RegisterType((*WatchRequest)(nil), "lvm.WatchRequest")
+	proto.RegisterType((*WatchEvent)(nil), "lvm.WatchEvent")
 }
 
 func init() { proto.RegisterFile("lvm.proto", fileDescriptor_8cc5677814b58357) }
 
 var fileDescriptor_8cc5677814b58357 = []byte{
-	// 1982 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x59, 0x5f, 0x8f, 0xe3, 0x48,
-	0x11, 0x9f, 0xfc, 0x4f, 0x2a, 0xff, 0x7a, 0x7a, 0x67, 0xf6, 0x72, 0x39, 0x60, 0x07, 0xdf, 0x1e,
-	0x37, 0x27, 0xd8, 0x15, 0x9a, 0x65, 0x57, 0x3a, 0x38, 0x84, 0x7c, 0x89, 0x37, 0xb1, 0xd6, 0xb1,
-	0xad, 0xb6, 0x27, 0xcb, 0x48, 0x48, 0xc6, 0x93, 0x78, 0x66, 0xcc, 0x25, 0x71, 0x48, 0x9c, 0xe8,
-	0x66, 0x1f, 0x79, 0xe0, 0x81, 0x67, 0xc4, 0x07, 0xe0, 0x1b, 0xf1, 0xcc, 0x2b, 0x9f, 0x03, 0xa1,
-	0xee, 0xf6, 0xdf, 0x4c, 0x2e, 0xac, 0x75, 0x7b, 0x6f, 0xee, 0xea, 0xfa, 0x55, 0xfd, 0xba, 0xaa,
-	0xba, 0xba, 0xa2, 0x40, 0x6d, 0xb6, 0x9d, 0x3f, 0x5f, 0xae, 0x3c, 0xdf, 0xc3, 0x85, 0xd9, 0x76,
-	0x2e, 0xfc, 0x03, 0x41, 0x53, 0xf1, 0x6e, 0xdd, 0x89, 0x3d, 0x1b, 0x7b, 0xb3, 0xcd, 0xdc, 0xc1,
-	0x18, 0x8a, 0x0b, 0x7b, 0xee, 0x74, 0x72, 0x67, 0xb9, 0xf3, 0x1a, 0x61, 0xdf, 0x54, 0xb6, 0x76,
-	0xdf, 0x39, 0x9d, 0xfc, 0x59, 0xee, 0xbc, 0x48, 0xd8, 0x37, 0x95, 0x6d, 0x36, 0xee, 0xb4, 0x53,
-	0xe0, 0x7a, 0xf4, 0x1b, 0xff, 0x16, 0xc0, 0xf6, 0xfd, 0x95, 0x7b, 0xbd, 0xf1, 0x9d, 0x75, 0xa7,
-	0x78, 0x96, 0x3b, 0xaf, 0x5f, 0xfc, 0xf8, 0x39, 0x75, 0x99, 0xf2, 0xf1, 0x5c, 0x8c, 0x94, 0x48,
-	0x02, 0x80, 0x7f, 0x0a, 0x8d, 0x89, 0xb7, 0xbc, 0xb7, 0x96, 0xce, 0x6a, 0xe2, 0x2c, 0xfc, 0x4e,
-	0x89, 0x99, 0xae, 0x53, 0x99, 0xce, 0x45, 0xf8, 0x25, 0x7c, 0x64, 0x4f, 0xfc, 0x8d, 0x3d, 0xb3,
-	0xa6, 0xce, 0xd6, 0x9a, 0xdb, 0x7f, 0xf2, 0x56, 0xd6, 0x62, 0x33, 0xbf, 0x76, 0x56, 0x9d, 0xf2,
-	0x59, 0xee, 0xbc, 0x49, 0x4e, 0xf8, 0x76, 0xdf, 0xd9, 0x8e, 0xe8, 0xa6, 0xca, 0xf6, 0x76, 0x61,
-	0xee, 0x22, 0x86, 0x55, 0x76, 0x61, 0x74, 0x33, 0x80, 0x61, 0x28, 0xfa, 0xf6, 0xed, 0xba, 0x53,
-	0x3d, 0x2b, 0xd0, 0x33, 0xd2, 0xef, 0xee, 0x7f, 0x9a, 0x00, 0x31, 0x7f, 0xfc, 0x0a, 0x8a, 0xfe,
-	0xfd, 0x92, 0x87, 0xab, 0x75, 0x21, 0x1c, 0x3c, 0xec, 0x73, 0xf3, 0x7e, 0xe9, 0x10, 0xa6, 0x8f,
-	0xdf, 0x40, 0x7d, 0xe9, 0xac, 0xe6, 0xee, 0x7a, 0xed, 0x7a, 0x8b, 0x35, 0x8b, 0x6c, 0xeb, 0xe2,
-	0x8b, 0xc3, 0x70, 0x3d, 0x06, 0x90, 0x24, 0x1a, 0x0f, 0x01, 0xec, 0xd9, 0xcc, 0x9b, 0xd8, 0xbe,
-	0xeb, 0x2d, 0x58, 0x46, 0x5a, 0x17, 0xe7, 0x87, 0x6d, 0x89, 0x91, 0x3e, 0x49, 0x60, 0xf1, 0x13,
-	0xa8, 0xdf, 0xb8, 0xdf, 0x3a, 0x53, 0x1e, 0x23, 0x96, 0xc2, 0x2a, 0x01, 0x26, 0x62, 0x81, 0xc1,
-	0x5f, 0x42, 0x69, 0xed, 0xdb, 0xbe, 0xc3, 0x92, 0xd3, 0xba, 0xf8, 0xf4, 0xb0, 0x17, 0x83, 0xaa,
-	0x12, 0x8e, 0xa0, 0xd1, 0xf4, 0x96, 0xce, 0x82, 0x25, 0xaa, 0x4a, 0xd8, 0x37, 0x96, 0xa1, 0xee,
-	0xdb, 0xab, 0x5b, 0xc7, 0xb7, 0x58, 0x14, 0x2b, 0xef, 0x43, 0xdd, 0x64, 0x00, 0x16, 0x4b, 0xf0,
-	0xa3, 0x6f, 0xdc, 0x81, 0xca, 0x3b, 0x67, 0xe5, 0xb9, 0x8b, 0xdb, 0x4e, 0x95, 0x79, 0x08, 0x97,
-	0xf8, 0x2b, 0x28, 0xdf, 0x39, 0xf6, 0xcc, 0xbf, 0xeb, 0xd4, 0x98, 0xfd, 0xa7, 0x87, 0xed, 0x0f,
-	0x99, 0x2e, 0x09, 0x30, 0xf8, 0x19, 0x60, 0x7b, 0xe2, 0xbb, 0x5b, 0x16, 0x20, 0x6b, 0xfd, 0x8d,
-	0xbb, 0x5c, 0x3a, 0xd3, 0x0e, 0x30, 0x17, 0xc7, 0xf1, 0x8e, 0xc1, 0x37, 0x84, 0xff, 0xe6, 0xa1,
-	0xc8, 0xf8, 0x60, 0x68, 0x8d, 0x44, 0xe5, 0xb5, 0x46, 0x46, 0x52, 0xdf, 0x32, 0xaf, 0x74, 0x09,
-	0x1d, 0xe1, 0x06, 0x54, 0x47, 0x32, 0x21, 0x1a, 0x91, 0xfa, 0x28, 0x87, 0x3f, 0x86, 0xd3, 0x70,
-	0x65, 0xbd, 0x95, 0xcd, 0xa1, 0x76, 0x69, 0x5a, 0xc6, 0x95, 0xda, 0x43, 0x79, 0x0c, 0x50, 0xd6,
-	0x88, 0x3c, 0x90, 0x55, 0x54, 0xc0, 0x67, 0xf0, 0x23, 0xfe, 0xcd, 0x94, 0xac, 0x91, 0x44, 0x06,
-	0xb2, 0x3a, 0xb0, 0x0c, 0x55, 0xd4, 0x8d, 0xa1, 0x66, 0xa2, 0x22, 0xae, 0x42, 0x91, 0x88, 0x72,
-	0x1f, 0x95, 0xf0, 0x29, 0x1c, 0xd3, 0xaf, 0xb4, 0xb9, 0x32, 0xf5, 0x1b, 0xa9, 0x57, 0xf0, 0x09,
-	0xa0, 0x07, 0x46, 0xaa, 0xb8, 0x0e, 0x15, 0x7d, 0x6c, 0x8d, 0xb4, 0xb1, 0x84, 0x6a, 0x94, 0xfc,
-	0x58, 0x26, 0xe6, 0xa5, 0xa8, 0x58, 0x9c, 0x22, 0x02, 0xfc, 0x18, 0x70, 0x28, 0x63, 0x3e, 0xe4,
-	0x91, 0x38, 0x90, 0x50, 0x1d, 0x77, 0xe1, 0x71, 0xbc, 0xb6, 0xa8, 0x57, 0xed, 0x35, 0x77, 0xdc,
-	0xc0, 0x2d, 0x00, 0x8e, 0xb7, 0x14, 0x6d, 0x80, 0x9a, 0xd4, 0xf5, 0xa5, 0xda, 0x97, 0x88, 0xd5,
-	0xd3, 0xd4, 0xb1, 0x44, 0x0c, 0x59, 0x53, 0x51, 0x8b, 0xf2, 0x37, 0x87, 0xb2, 0x8a, 0xda, 0xb8,
-	0x09, 0x35, 0xfa, 0x65, 0xe9, 0x9a, 0xa6, 0x20, 0x44, 0x69, 0x44, 0x4b, 0xab, 0x2f, 0x9a, 0x22,
-	0x3a, 0xc6, 0x3f, 0x81, 0x2e, 0x73, 0xa7, 0x11, 0x2b, 0xde, 0x1b, 0x49, 0xa6, 0xc8, 0xf6, 0xb1,
-	0xf0, 0x47, 0xa8, 0x27, 0x2e, 0x0a, 0x0b, 0x72, 0x94, 0x06, 0x5d, 0x22, 0x23, 0xd9, 0xa0, 0x5e,
-	0x0d, 0x74, 0x44, 0x9d, 0xbd, 0x25, 0xb2, 0x29, 0x89, 0x5f, 0x2b, 0x12, 0xca, 0xd1, 0x25, 0x91,
-	0xc4, 0xbe, 0xa5, 0xa9, 0xca, 0x15, 0xca, 0xe3, 0x0e, 0x9c, 0x44, 0x4b, 0x4b, 0xec, 0x99, 0xf2,
-	0x58, 0x34, 0x29, 0xdd, 0x82, 0xf0, 0xaf, 0x1c, 0x40, 0x7c, 0x7f, 0xa8, 0x62, 0xec, 0x41, 0x54,
-	0x14, 0xad, 0xc7, 0x15, 0x59, 0xba, 0x45, 0xf5, 0xea, 0xed, 0x50, 0x22, 0xd4, 0x7e, 0x0b, 0xa0,
-	0xa7, 0xa9, 0xa6, 0x3c, 0xb8, 0xd4, 0x2e, 0x0d, 0x94, 0xa7, 0xfe, 0x64, 0x75, 0x28, 0x51, 0x06,
-	0x7d, 0x54, 0xc0, 0x35, 0x28, 0xf5, 0x14, 0x59, 0x1d, 0xa0, 0x22, 0xcd, 0xbe, 0xaa, 0x91, 0x91,
-	0xa8, 0xa0, 0x12, 0x7e, 0x04, 0xed, 0xd0, 0x86, 0xa5, 0x68, 0xbd, 0x37, 0x52, 0x1f, 0x95, 0x69,
-	0x9a, 0x63, 0x53, 0xa1, 0x98, 0x25, 0x36, 0xb2, 0x18, 0x4a, 0xab, 0x18, 0x41, 0x83, 0x19, 0x0e,
-	0x25, 0x35, 0x7c, 0x0c, 0x4d, 0x6e, 0x3f, 0x14, 0x81, 0xf0, 0xd7, 0x3c, 0x94, 0xd8, 0x6d, 0xa5,
-	0x0e, 0xe3, 0xe3, 0x18, 0xa6, 0x68, 0xd2, 0xc2, 0x05, 0x28, 0xb3, 0x10, 0x04, 0x71, 0x32, 0x2e,
-	0x0d, 0x5d, 0x52, 0xfb, 0x52, 0x1f, 0xe5, 0xb9, 0xd3, 0xb1, 0xa8, 0xc8, 0xfd, 0xb8, 0x9a, 0x0a,
-	0x34, 0x4b, 0x91, 0x34, 0x54, 0x4e, 0x96, 0xec, 0xc7, 0x70, 0x1a, 0xae, 0x58, 0x45, 0x4b, 0xd6,
-	0x6b, 0x51, 0x56, 0x24, 0x5a, 0xc3, 0x9f, 0xc2, 0x93, 0x87, 0x90, 0xb4, 0x52, 0x19, 0x9f, 0xc3,
-	0xd3, 0x91, 0xa8, 0xeb, 0x52, 0xdf, 0xea, 0x4b, 0x63, 0xb9, 0x27, 0x59, 0x3a, 0x91, 0x0c, 0x49,
-	0x35, 0xa3, 0xca, 0x37, 0x69, 0x56, 0x0d, 0x54, 0xc1, 0xcf, 0xe0, 0x8b, 0xef, 0xd6, 0xb4, 0x64,
-	0x95, 0x9f, 0x8b, 0xeb, 0xa3, 0xaa, 0xf0, 0xf7, 0x1c, 0x40, 0xdc, 0x61, 0xd8, 0x5d, 0x89, 0x6f,
-	0xb1, 0x48, 0x06, 0x92, 0x89, 0x8e, 0x68, 0x00, 0x83, 0xb2, 0x0e, 0x44, 0x39, 0xdc, 0x86, 0x3a,
-	0x2b, 0xcb, 0x40, 0x90, 0xa7, 0x71, 0x8c, 0xc8, 0x07, 0xc2, 0x02, 0xd5, 0x62, 0x45, 0x1b, 0x08,
-	0x8a, 0xb4, 0xc2, 0x2f, 0xd5, 0x37, 0xaa, 0xf6, 0x36, 0x92, 0x95, 0x92, 0x97, 0x2f, 0x90, 0x95,
-	0x85, 0x05, 0x94, 0x79, 0x5f, 0x4a, 0x33, 0x1a, 0x4a, 0xa2, 0x62, 0x0e, 0xd1, 0x11, 0x2e, 0x43,
-	0x5e, 0x7b, 0x83, 0x72, 0xec, 0x16, 0x8b, 0xc4, 0x94, 0x45, 0x05, 0xe5, 0xa9, 0x21, 0x22, 0xbd,
-	0x26, 0x92, 0x31, 0xb4, 0x54, 0x49, 0xea, 0xb3, 0x32, 0xa3, 0x70, 0xd9, 0x18, 0x89, 0x66, 0x6f,
-	0x28, 0x19, 0x96, 0xf4, 0x7b, 0xd9, 0xa0, 0x34, 0xda, 0x50, 0x67, 0x57, 0x61, 0xa4, 0x19, 0xa6,
-	0x72, 0x85, 0x4a, 0xc2, 0x3b, 0xa8, 0xf3, 0xce, 0x38, 0x58, 0x79, 0x9b, 0xe5, 0x7b, 0x4f, 0x05,
-	0x9f, 0x40, 0xed, 0x66, 0xe5, 0x38, 0x16, 0xdb, 0x28, 0xb0, 0x8d, 0x2a, 0x15, 0x18, 0xc9, 0x91,
-	0xa1, 0x98, 0x18, 0x19, 0xc2, 0x27, 0xb6, 0x14, 0x3f, 0xb1, 0xc2, 0x05, 0x34, 0x15, 0x77, 0xed,
-	0x2b, 0x63, 0xe2, 0xfc, 0x79, 0xe3, 0xac, 0x7d, 0x3a, 0x18, 0x6c, 0x19, 0x19, 0xeb, 0x96, 0xb2,
-	0x09, 0x58, 0xd4, 0xb7, 0x31, 0x41, 0xe1, 0x37, 0x50, 0x0f, 0x31, 0xcb, 0xd9, 0x3d, 0xfe, 0x05,
-	0x54, 0xf8, 0xee, 0xba, 0x93, 0x3b, 0x2b, 0x9c, 0xd7, 0x2f, 0xf0, 0xc3, 0x9e, 0x4f, 0x42, 0x15,
-	0xe1, 0x6f, 0x39, 0x68, 0xf7, 0x56, 0x8e, 0xed, 0x3b, 0x59, 0x7c, 0x46, 0x41, 0xc9, 0xef, 0x09,
-	0x4a, 0x21, 0x11, 0x94, 0x0e, 0x54, 0xe6, 0xee, 0x6a, 0xe5, 0xad, 0xf8, 0x4c, 0xd4, 0x24, 0xe1,
-	0x72, 0xef, 0xe9, 0x5f, 0x41, 0x33, 0xe6, 0x42, 0xcf, 0xf2, 0x19, 0xb4, 0x26, 0xde, 0x7c, 0x6e,
-	0x2f, 0xa6, 0x96, 0xb7, 0xf1, 0x97, 0x1b, 0x3f, 0xe0, 0xd2, 0x0c, 0xa4, 0x1a, 0x13, 0x0a, 0x2a,
-	0x9c, 0x72, 0x9c, 0x79, 0xe7, 0x2e, 0x74, 0xcf, 0x9b, 0x65, 0x3b, 0xc9, 0xd2, 0xf3, 0x66, 0xe1,
-	0x49, 0xe8, 0xb7, 0xf0, 0x15, 0x3c, 0xda, 0xb5, 0x97, 0x81, 0xcd, 0x10, 0xda, 0xbd, 0x3b, 0x7b,
-	0x71, 0xfb, 0xbd, 0x23, 0xca, 0xe2, 0x11, 0x59, 0xca, 0xc0, 0xe0, 0x9f, 0xb9, 0xe4, 0x01, 0xb2,
-	0xd2, 0xd8, 0x0d, 0x47, 0x44, 0xad, 0xb0, 0x27, 0xd9, 0xc5, 0xfd, 0xc9, 0x2e, 0xed, 0x4f, 0x76,
-	0x39, 0x91, 0xec, 0x5f, 0xc3, 0x71, 0x9a, 0x63, 0xb6, 0x10, 0x13, 0x67, 0xee, 0x6d, 0x3f, 0x48,
-	0x88, 0x63, 0x4b, 0x99, 0x4a, 0xae, 0xd5, 0x9b, 0x79, 0x8b, 0x04, 0x81, 0x27, 0x50, 0x5f, 0x7b,
-	0x9b, 0xd5, 0xc4, 0xb1, 0x12, 0xed, 0x02, 0xb8, 0x48, 0xa5, 0x21, 0xfb, 0x04, 0x6a, 0x53, 0x67,
-	0xed, 0x5b, 0x09, 0x0e, 0x55, 0x2a, 0xa0, 0x9b, 0xc2, 0x4b, 0x68, 0x44, 0xf6, 0x32, 0xd0, 0xf8,
-	0x03, 0x0d, 0x04, 0x0d, 0xfe, 0x0f, 0x71, 0x7b, 0x79, 0x70, 0x42, 0xeb, 0x19, 0x58, 0xb5, 0x79,
-	0x17, 0x1b, 0x0f, 0x02, 0x4e, 0x42, 0x9f, 0xb7, 0x28, 0x2a, 0xa0, 0x66, 0x5e, 0x42, 0x33, 0x49,
-	0x31, 0x6c, 0x54, 0x88, 0x35, 0xaa, 0x44, 0xef, 0x25, 0x8d, 0x04, 0xeb, 0xb5, 0x70, 0x1d, 0xb6,
-	0xaa, 0xc8, 0xf0, 0xde, 0xe6, 0xfc, 0x39, 0xb4, 0x97, 0x77, 0xf7, 0x6b, 0xda, 0xed, 0x2c, 0x8e,
-	0x0f, 0x0e, 0xda, 0x0a, 0xc5, 0xf1, 0xef, 0x3d, 0x56, 0x95, 0x85, 0x7d, 0x2d, 0x28, 0xe4, 0xfa,
-	0x9e, 0x47, 0xfe, 0x2c, 0xac, 0xc8, 0x83, 0xdc, 0xe2, 0x72, 0xcb, 0x68, 0x5e, 0x85, 0xb6, 0xf4,
-	0xad, 0xef, 0x2c, 0xa6, 0x1f, 0xe6, 0xe8, 0x94, 0x47, 0x6c, 0x2f, 0x5b, 0xbd, 0x89, 0xd3, 0xa9,
-	0x69, 0xdf, 0x7e, 0x88, 0x7a, 0xdb, 0x17, 0xfc, 0xd8, 0x7a, 0x06, 0x56, 0x16, 0x60, 0x1e, 0xd5,
-	0x1f, 0x8a, 0xd8, 0x97, 0x80, 0x52, 0x0e, 0x32, 0x70, 0xfb, 0x3c, 0x2c, 0x5a, 0x3d, 0x22, 0x76,
-	0x02, 0xa5, 0xeb, 0x99, 0x37, 0xf9, 0x26, 0x00, 0xf0, 0x45, 0x5c, 0x79, 0x7a, 0x66, 0x07, 0x9c,
-	0xdb, 0x7b, 0x38, 0x88, 0x15, 0xb3, 0xdf, 0xe6, 0xc8, 0xbc, 0xf0, 0x2b, 0x7e, 0x9b, 0x63, 0x33,
-	0x95, 0xe5, 0xd6, 0x5d, 0xdc, 0x78, 0xe1, 0x3d, 0xae, 0xb3, 0x7b, 0xac, 0x8f, 0xe5, 0xc5, 0x8d,
-	0x47, 0xc2, 0x3d, 0xe1, 0x2f, 0x39, 0x28, 0x73, 0xd9, 0x77, 0x8d, 0x54, 0x6c, 0x42, 0xca, 0x27,
-	0x26, 0x24, 0x04, 0x85, 0x9b, 0xb9, 0x1f, 0xbc, 0x3b, 0xf4, 0x73, 0xef, 0xb3, 0x73, 0x02, 0xa5,
-	0x0d, 0x13, 0x96, 0x98, 0x90, 0x2f, 0xa8, 0xf4, 0x86, 0x49, 0xcb, 0x5c, 0xca, 0x16, 0x34, 0x58,
-	0x63, 0x7b, 0xe6, 0x4e, 0xe9, 0x6f, 0xf3, 0x83, 0xc1, 0xfa, 0x39, 0x34, 0x63, 0x45, 0x7a, 0xca,
-	0x2e, 0x54, 0xb7, 0x81, 0x80, 0x69, 0x56, 0x49, 0xb4, 0x16, 0x7e, 0x06, 0xad, 0xbe, 0xb3, 0xf6,
-	0xbd, 0xd5, 0xfd, 0x61, 0xa3, 0x2f, 0xa1, 0x11, 0xe9, 0x65, 0x48, 0xc0, 0x53, 0x68, 0x8c, 0x6c,
-	0x7f, 0x72, 0x77, 0xd8, 0xf8, 0x0b, 0x80, 0x40, 0x2b, 0x83, 0xe9, 0x57, 0xd0, 0x1c, 0x38, 0xbe,
-	0x3e, 0x56, 0x37, 0xf3, 0x2c, 0xb8, 0x8b, 0x7f, 0xd7, 0xa0, 0xa0, 0x8c, 0x47, 0xf8, 0x97, 0x50,
-	0xe6, 0xb3, 0x27, 0x0e, 0xa6, 0xcc, 0xe4, 0xf0, 0xda, 0x45, 0x29, 0xd9, 0x72, 0x76, 0x2f, 0x1c,
-	0xe1, 0x57, 0x50, 0x0d, 0x67, 0x3c, 0x7c, 0xc2, 0xf6, 0x77, 0xc6, 0xcf, 0x2e, 0xde, 0x91, 0x72,
-	0xdc, 0x10, 0x5a, 0xe9, 0x99, 0x0c, 0x77, 0x13, 0x7a, 0x3b, 0x83, 0x5f, 0xb7, 0xb3, 0x77, 0x8f,
-	0x5b, 0xfa, 0x1a, 0x1a, 0xc9, 0xc1, 0x03, 0xef, 0xea, 0xc6, 0x4c, 0x1e, 0xef, 0xd9, 0x89, 0x4f,
-	0x11, 0x4c, 0x66, 0xe1, 0x29, 0xd2, 0x23, 0x5f, 0x78, 0x8a, 0xe4, 0xf8, 0xc6, 0x71, 0xe1, 0xb8,
-	0x11, 0xe0, 0x76, 0xe6, 0x98, 0x00, 0x97, 0x9a, 0x49, 0x84, 0x23, 0xfc, 0x02, 0x2a, 0xc1, 0x78,
-	0x80, 0x1f, 0x71, 0xc3, 0xa9, 0xe1, 0xa3, 0x7b, 0x9c, 0x16, 0x26, 0x9c, 0xf1, 0xe7, 0x3b, 0x72,
-	0x96, 0x9a, 0x15, 0x22, 0x67, 0x89, 0x37, 0x9e, 0xe3, 0xc2, 0x36, 0x1c, 0xe0, 0x76, 0x7a, 0x7e,
-	0x80, 0x4b, 0xf5, 0x6a, 0xe1, 0x08, 0xff, 0x0e, 0xea, 0x89, 0x2e, 0x89, 0x3f, 0x4a, 0x9c, 0x24,
-	0x85, 0x3e, 0x7d, 0xb8, 0xc1, 0x0d, 0x04, 0xd5, 0x34, 0x1e, 0x24, 0xaa, 0x29, 0x7a, 0xf0, 0x12,
-	0xd5, 0x14, 0x3c, 0x5a, 0xc9, 0x6a, 0x1a, 0x0f, 0x52, 0xd5, 0x14, 0xa3, 0xf0, 0x8e, 0x74, 0x27,
-	0x0f, 0xff, 0x07, 0x97, 0x7a, 0xac, 0x93, 0xfe, 0xf4, 0x74, 0xf5, 0xea, 0x7b, 0xab, 0x57, 0x7f,
-	0x98, 0x77, 0x3d, 0x9d, 0x77, 0x7d, 0x6f, 0xde, 0x53, 0xb8, 0xf0, 0x9d, 0x0e, 0x70, 0x3b, 0x63,
-	0x40, 0x80, 0x4b, 0x3d, 0xe6, 0xa1, 0xbf, 0xe9, 0x66, 0xe2, 0x64, 0xc4, 0x05, 0x19, 0xd0, 0x93,
-	0xf7, 0x59, 0xdf, 0x73, 0x9f, 0x63, 0x86, 0xcf, 0xa0, 0xc4, 0xda, 0x0e, 0xe6, 0x25, 0x98, 0x6c,
-	0x54, 0xdd, 0x76, 0x52, 0x14, 0x11, 0x0b, 0x1b, 0xce, 0xc1, 0xc0, 0xa7, 0xba, 0x12, 0xc7, 0x85,
-	0xfd, 0x38, 0xc0, 0xed, 0xf4, 0xf1, 0x00, 0x97, 0x6a, 0xda, 0xfc, 0xe2, 0x04, 0x2d, 0x37, 0xb8,
-	0x38, 0xe9, 0x46, 0x1d, 0x5c, 0x9c, 0x64, 0x57, 0x16, 0x8e, 0xae, 0xcb, 0xec, 0x6f, 0x82, 0x17,
-	0xff, 0x0b, 0x00, 0x00, 0xff, 0xff, 0x9f, 0x41, 0x22, 0x16, 0x33, 0x18, 0x00, 0x00,
+	// 4083 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x5b, 0x4b, 0x8f, 0x1b, 0x49,
+	0x72, 0x16, 0xdf, 0x64, 0xb0, 0x49, 0x56, 0xa7, 0x5a, 0x12, 0x45, 0xcd, 0x43, 0x53, 0x33, 0x83,
+	0xed, 0xd1, 0xcc, 0xc8, 0x83, 0x1e, 0x8f, 0xe0, 0xc5, 0xac, 0x31, 0xa0, 0x9a, 0xa5, 0x6e, 0x42,
+	0x7c, 0x4d, 0x15, 0x9b, 0x6d, 0xad, 0x0d, 0x94, 0xab, 0xc9, 0x6c, 0x76, 0xad, 0x8a, 0x55, 0x74,
+	0x55, 0x91, 0xa3, 0x5e, 0x03, 0xc6, 0x1e, 0x0c, 0x1f, 0x0c, 0xc3, 0x27, 0x5f, 0x7d, 0xd9, 0x9b,
+	0xaf, 0x7b, 0x30, 0xfc, 0x37, 0xfc, 0x07, 0x0c, 0x18, 0xf0, 0x1f, 0xf0, 0xcd, 0xf0, 0xc1, 0x30,
+	0x22, 0x33, 0xeb, 0x45, 0x56, 0xb7, 0xd4, 0xab, 0xed, 0x1b, 0xf3, 0xcb, 0x88, 0xc8, 0xc8, 0xc8,
+	0xc8, 0xcc, 0xc8, 0x88, 0x22, 0x54, 0xac, 0xf5, 0xe2, 0xe9, 0xd2, 0x75, 0x7c, 0x87, 0xe4, 0xac,
+	0xf5, 0x42, 0xfe, 0xed, 0x3d, 0xa8, 0xf5, 0x9c, 0xb9, 0x39, 0x35, 0xac, 0x89, 0x63, 0xad, 0x16,
+	0x94, 0x10, 0xc8, 0xdb, 0xc6, 0x82, 0x36, 0x33, 0x8f, 0x33, 0xfb, 0x15, 0x95, 0xfd, 0x46, 0xcc,
+	0x33, 0x7f, 0x4d, 0x9b, 0xd9, 0xc7, 0x99, 0xfd, 0xbc, 0xca, 0x7e, 0x23, 0xb6, 0x5a, 0x99, 0xb3,
+	0x66, 0x8e, 0xd3, 0xe1, 0x6f, 0xf2, 0xa7, 0x00, 0x86, 0xef, 0xbb, 0xe6, 0xd9, 0xca, 0xa7, 0x5e,
+	0x33, 0xff, 0x38, 0xb3, 0x5f, 0x3d, 0xf8, 0xf0, 0x29, 0x0e, 0x99, 0x18, 0xe3, 0x69, 0x3b, 0x24,
+	0x52, 0x63, 0x0c, 0xe4, 0x13, 0xd8, 0x99, 0x3a, 0xcb, 0x4b, 0x7d, 0x49, 0xdd, 0x29, 0xb5, 0xfd,
+	0x66, 0x81, 0x89, 0xae, 0x22, 0x36, 0xe2, 0x10, 0xf9, 0x0e, 0x1e, 0x18, 0x53, 0x7f, 0x65, 0x58,
+	0xfa, 0x8c, 0xae, 0xf5, 0x85, 0xf1, 0x2b, 0xc7, 0xd5, 0xed, 0xd5, 0xe2, 0x8c, 0xba, 0xcd, 0xe2,
+	0xe3, 0xcc, 0x7e, 0x4d, 0xdd, 0xe3, 0xdd, 0x1d, 0xba, 0xee, 0x63, 0xe7, 0x80, 0xf5, 0x6d, 0xb2,
+	0x99, 0x76, 0xc4, 0x56, 0xda, 0x64, 0x33, 0xed, 0x90, 0x8d, 0x40, 0xde, 0x37, 0xe6, 0x5e, 0xb3,
+	0xfc, 0x38, 0x87, 0x73, 0xc4, 0xdf, 0xe4, 0x3e, 0x14, 0x1d, 0xd7, 0x9c, 0x9b, 0x76, 0xb3, 0xc2,
+	0xd4, 0x13, 0x2d, 0x54, 0xde, 0xb3, 0x8d, 0x65, 0xa8, 0x3c, 0x70, 0xe5, 0x11, 0x0b, 0x94, 0x7f,
+	0x00, 0xa5, 0xa5, 0xe3, 0x58, 0xba, 0xb5, 0x6e, 0x56, 0x39, 0x2f, 0x36, 0x7b, 0x6b, 0xe4, 0x9d,
+	0x19, 0xbe, 0x11, 0xf2, 0xee, 0x3c, 0xce, 0xec, 0x67, 0xd4, 0x2a, 0x62, 0x01, 0xef, 0x17, 0x20,
+	0x2d, 0xa8, 0x6f, 0x24, 0xc8, 0x6a, 0x8c, 0xac, 0x11, 0xe0, 0x01, 0x69, 0x13, 0x4a, 0x1e, 0x9d,
+	0xfb, 0x97, 0x4b, 0xda, 0xac, 0xb3, 0x61, 0x82, 0x26, 0xeb, 0xf1, 0x5d, 0x73, 0x49, 0xbd, 0x66,
+	0x83, 0x4d, 0x3b, 0x68, 0x92, 0x8f, 0xa1, 0xca, 0x7f, 0xea, 0x6c, 0xa1, 0x25, 0xb6, 0xd0, 0xc0,
+	0x21, 0x0d, 0x97, 0xfb, 0x63, 0xa8, 0xba, 0x74, 0x6e, 0x3a, 0x36, 0x27, 0xd8, 0xe5, 0x04, 0x1c,
+	0x62, 0x04, 0x4d, 0x28, 0xcd, 0xe8, 0xda, 0x9c, 0x52, 0xaf, 0x49, 0x98, 0xb9, 0x82, 0x26, 0x79,
+	0x06, 0x65, 0x8f, 0xce, 0x17, 0xd4, 0xf6, 0xbd, 0xe6, 0xdd, 0xc7, 0xb9, 0xfd, 0xea, 0x41, 0x2b,
+	0xc5, 0x27, 0x34, 0x4e, 0xa2, 0x86, 0xb4, 0xad, 0xff, 0xaa, 0x01, 0x44, 0x9e, 0x42, 0x9e, 0x41,
+	0x9e, 0xcd, 0x09, 0x1d, 0xb3, 0x7e, 0x20, 0x5f, 0xeb, 0x56, 0x4f, 0xc7, 0x97, 0x4b, 0xaa, 0x32,
+	0x7a, 0xf2, 0x12, 0xaa, 0x4b, 0xea, 0x2e, 0x4c, 0xcf, 0x33, 0x1d, 0xdb, 0x63, 0x3e, 0x5c, 0x3f,
+	0xf8, 0xe2, 0x7a, 0xf6, 0x51, 0xc4, 0xa0, 0xc6, 0xb9, 0xc9, 0x31, 0x80, 0x61, 0x59, 0xce, 0xd4,
+	0xf0, 0x4d, 0xc7, 0x66, 0xbe, 0x5f, 0x3f, 0xd8, 0xbf, 0x5e, 0x56, 0x3b, 0xa4, 0x57, 0x63, 0xbc,
+	0x68, 0xd0, 0x73, 0xf3, 0x0d, 0x9d, 0x71, 0x6f, 0x64, 0x9b, 0xa5, 0xac, 0x02, 0x83, 0x98, 0x0b,
+	0x92, 0x9f, 0x43, 0xc1, 0xf3, 0x0d, 0x9f, 0xb2, 0x6d, 0x50, 0x3f, 0xf8, 0xf4, 0xfa, 0x51, 0x34,
+	0x24, 0x55, 0x39, 0x07, 0xfa, 0xad, 0xb3, 0xa4, 0x36, 0xdb, 0x12, 0x65, 0x95, 0xfd, 0x26, 0x5d,
+	0xa8, 0xfa, 0x86, 0x3b, 0xa7, 0xbe, 0xce, 0xac, 0x58, 0x7a, 0x17, 0xd5, 0xc7, 0x8c, 0x81, 0xd9,
+	0x12, 0xfc, 0xf0, 0x37, 0x2e, 0xf5, 0xaf, 0xa9, 0xeb, 0x98, 0xf6, 0xbc, 0x59, 0x66, 0x23, 0x04,
+	0x4d, 0xf2, 0x0b, 0x28, 0x5e, 0x50, 0xc3, 0xf2, 0x2f, 0xd8, 0xe6, 0xa8, 0x1f, 0x7c, 0x76, 0xbd,
+	0xfc, 0x63, 0x46, 0xab, 0x0a, 0x1e, 0xf2, 0x35, 0x10, 0x63, 0xea, 0x9b, 0x6b, 0x66, 0x20, 0xdd,
+	0x7b, 0x6d, 0x2e, 0x97, 0x74, 0xc6, 0x36, 0x52, 0x59, 0xdd, 0x8d, 0x7a, 0x34, 0xde, 0x21, 0xff,
+	0x5f, 0x16, 0xf2, 0x4c, 0x1f, 0x02, 0xf5, 0x7e, 0xbb, 0xf7, 0x62, 0xa8, 0xf6, 0x95, 0x8e, 0x3e,
+	0x7e, 0x35, 0x52, 0xa4, 0x3b, 0x64, 0x07, 0xca, 0xfd, 0xae, 0xaa, 0x0e, 0x55, 0xa5, 0x23, 0x65,
+	0xc8, 0x43, 0xb8, 0x17, 0xb4, 0xf4, 0xd3, 0xee, 0xf8, 0x78, 0x78, 0x32, 0xd6, 0xb5, 0x57, 0x83,
+	0x43, 0x29, 0x4b, 0x00, 0x8a, 0x43, 0xb5, 0x7b, 0xd4, 0x1d, 0x48, 0x39, 0xf2, 0x18, 0x3e, 0xe0,
+	0xbf, 0x19, 0x91, 0xde, 0x57, 0xd4, 0xa3, 0xee, 0xe0, 0x48, 0xd7, 0x06, 0xed, 0x91, 0x76, 0x3c,
+	0x1c, 0x4b, 0x79, 0x52, 0x86, 0xbc, 0xda, 0xee, 0x76, 0xa4, 0x02, 0xb9, 0x07, 0xbb, 0xf8, 0x2b,
+	0x29, 0xae, 0x88, 0xe3, 0x86, 0xe4, 0x25, 0xb2, 0x07, 0xd2, 0x96, 0x90, 0x32, 0xa9, 0x42, 0x69,
+	0x34, 0xd1, 0xfb, 0xc3, 0x89, 0x22, 0x55, 0x50, 0xf9, 0x49, 0x57, 0x1d, 0x9f, 0xb4, 0x7b, 0x3a,
+	0x57, 0x51, 0x02, 0x72, 0x1f, 0x48, 0x80, 0xb1, 0x31, 0xba, 0xfd, 0xf6, 0x91, 0x22, 0x55, 0x49,
+	0x0b, 0xee, 0x47, 0x6d, 0x1d, 0x47, 0x1d, 0xbe, 0xe0, 0x03, 0xef, 0x90, 0x3a, 0x00, 0xe7, 0xd7,
+	0x7b, 0xc3, 0x23, 0xa9, 0x86, 0x43, 0x9f, 0x0c, 0x3a, 0x8a, 0xaa, 0x1f, 0x0e, 0x07, 0x13, 0x45,
+	0xd5, 0xba, 0xc3, 0x81, 0x54, 0x47, 0xfd, 0xc7, 0xc7, 0xdd, 0x81, 0xd4, 0x20, 0x35, 0xa8, 0xe0,
+	0x2f, 0x7d, 0x34, 0x1c, 0xf6, 0x24, 0x09, 0xd5, 0x08, 0x9b, 0x7a, 0xa7, 0x3d, 0x6e, 0x4b, 0xbb,
+	0xe4, 0x23, 0x68, 0xb1, 0xe1, 0x86, 0xaa, 0x1e, 0xf5, 0xf5, 0x95, 0x71, 0x9b, 0xf5, 0x13, 0xf9,
+	0x2f, 0xa1, 0x1a, 0xdb, 0x28, 0xcc, 0xc8, 0xe1, 0x32, 0x8c, 0x14, 0xb5, 0xdf, 0xd5, 0x70, 0x54,
+	0x4d, 0xba, 0x83, 0x83, 0x9d, 0xaa, 0xdd, 0xb1, 0xd2, 0x7e, 0xde, 0x53, 0xa4, 0x0c, 0x36, 0x55,
+	0xa5, 0xdd, 0xd1, 0x87, 0x83, 0xde, 0x2b, 0x29, 0x4b, 0x9a, 0xb0, 0x17, 0x36, 0xf5, 0xf6, 0xe1,
+	0xb8, 0x3b, 0x69, 0x8f, 0x51, 0xdd, 0x9c, 0xfc, 0xef, 0x19, 0x80, 0x68, 0xff, 0x20, 0x61, 0x34,
+	0x42, 0xbb, 0xd7, 0x1b, 0x1e, 0x72, 0x42, 0xb6, 0xdc, 0xed, 0xc1, 0xab, 0xd3, 0x63, 0x45, 0x45,
+	0xf9, 0x75, 0x80, 0xc3, 0xe1, 0x60, 0xdc, 0x3d, 0x3a, 0x19, 0x9e, 0x68, 0x52, 0x16, 0xc7, 0xeb,
+	0x0e, 0x8e, 0x15, 0xd4, 0xa0, 0x23, 0xe5, 0x48, 0x05, 0x0a, 0x87, 0xbd, 0xee, 0xe0, 0x48, 0xca,
+	0xe3, 0xea, 0x0f, 0x86, 0x6a, 0xbf, 0xdd, 0x93, 0x0a, 0xe4, 0x2e, 0x34, 0x02, 0x19, 0x7a, 0x6f,
+	0x78, 0xf8, 0x52, 0xe9, 0x48, 0x45, 0x5c, 0xe6, 0x48, 0x54, 0x00, 0xb3, 0x85, 0x0d, 0x25, 0x06,
+	0x68, 0x99, 0x48, 0xb0, 0xc3, 0x04, 0x07, 0x48, 0x85, 0xec, 0x42, 0x8d, 0xcb, 0x0f, 0x20, 0x90,
+	0xff, 0x2e, 0x0b, 0x05, 0xb6, 0x5b, 0x71, 0xc0, 0x68, 0x3a, 0xda, 0xb8, 0x3d, 0x46, 0xc7, 0x05,
+	0x28, 0x32, 0x13, 0x08, 0x3b, 0x69, 0x27, 0xda, 0x48, 0x19, 0x74, 0x94, 0x8e, 0x94, 0xe5, 0x83,
+	0x4e, 0xda, 0xbd, 0x6e, 0x27, 0xf2, 0xa6, 0x1c, 0xae, 0x52, 0x88, 0x06, 0xc4, 0x71, 0x97, 0x7d,
+	0x08, 0xf7, 0x82, 0x16, 0xf3, 0x68, 0x45, 0x7f, 0xd1, 0xee, 0xf6, 0x14, 0xf4, 0xe1, 0x4f, 0xe1,
+	0xe3, 0x6d, 0x96, 0x24, 0x51, 0x91, 0xec, 0xc3, 0x67, 0xfd, 0xf6, 0x68, 0xa4, 0x74, 0xf4, 0x8e,
+	0x32, 0xe9, 0x1e, 0x2a, 0xfa, 0x48, 0x55, 0x34, 0x65, 0x30, 0x0e, 0x3d, 0x7f, 0x8c, 0xab, 0xaa,
+	0x49, 0x25, 0xf2, 0x35, 0x7c, 0x71, 0x35, 0xa5, 0xde, 0x1d, 0xf0, 0x79, 0x71, 0x7a, 0xa9, 0x2c,
+	0xff, 0x53, 0x06, 0x20, 0x3a, 0x61, 0xd8, 0x5e, 0x89, 0x76, 0x71, 0x5b, 0x3d, 0x52, 0xc6, 0xd2,
+	0x1d, 0x34, 0xa0, 0x70, 0x6b, 0x01, 0x65, 0x48, 0x03, 0xaa, 0xcc, 0x2d, 0x05, 0x90, 0x45, 0x3b,
+	0x86, 0xca, 0x0b, 0x30, 0x87, 0x54, 0xcc, 0x69, 0x05, 0x90, 0x47, 0x0f, 0x3f, 0x19, 0xbc, 0x1c,
+	0x0c, 0x4f, 0x43, 0xac, 0x10, 0xdf, 0x7c, 0x02, 0x2b, 0xca, 0x36, 0x14, 0xf9, 0xb9, 0x94, 0xd4,
+	0xe8, 0x58, 0x69, 0xf7, 0xc6, 0xc7, 0xd2, 0x1d, 0x52, 0x84, 0xec, 0xf0, 0xa5, 0x94, 0x61, 0xbb,
+	0xb8, 0xad, 0x8e, 0xbb, 0xed, 0x9e, 0x94, 0x45, 0x41, 0xaa, 0xf2, 0x42, 0x55, 0xb4, 0x63, 0x7d,
+	0xa0, 0x28, 0x1d, 0xe6, 0x66, 0xc8, 0xde, 0xd5, 0xfa, 0xed, 0xf1, 0xe1, 0xb1, 0xa2, 0xe9, 0xca,
+	0x9f, 0x75, 0x35, 0x54, 0xa3, 0x01, 0x55, 0xb6, 0x15, 0xfa, 0x43, 0x6d, 0xdc, 0x7b, 0x25, 0x15,
+	0x5a, 0xff, 0x91, 0x81, 0x92, 0xb8, 0xfc, 0xc8, 0x1e, 0x3b, 0xf3, 0x5d, 0x9f, 0x5d, 0x72, 0x79,
+	0x95, 0x37, 0x52, 0xc3, 0xaf, 0xd8, 0x25, 0x9f, 0xbb, 0xf2, 0x92, 0xcf, 0x5f, 0x7b, 0xc9, 0x17,
+	0xde, 0x76, 0xc9, 0x17, 0xb7, 0x2e, 0xf9, 0x6f, 0xa3, 0x4b, 0xbe, 0xc4, 0x6e, 0xf2, 0x87, 0x29,
+	0x07, 0x7c, 0x87, 0x51, 0x84, 0xf7, 0x7f, 0xeb, 0x07, 0x28, 0x72, 0x28, 0x35, 0xb6, 0xc4, 0xb8,
+	0x09, 0x67, 0xa9, 0xd3, 0x37, 0x3e, 0x06, 0x35, 0x7c, 0x92, 0x55, 0x86, 0x29, 0x0c, 0x92, 0x7f,
+	0x53, 0x80, 0x2a, 0x97, 0x7d, 0xe4, 0x3a, 0xab, 0xe5, 0x3b, 0x87, 0xa8, 0x8f, 0xa0, 0x72, 0xee,
+	0x52, 0x31, 0xdb, 0x1c, 0xeb, 0x28, 0x23, 0xa0, 0xc5, 0xe3, 0xd7, 0x7c, 0x2c, 0x7e, 0x0d, 0xe2,
+	0xbd, 0x42, 0x2c, 0xde, 0xfb, 0x18, 0xaa, 0x5c, 0xb3, 0x84, 0x4d, 0x38, 0xc4, 0x04, 0x7d, 0x02,
+	0x3b, 0x82, 0x60, 0xea, 0xac, 0x6c, 0x9f, 0xdd, 0xac, 0x79, 0x55, 0x30, 0x1d, 0x22, 0x44, 0x9e,
+	0xc0, 0x2e, 0x53, 0x24, 0x41, 0x57, 0x66, 0x74, 0x0d, 0xec, 0x50, 0x62, 0xb4, 0x0f, 0xa1, 0xbc,
+	0x5c, 0x0b, 0x92, 0x0a, 0x5f, 0xbf, 0xe5, 0x3a, 0xec, 0xb2, 0x82, 0x2e, 0xe0, 0x5d, 0x96, 0xe8,
+	0xfa, 0x10, 0x80, 0x45, 0x9f, 0xbc, 0xb3, 0xca, 0x3a, 0x2b, 0x88, 0xf0, 0xee, 0x7b, 0x50, 0x5c,
+	0x18, 0x6f, 0x30, 0xf0, 0xdc, 0x61, 0x5d, 0x85, 0x85, 0xf1, 0xa6, 0xb7, 0x0e, 0xe0, 0xe5, 0xba,
+	0x59, 0x0b, 0xe1, 0xd1, 0x9a, 0x7c, 0x9f, 0x08, 0xe3, 0xeb, 0x2c, 0x8c, 0x7f, 0xc4, 0x16, 0x3a,
+	0xb6, 0x0a, 0x57, 0x05, 0xf1, 0x8f, 0xa0, 0x62, 0x39, 0xd3, 0xd7, 0x3c, 0xca, 0x68, 0x30, 0xe3,
+	0x96, 0x11, 0xc0, 0x3d, 0xde, 0xfa, 0xd7, 0x4c, 0x22, 0xa4, 0xfb, 0x00, 0x2a, 0x3f, 0xb9, 0xa6,
+	0x4f, 0x8d, 0x33, 0x8b, 0xaf, 0x66, 0x59, 0x8d, 0x00, 0xf2, 0x11, 0x80, 0x4b, 0xd1, 0xe8, 0xac,
+	0x3b, 0xcb, 0xba, 0x63, 0x08, 0x69, 0x41, 0x99, 0xbe, 0x59, 0x3a, 0xae, 0x4f, 0xf9, 0x2b, 0xa4,
+	0xac, 0x86, 0x6d, 0xdc, 0x04, 0x4b, 0xc3, 0xf5, 0x4d, 0xc3, 0x12, 0x91, 0x55, 0xd0, 0xc4, 0x31,
+	0xa7, 0xd6, 0xca, 0xf3, 0xa9, 0x4b, 0x67, 0x6c, 0x0b, 0x94, 0xd5, 0x08, 0xc0, 0xe8, 0xde, 0xbb,
+	0x30, 0xb0, 0x8b, 0xc7, 0x4e, 0xa2, 0x25, 0x1f, 0x40, 0xad, 0x67, 0x7a, 0x7e, 0x6f, 0xa2, 0xd2,
+	0xbf, 0x5a, 0x51, 0xcf, 0xc7, 0x55, 0x5f, 0x33, 0x63, 0xe8, 0x73, 0xb4, 0x86, 0xf0, 0xc5, 0xea,
+	0x3a, 0x32, 0x90, 0xfc, 0x3d, 0x54, 0x03, 0x9e, 0xa5, 0x75, 0x49, 0xbe, 0x82, 0x12, 0xef, 0xf5,
+	0x9a, 0x19, 0xb6, 0x77, 0xc8, 0xf6, 0xde, 0x51, 0x03, 0x12, 0xf9, 0xef, 0x33, 0xd0, 0x38, 0x74,
+	0xa9, 0xe1, 0xd3, 0x9b, 0x8c, 0x19, 0x6e, 0x8d, 0x6c, 0xca, 0xd6, 0xc8, 0x25, 0x8f, 0x8f, 0x85,
+	0xe9, 0xba, 0x8e, 0x1b, 0x1e, 0x12, 0xa2, 0x99, 0xb6, 0x07, 0xe4, 0x33, 0xa8, 0x45, 0xba, 0xe0,
+	0x5c, 0x3e, 0x87, 0xfa, 0xd4, 0x59, 0x2c, 0x0c, 0x7b, 0xa6, 0x3b, 0x2b, 0x7f, 0xb9, 0xf2, 0x85,
+	0x2e, 0x35, 0x81, 0x0e, 0x19, 0x48, 0x9e, 0x40, 0x91, 0x2b, 0xc7, 0xf4, 0x49, 0x9f, 0xb1, 0xa0,
+	0x90, 0xff, 0x37, 0x07, 0xf7, 0xf8, 0x20, 0xe3, 0x0b, 0xd3, 0x1e, 0x39, 0x8e, 0x75, 0xb3, 0x69,
+	0xe3, 0x53, 0x2a, 0x98, 0x36, 0xfe, 0x4e, 0x9d, 0xf6, 0x27, 0xb0, 0x23, 0x1e, 0x4f, 0x3a, 0xee,
+	0x3b, 0x31, 0xf7, 0xaa, 0xc0, 0x5e, 0xb8, 0x94, 0x92, 0x4f, 0xa1, 0x16, 0x3e, 0xb4, 0x62, 0xc7,
+	0xe4, 0x4e, 0x00, 0xb2, 0x3d, 0xff, 0x21, 0xc0, 0xf4, 0x62, 0x65, 0xbf, 0x8e, 0x9f, 0x09, 0x15,
+	0x86, 0xb0, 0xee, 0x5f, 0x44, 0x01, 0x72, 0x29, 0xf6, 0x5a, 0x49, 0x9d, 0xde, 0xd3, 0x5f, 0x72,
+	0xca, 0x28, 0x88, 0xfe, 0x01, 0xca, 0x33, 0xd3, 0x9b, 0x1a, 0xee, 0xcc, 0x6b, 0x96, 0x63, 0xb1,
+	0x7f, 0x3a, 0x7b, 0x47, 0x90, 0xaa, 0x21, 0x93, 0xdc, 0x85, 0x92, 0x10, 0x8a, 0x57, 0xe3, 0x2f,
+	0x15, 0x75, 0x88, 0x31, 0x49, 0x47, 0x79, 0xd1, 0x3e, 0xe9, 0xe1, 0x9d, 0x1a, 0x03, 0x95, 0x01,
+	0x5e, 0xc6, 0x18, 0x22, 0xef, 0x81, 0x14, 0x52, 0x76, 0x35, 0x8e, 0x66, 0x65, 0x0a, 0xe5, 0x60,
+	0x00, 0xa4, 0xe8, 0x74, 0xb5, 0xc3, 0xb6, 0xda, 0xd1, 0x62, 0xc2, 0xee, 0xc1, 0x6e, 0x88, 0x8e,
+	0xda, 0x9a, 0xd6, 0x19, 0x9e, 0x0e, 0xa4, 0x0c, 0x79, 0x00, 0x77, 0x43, 0x78, 0x30, 0x0c, 0x3b,
+	0xd8, 0x65, 0x1d, 0x76, 0x74, 0x8f, 0x06, 0x43, 0x55, 0x91, 0x72, 0xf2, 0x05, 0xdc, 0xdd, 0x9c,
+	0xdd, 0x2d, 0xb9, 0xd9, 0x31, 0x34, 0x0e, 0x2f, 0x0c, 0x7b, 0xfe, 0xde, 0xdb, 0x8a, 0x6d, 0x8a,
+	0x50, 0xd2, 0x2d, 0x69, 0xfb, 0xdb, 0x4c, 0xdc, 0x30, 0x37, 0x55, 0x39, 0x6d, 0x4b, 0xb0, 0x69,
+	0xe4, 0x52, 0x4e, 0x87, 0x7c, 0xfa, 0xe9, 0x50, 0x48, 0x3f, 0x1d, 0x8a, 0xb1, 0xd3, 0xe1, 0x1c,
+	0x76, 0x93, 0x3a, 0xde, 0xde, 0xd2, 0xa9, 0x74, 0xe1, 0xac, 0xdf, 0x7f, 0xe9, 0x9e, 0x41, 0x2d,
+	0x92, 0xf4, 0xee, 0xda, 0xca, 0xff, 0x9c, 0x81, 0xfa, 0xa1, 0xe5, 0xd8, 0x31, 0x0d, 0x30, 0xa6,
+	0x72, 0x56, 0xee, 0x94, 0xea, 0xb1, 0x90, 0x04, 0x38, 0x34, 0x40, 0xfb, 0x3e, 0x82, 0xca, 0x8c,
+	0x7a, 0xbe, 0x1e, 0x53, 0xa2, 0x8c, 0xc0, 0x40, 0x04, 0x3f, 0x09, 0xfd, 0x73, 0xdb, 0xfa, 0x3f,
+	0x81, 0x5d, 0xc6, 0x9f, 0xa0, 0xe3, 0x41, 0x4b, 0x03, 0x3b, 0x62, 0x57, 0xb2, 0xfc, 0x6f, 0x78,
+	0x69, 0x70, 0xfd, 0x46, 0xae, 0x33, 0x77, 0xa9, 0xc7, 0x92, 0x6a, 0x67, 0x97, 0x3e, 0xf5, 0xf4,
+	0xa9, 0xb3, 0x34, 0xe9, 0x4c, 0x44, 0x96, 0x55, 0x86, 0x1d, 0x32, 0x08, 0xe7, 0xe0, 0x3b, 0xbe,
+	0x61, 0xe9, 0x0c, 0x14, 0x21, 0x14, 0x30, 0xe8, 0x39, 0x22, 0x78, 0x26, 0x72, 0x19, 0xc1, 0x9b,
+	0x9c, 0x9f, 0xa9, 0x5c, 0xb0, 0x78, 0x8e, 0x93, 0x7d, 0x90, 0x38, 0xd1, 0x92, 0xba, 0xba, 0x47,
+	0xa7, 0x8e, 0x3d, 0x13, 0x4e, 0x55, 0x67, 0xf8, 0x88, 0xba, 0x1a, 0x43, 0x71, 0x49, 0x66, 0x8e,
+	0x4d, 0xc5, 0xed, 0xcb, 0x7e, 0xcb, 0xff, 0x98, 0x09, 0x8e, 0x7f, 0xcd, 0x36, 0x96, 0xde, 0x85,
+	0xe3, 0xdf, 0x60, 0x8d, 0xa3, 0x9c, 0x5c, 0x36, 0x91, 0x93, 0x7b, 0x57, 0x7f, 0x4f, 0xbb, 0xf3,
+	0xc2, 0x23, 0x29, 0xd2, 0xe7, 0x96, 0xfc, 0xfa, 0x47, 0xd8, 0xc3, 0x38, 0x21, 0x18, 0xc7, 0x7b,
+	0xff, 0x89, 0xcb, 0x2f, 0x80, 0x6c, 0x88, 0x44, 0xdd, 0xbf, 0x81, 0x8a, 0x17, 0x20, 0xd7, 0xc4,
+	0x20, 0x11, 0x91, 0xdc, 0x87, 0xbd, 0x3e, 0x75, 0xe7, 0xbf, 0xcf, 0x9a, 0xa4, 0xed, 0xbb, 0x39,
+	0x90, 0x0d, 0x71, 0x37, 0x33, 0x69, 0x6c, 0xae, 0x57, 0x98, 0x54, 0xcc, 0xff, 0x77, 0x39, 0xa8,
+	0x07, 0x57, 0x09, 0xbe, 0xb5, 0x57, 0xde, 0x55, 0x6f, 0x8f, 0xc4, 0x34, 0xb2, 0xa9, 0xd3, 0xd8,
+	0x8a, 0x22, 0xb6, 0x42, 0x84, 0x7c, 0x4a, 0x88, 0xb0, 0x99, 0xd3, 0x2d, 0xbc, 0x5b, 0x4e, 0xb7,
+	0x98, 0x9e, 0xd3, 0x4d, 0x06, 0x1c, 0xa5, 0xcd, 0x80, 0xe3, 0x73, 0xa8, 0xfb, 0xae, 0x61, 0x7b,
+	0x98, 0x24, 0x73, 0x6c, 0xdd, 0x9c, 0x89, 0xd7, 0x45, 0x2d, 0x86, 0x76, 0x67, 0x6c, 0xbe, 0xa6,
+	0xcb, 0xf2, 0xe0, 0x4c, 0x4e, 0x85, 0x9f, 0x05, 0x02, 0x0b, 0xd4, 0xf6, 0x2f, 0x4c, 0x5b, 0x0f,
+	0x42, 0x55, 0xfe, 0xce, 0xa8, 0x22, 0xc6, 0x6d, 0xec, 0xa1, 0xda, 0xce, 0x9a, 0xba, 0xb8, 0x3a,
+	0xa6, 0xaf, 0xbb, 0x98, 0x9a, 0x61, 0x2f, 0x8e, 0x8c, 0xda, 0x88, 0x70, 0x15, 0x61, 0xf2, 0x25,
+	0x14, 0x97, 0x8e, 0x65, 0x4e, 0x2f, 0xd9, 0xbb, 0xa3, 0x7a, 0x70, 0x97, 0xad, 0x59, 0xb0, 0x32,
+	0x23, 0xd6, 0xa5, 0x0a, 0x12, 0xf9, 0x04, 0xea, 0xc9, 0x1e, 0x8c, 0xd5, 0xfd, 0x0b, 0x97, 0x7a,
+	0x17, 0x8e, 0xc5, 0x0f, 0xae, 0x9a, 0x1a, 0x01, 0x38, 0x69, 0xf6, 0xa0, 0x9a, 0x85, 0xc6, 0xcb,
+	0x32, 0x92, 0x1a, 0x47, 0x85, 0xe9, 0xe4, 0xbf, 0x81, 0xa6, 0x46, 0xfd, 0x8d, 0x31, 0xdf, 0xef,
+	0x1e, 0x8d, 0xa6, 0x95, 0x7b, 0xfb, 0xb4, 0x2c, 0xb8, 0x9f, 0x32, 0xfe, 0x0d, 0x1c, 0xff, 0x4b,
+	0x28, 0x7a, 0xcc, 0x87, 0x9b, 0xd9, 0x94, 0xd1, 0xb8, 0x7b, 0xab, 0x82, 0x44, 0xfe, 0x11, 0x9a,
+	0x47, 0xd4, 0xdf, 0xe8, 0x7c, 0xaf, 0xd9, 0xca, 0x0a, 0xdc, 0x4f, 0x11, 0x89, 0x13, 0x88, 0x34,
+	0xcb, 0xbc, 0x5d, 0xb3, 0xbf, 0xc6, 0xeb, 0x1b, 0xdd, 0xee, 0x56, 0x1e, 0x34, 0x1f, 0x02, 0x9c,
+	0xb1, 0x77, 0xa7, 0x63, 0x5b, 0x97, 0xe2, 0xcd, 0x57, 0x61, 0xc8, 0xd0, 0xb6, 0x2e, 0x31, 0x58,
+	0x8b, 0x06, 0xbf, 0xa5, 0x73, 0xbc, 0xc1, 0xdf, 0x88, 0x93, 0x23, 0x31, 0x3d, 0xb9, 0x03, 0xd5,
+	0x00, 0xc0, 0x21, 0xbf, 0x83, 0x5a, 0x7c, 0xb6, 0xc1, 0x11, 0x2c, 0x6d, 0xbe, 0xac, 0xd5, 0x9d,
+	0x98, 0x01, 0xf0, 0xf1, 0x25, 0x1e, 0x82, 0xa1, 0xe0, 0xd4, 0xb3, 0xec, 0x67, 0xd0, 0x58, 0x5e,
+	0x5c, 0x7a, 0xa8, 0x97, 0x1e, 0x53, 0xb9, 0xa2, 0xd6, 0x03, 0x38, 0x2a, 0xf0, 0xb1, 0xcb, 0x2e,
+	0x17, 0xbb, 0xec, 0x5e, 0x43, 0x2d, 0x1a, 0xe3, 0x06, 0xe6, 0xf9, 0x36, 0xe5, 0x00, 0x4d, 0x9b,
+	0x51, 0xe2, 0x5d, 0xfc, 0x79, 0x10, 0xc7, 0x5d, 0x3b, 0xa1, 0x28, 0x48, 0xbb, 0x99, 0x4e, 0xf2,
+	0x00, 0x1a, 0x2c, 0x9f, 0x32, 0xfb, 0xc3, 0xd8, 0x0b, 0x6d, 0x13, 0xc9, 0xbb, 0x6d, 0xdb, 0xfc,
+	0x05, 0x34, 0xda, 0xb3, 0xd9, 0xd8, 0x98, 0xff, 0x21, 0x36, 0xc9, 0xd6, 0x32, 0x9f, 0x41, 0x2d,
+	0x92, 0x7e, 0x4b, 0xbb, 0x40, 0x07, 0xc2, 0x97, 0xed, 0xb6, 0x26, 0x41, 0x41, 0x4a, 0x0c, 0x70,
+	0x4b, 0xf3, 0xf8, 0x59, 0xb0, 0xed, 0x46, 0xe1, 0x24, 0xf6, 0xa0, 0xc0, 0x4e, 0x14, 0x21, 0x9c,
+	0x37, 0xe4, 0x3f, 0x87, 0x5a, 0x44, 0x78, 0x03, 0x65, 0x3e, 0x85, 0xe2, 0x72, 0x6d, 0xda, 0xe7,
+	0x8e, 0x50, 0xa6, 0xca, 0x94, 0x19, 0x4d, 0xba, 0xf6, 0xb9, 0xa3, 0x8a, 0x2e, 0xd4, 0x82, 0x4f,
+	0xf6, 0x6d, 0x5a, 0x84, 0xbb, 0xe5, 0x66, 0x5a, 0x04, 0x87, 0x56, 0x28, 0x5e, 0xfe, 0x63, 0xa8,
+	0x06, 0x00, 0x17, 0x53, 0xe2, 0xaa, 0x04, 0xc7, 0x55, 0x42, 0xcd, 0xa0, 0x4f, 0xfe, 0xcf, 0x1c,
+	0x14, 0x39, 0x76, 0x55, 0x76, 0x96, 0x25, 0x5b, 0xb3, 0xb1, 0x64, 0xab, 0x04, 0xb9, 0xf3, 0x85,
+	0x2f, 0x62, 0x73, 0xfc, 0x99, 0x1a, 0x9a, 0xef, 0x41, 0x61, 0x15, 0x4b, 0xc3, 0x14, 0x56, 0x01,
+	0x7a, 0x1e, 0x4b, 0xbd, 0xf0, 0x06, 0xd6, 0xd7, 0xd7, 0x73, 0xfe, 0xd0, 0x2a, 0xf1, 0x70, 0x78,
+	0x3d, 0x67, 0xcf, 0x2c, 0xcc, 0xa9, 0xd2, 0x44, 0xda, 0xb5, 0xb4, 0xa4, 0x3c, 0x33, 0xfa, 0x19,
+	0xd4, 0x97, 0x54, 0x67, 0x75, 0xd9, 0x58, 0xd2, 0x35, 0xaf, 0xee, 0x2c, 0x29, 0x2b, 0x3c, 0x71,
+	0xaa, 0x67, 0x89, 0x8c, 0x28, 0xb0, 0xf5, 0xba, 0x1f, 0x33, 0xc4, 0x55, 0xc9, 0xd0, 0x87, 0x50,
+	0xc6, 0x0f, 0x0e, 0x98, 0xaa, 0x55, 0x3e, 0xf0, 0x8c, 0xae, 0x35, 0x91, 0x9c, 0x5e, 0xcc, 0x0c,
+	0x31, 0x26, 0xcf, 0xca, 0x96, 0x17, 0x33, 0x23, 0xcc, 0xf4, 0x62, 0x27, 0xe3, 0xab, 0x71, 0xbe,
+	0xc5, 0xcc, 0xd0, 0xe2, 0x6f, 0x95, 0x7a, 0xb4, 0x25, 0x5a, 0xb3, 0x44, 0x56, 0xf5, 0x31, 0x54,
+	0x45, 0x9d, 0x39, 0x96, 0x57, 0x8d, 0x43, 0x89, 0xcc, 0x69, 0x76, 0x3b, 0x73, 0xca, 0x2a, 0x7a,
+	0xf6, 0x5c, 0x24, 0x55, 0x83, 0x26, 0xfa, 0xe2, 0xc4, 0xb0, 0xcc, 0x99, 0xe1, 0xd3, 0xeb, 0x7d,
+	0xf1, 0x27, 0xa8, 0x68, 0xe6, 0xdc, 0x36, 0xfc, 0x95, 0x4b, 0xd9, 0x13, 0xe5, 0xfc, 0xdc, 0xa3,
+	0x41, 0x4d, 0x43, 0xb4, 0xd8, 0x3c, 0x30, 0x45, 0x2c, 0x5c, 0xc2, 0xe7, 0x25, 0xa0, 0xc2, 0xca,
+	0x33, 0xe6, 0xc1, 0x83, 0x8d, 0x37, 0x52, 0x33, 0xf5, 0x7b, 0x50, 0xb0, 0x8c, 0x33, 0x6a, 0x89,
+	0x6f, 0x44, 0x78, 0x43, 0xfe, 0x87, 0x1c, 0xd4, 0x22, 0x15, 0xd1, 0x7d, 0x5b, 0x50, 0x5e, 0x0b,
+	0x40, 0x18, 0x22, 0x6c, 0x63, 0x31, 0xc3, 0xa5, 0x86, 0xc7, 0x3f, 0x0a, 0x88, 0x8a, 0x19, 0x09,
+	0x01, 0x4f, 0x55, 0x46, 0xa1, 0x06, 0x94, 0xad, 0x7f, 0xc9, 0x42, 0x91, 0x63, 0xe4, 0x00, 0xf2,
+	0xaf, 0x4d, 0x7b, 0x26, 0x3e, 0x48, 0xf8, 0xe8, 0x4a, 0xe6, 0xa7, 0x2f, 0x4d, 0x7b, 0xa6, 0x32,
+	0x5a, 0xb4, 0xc6, 0x8c, 0xfa, 0x86, 0x19, 0x44, 0x58, 0xa2, 0x45, 0xbe, 0x82, 0x8a, 0x17, 0x98,
+	0x4c, 0x04, 0x95, 0x75, 0x26, 0x30, 0x34, 0xa4, 0x1a, 0x11, 0xb0, 0x35, 0xa2, 0x1e, 0xb3, 0x14,
+	0x37, 0x4a, 0xd0, 0xc4, 0xb4, 0x71, 0x1e, 0x87, 0xc3, 0xea, 0x94, 0xa8, 0x76, 0xf1, 0xf2, 0xab,
+	0xd6, 0x3d, 0x1a, 0xb4, 0xc7, 0x27, 0xac, 0x3c, 0x7a, 0x17, 0x1a, 0xac, 0x72, 0x85, 0xb5, 0x53,
+	0x51, 0x8d, 0xcb, 0x22, 0x43, 0x7f, 0x78, 0x32, 0xe0, 0x15, 0x52, 0x80, 0xe2, 0xf1, 0xb0, 0xd7,
+	0x51, 0x54, 0x5e, 0xf2, 0xd6, 0x4e, 0xdb, 0x23, 0xa9, 0x10, 0x16, 0xde, 0xfa, 0x4a, 0xff, 0xb9,
+	0xa2, 0x4a, 0x45, 0x26, 0xe8, 0xf8, 0x95, 0xd6, 0x3d, 0x6c, 0xf7, 0xf4, 0xc9, 0xb0, 0x77, 0xd2,
+	0x57, 0xa4, 0x12, 0xd2, 0x0f, 0x47, 0xca, 0x40, 0x2a, 0xcb, 0x3f, 0x40, 0xbd, 0x43, 0x3d, 0xdf,
+	0x71, 0x2f, 0xaf, 0xf5, 0x17, 0xdc, 0xb7, 0x33, 0xf7, 0x52, 0x77, 0x57, 0xb6, 0xf0, 0xc6, 0xe2,
+	0xcc, 0xbd, 0x54, 0x57, 0xb6, 0xfc, 0xb7, 0x19, 0xd8, 0x09, 0x25, 0xdc, 0xe0, 0x68, 0x7d, 0x0a,
+	0x10, 0x1a, 0x2b, 0x58, 0xdc, 0x4d, 0x73, 0xc6, 0x28, 0x58, 0xf0, 0x68, 0x4c, 0x5f, 0xaf, 0x96,
+	0xfa, 0xcc, 0x74, 0x85, 0xf3, 0x55, 0x38, 0xd2, 0x31, 0x5d, 0xf9, 0x1b, 0x68, 0xaa, 0x4c, 0x0b,
+	0x1a, 0xb2, 0x7b, 0xd7, 0xef, 0x00, 0x07, 0xee, 0xa7, 0x70, 0xdc, 0xde, 0x0c, 0xe4, 0xcf, 0x60,
+	0xa7, 0x6f, 0xf8, 0xd3, 0x8b, 0xeb, 0xd5, 0x9a, 0x00, 0x08, 0xaa, 0x1b, 0xa8, 0xf2, 0xf6, 0x47,
+	0xb2, 0xfc, 0x23, 0xd4, 0x8e, 0xa8, 0x3f, 0x9a, 0x0c, 0x56, 0x8b, 0x1b, 0x89, 0x8e, 0xd7, 0xba,
+	0xb2, 0x89, 0x5a, 0x97, 0xfc, 0x9b, 0x0c, 0x3c, 0xc0, 0x7b, 0xe8, 0x39, 0x2a, 0xce, 0xcb, 0x87,
+	0xa1, 0xcd, 0xf1, 0x74, 0x34, 0x45, 0x8d, 0x32, 0x23, 0x4e, 0x47, 0x93, 0x17, 0x28, 0xbf, 0x84,
+	0x5d, 0xfa, 0x66, 0x6a, 0xad, 0x66, 0x54, 0x77, 0xf1, 0x3a, 0x8c, 0x95, 0x8e, 0x24, 0xd1, 0xa1,
+	0x06, 0x38, 0x2e, 0xfb, 0xd2, 0xf0, 0x2f, 0xf4, 0xb9, 0xe5, 0x9c, 0x05, 0x31, 0x46, 0x05, 0x91,
+	0x23, 0x04, 0xe4, 0xff, 0xc9, 0x41, 0x35, 0x36, 0xfc, 0x55, 0x17, 0x1b, 0x32, 0x84, 0xef, 0x25,
+	0xc3, 0xbf, 0x48, 0x7d, 0x9e, 0x04, 0xa7, 0x5d, 0x3e, 0x76, 0xda, 0x61, 0x7d, 0xcb, 0xf1, 0xd9,
+	0x97, 0x0d, 0x86, 0x25, 0x92, 0x61, 0x31, 0x04, 0x5f, 0xbf, 0xd1, 0x1c, 0x78, 0x39, 0x2a, 0x02,
+	0x70, 0x85, 0x17, 0xce, 0x8c, 0x5a, 0xe2, 0xaa, 0xe3, 0x0d, 0x56, 0xbf, 0xa2, 0x2e, 0x96, 0xbd,
+	0xca, 0xfc, 0x7c, 0xe1, 0x2d, 0xbc, 0x6c, 0x7f, 0xfa, 0x29, 0xf8, 0x64, 0x0d, 0x7f, 0xa2, 0x74,
+	0x96, 0x1c, 0xc0, 0x63, 0x5f, 0x7c, 0xac, 0x16, 0x01, 0x2c, 0x3a, 0xc6, 0x82, 0x19, 0x4b, 0x27,
+	0xf0, 0x7b, 0xa4, 0x2a, 0xa2, 0xe3, 0x00, 0x1e, 0x07, 0x45, 0xba, 0x73, 0xd3, 0xa2, 0xde, 0xa5,
+	0xe7, 0xd3, 0x05, 0xbb, 0xc7, 0x2a, 0x6a, 0x0c, 0xc1, 0xcb, 0x68, 0x81, 0x0b, 0xba, 0x74, 0x4c,
+	0xfc, 0xfe, 0xab, 0xc6, 0x8c, 0x1c, 0x87, 0x50, 0xe5, 0xa5, 0xe1, 0xe2, 0xf3, 0x9d, 0x7f, 0xad,
+	0x26, 0x5a, 0x78, 0xc8, 0xe1, 0x33, 0x9f, 0xba, 0xf8, 0xb1, 0x1a, 0x72, 0x05, 0x4d, 0x76, 0xf8,
+	0x9b, 0xf6, 0x6b, 0xaf, 0x29, 0x31, 0x9c, 0x37, 0xd2, 0x02, 0xfa, 0x5d, 0x66, 0xb4, 0xcd, 0x07,
+	0xd0, 0xa6, 0x43, 0x93, 0x6d, 0x87, 0x3e, 0x84, 0x7b, 0xdb, 0xce, 0x87, 0x8e, 0xfd, 0x24, 0x2a,
+	0x80, 0xc7, 0x5f, 0x6f, 0x31, 0xc2, 0xb0, 0xee, 0x2d, 0xff, 0x1c, 0x76, 0x4e, 0xe3, 0x7b, 0xf2,
+	0x0b, 0x90, 0x5c, 0x2a, 0x92, 0xc5, 0x6b, 0xea, 0xe2, 0x47, 0x33, 0xc2, 0x7d, 0x1b, 0x01, 0x3e,
+	0xe1, 0xb0, 0xfc, 0xdf, 0x59, 0x00, 0xc6, 0xab, 0xac, 0xd1, 0x14, 0xfb, 0x89, 0x4f, 0xdf, 0xf6,
+	0xd8, 0x90, 0x51, 0x77, 0xfc, 0x63, 0xb7, 0xb4, 0x31, 0xb2, 0xa9, 0x63, 0x90, 0xef, 0xa1, 0x6e,
+	0x39, 0xf3, 0xb8, 0xb9, 0x72, 0x57, 0x05, 0xc5, 0xc7, 0x77, 0xd4, 0x9a, 0x15, 0x07, 0xc8, 0x77,
+	0x1b, 0x36, 0xcc, 0xa7, 0x3f, 0x6e, 0x8e, 0xef, 0x24, 0x63, 0xfc, 0x67, 0xdb, 0x6b, 0x54, 0xd8,
+	0x0a, 0x7e, 0x8f, 0xef, 0x6c, 0x2d, 0xd9, 0x13, 0xd8, 0x8d, 0x0f, 0xc7, 0x63, 0xbc, 0x22, 0x4f,
+	0x82, 0xc7, 0xe4, 0x63, 0xb0, 0x27, 0x7f, 0x25, 0xbe, 0x0a, 0xab, 0x40, 0xa1, 0xdd, 0xc1, 0x2f,
+	0x31, 0xf8, 0xc7, 0x60, 0xc3, 0x4e, 0xf7, 0x45, 0x97, 0x55, 0xba, 0xaa, 0x50, 0xea, 0x28, 0x3d,
+	0x05, 0x6f, 0xba, 0xec, 0xf3, 0x32, 0x14, 0x9d, 0xb3, 0x5f, 0xd1, 0xa9, 0x7f, 0xf0, 0xbb, 0x3a,
+	0xe4, 0x7a, 0x93, 0x3e, 0xf9, 0x06, 0x8a, 0xbc, 0x6c, 0x4b, 0x84, 0x25, 0xe2, 0x75, 0xdf, 0x96,
+	0x94, 0xc0, 0x96, 0xd6, 0xa5, 0x7c, 0x07, 0x3f, 0x70, 0x0c, 0xca, 0xa3, 0x64, 0x2f, 0x56, 0xaa,
+	0x8b, 0xb8, 0xc8, 0x06, 0xca, 0xf9, 0x8e, 0xa1, 0x9e, 0xac, 0x7a, 0x91, 0xd6, 0xd5, 0x85, 0xbe,
+	0x56, 0x33, 0xb5, 0x8f, 0x4b, 0x7a, 0x0e, 0x3b, 0xf1, 0x12, 0x0c, 0xd9, 0xa4, 0x8d, 0x34, 0xb9,
+	0x9f, 0xd2, 0xc3, 0x65, 0xfc, 0x08, 0xbb, 0x5b, 0x69, 0x1e, 0xc2, 0xbf, 0xde, 0xbd, 0x2a, 0xa3,
+	0xd4, 0x7a, 0x74, 0x55, 0x77, 0x28, 0x72, 0x2b, 0xf5, 0x25, 0x44, 0x5e, 0x95, 0x92, 0x6b, 0x3d,
+	0xba, 0xaa, 0x3b, 0xb2, 0xb5, 0xa8, 0xba, 0x05, 0xb6, 0x4e, 0x96, 0xf3, 0x5a, 0x64, 0x03, 0x0d,
+	0xf9, 0x82, 0x92, 0x8f, 0xe0, 0xdb, 0xa8, 0x25, 0xb5, 0xc8, 0x06, 0xca, 0xf9, 0xfe, 0x04, 0x4a,
+	0xa2, 0xa2, 0x42, 0x78, 0x76, 0x2b, 0x59, 0xff, 0x69, 0xed, 0xc5, 0xc1, 0xa0, 0xe8, 0x22, 0xdf,
+	0xf9, 0x26, 0xc3, 0x47, 0xe4, 0x29, 0xa7, 0x70, 0xc4, 0x44, 0xfa, 0xab, 0x45, 0x36, 0xd0, 0x0d,
+	0xaf, 0x08, 0xb2, 0xe4, 0x09, 0xaf, 0xd8, 0xc8, 0xc4, 0xb7, 0x9a, 0xa9, 0x7d, 0x5c, 0x92, 0xc2,
+	0xdf, 0x76, 0x01, 0xec, 0x91, 0x87, 0xa1, 0xf3, 0x6e, 0x16, 0x1b, 0x5a, 0x0f, 0xd2, 0xba, 0x42,
+	0x31, 0x89, 0xac, 0xbd, 0x10, 0x93, 0x56, 0x18, 0x68, 0x3d, 0x48, 0xeb, 0x0a, 0x57, 0x20, 0x48,
+	0x3e, 0x08, 0x7b, 0x6c, 0x64, 0x3a, 0x5a, 0x64, 0x03, 0xe5, 0x7c, 0x3f, 0x40, 0x35, 0xf6, 0xde,
+	0x27, 0x0f, 0x62, 0xcb, 0x94, 0xe0, 0xbe, 0xb7, 0xdd, 0xc1, 0x05, 0x88, 0x0d, 0x3d, 0x39, 0x8a,
+	0x6d, 0xe8, 0xc9, 0xd1, 0xf6, 0x86, 0x9e, 0x1c, 0xc5, 0x54, 0x0d, 0xd2, 0x61, 0x89, 0x0d, 0x1d,
+	0x71, 0x91, 0x0d, 0x74, 0xc3, 0xc9, 0xde, 0xc2, 0x97, 0xc8, 0x6b, 0xc5, 0xc7, 0x1b, 0x25, 0x0f,
+	0x90, 0x51, 0xea, 0x01, 0x32, 0xda, 0x76, 0xea, 0x51, 0xd2, 0xa9, 0x47, 0xa9, 0x4e, 0x9d, 0xe0,
+	0x0b, 0x52, 0x5a, 0x82, 0x6f, 0x23, 0x63, 0xd6, 0x22, 0x1b, 0x68, 0x6c, 0xbc, 0xd9, 0x6a, 0x4a,
+	0x6f, 0xc8, 0x27, 0x56, 0x60, 0x14, 0x3f, 0x52, 0x47, 0x29, 0x47, 0x6a, 0xa4, 0xe1, 0xd7, 0x50,
+	0x60, 0x91, 0x2a, 0xd9, 0xe5, 0x0e, 0x15, 0xbb, 0x47, 0x5b, 0x8d, 0x38, 0x14, 0x2a, 0x16, 0x04,
+	0xa0, 0xd7, 0x1a, 0x3e, 0x11, 0xa5, 0x72, 0xbe, 0xe0, 0xc5, 0x26, 0xf8, 0x36, 0x5e, 0xb8, 0x2d,
+	0xb2, 0x81, 0x72, 0xbe, 0x6f, 0xa1, 0x24, 0xde, 0x25, 0xe2, 0x54, 0x48, 0xbe, 0x73, 0x5a, 0xbb,
+	0x49, 0x30, 0x3c, 0x0d, 0xb7, 0x1e, 0x05, 0xe2, 0x34, 0xbc, 0xea, 0x79, 0xd1, 0x7a, 0x74, 0x55,
+	0x37, 0x17, 0x39, 0x00, 0x69, 0x33, 0x4e, 0x21, 0x1f, 0x84, 0xe6, 0x4c, 0x89, 0x9d, 0x5b, 0xad,
+	0x2b, 0x7a, 0xb9, 0xbc, 0x3f, 0x82, 0xc2, 0x69, 0xcc, 0xec, 0xa7, 0xdb, 0x66, 0x8f, 0xc2, 0x0e,
+	0x3c, 0xe4, 0xce, 0x8a, 0xec, 0xbf, 0x24, 0xdf, 0xfe, 0xff, 0x00, 0x0a, 0x86, 0x72, 0xc8, 0x58,
+	0x32, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -2621,10 +5035,15 @@ type LVMClient interface {
 	CreateLV(ctx context.Context, in *CreateLVRequest, opts ...grpc.CallOption) (*CreateLVReply, error)
 	CreateThinPool(ctx context.Context, in *CreateThinPoolRequest, opts ...grpc.CallOption) (*CreateThinPoolReply, error)
 	CreateThinLV(ctx context.Context, in *CreateThinLVRequest, opts ...grpc.CallOption) (*CreateThinLVReply, error)
+	GetThinPoolStatus(ctx context.Context, in *GetThinPoolStatusRequest, opts ...grpc.CallOption) (*GetThinPoolStatusReply, error)
+	SetThinPoolPolicy(ctx context.Context, in *SetThinPoolPolicyRequest, opts ...grpc.CallOption) (*SetThinPoolPolicyReply, error)
 	ChangeLV(ctx context.Context, in *ChangeLVRequest, opts ...grpc.CallOption) (*ChangeLVReply, error)
 	RemoveLV(ctx context.Context, in *RemoveLVRequest, opts ...grpc.CallOption) (*RemoveLVReply, error)
-	CloneLV(ctx context.Context, in *CloneLVRequest, opts ...grpc.CallOption) (*CloneLVReply, error)
+	CloneLV(ctx context.Context, in *CloneLVRequest, opts ...grpc.CallOption) (LVM_CloneLVClient, error)
 	ResizeLV(ctx context.Context, in *ResizeLVRequest, opts ...grpc.CallOption) (*ResizeLVReply, error)
+	CreateSnapshot(ctx context.Context, in *CreateSnapshotRequest, opts ...grpc.CallOption) (*CreateSnapshotReply, error)
+	ListSnapshots(ctx context.Context, in *ListSnapshotsRequest, opts ...grpc.CallOption) (*ListSnapshotsReply, error)
+	MergeSnapshot(ctx context.Context, in *MergeSnapshotRequest, opts ...grpc.CallOption) (*MergeSnapshotReply, error)
 	AddTagLV(ctx context.Context, in *AddTagLVRequest, opts ...grpc.CallOption) (*AddTagLVReply, error)
 	RemoveTagLV(ctx context.Context, in *RemoveTagLVRequest, opts ...grpc.CallOption) (*RemoveTagLVReply, error)
 	ListVG(ctx context.Context, in *ListVGRequest, opts ...grpc.CallOption) (*ListVGReply, error)
@@ -2639,6 +5058,9 @@ type LVMClient interface {
 	GetPVNum(ctx context.Context, in *CreateVGRequest, opts ...grpc.CallOption) (*GetPVNumReply, error)
 	Validate(ctx context.Context, in *ValidateRequest, opts ...grpc.CallOption) (*ValidateReply, error)
 	Destory(ctx context.Context, in *DestoryRequest, opts ...grpc.CallOption) (*DestoryReply, error)
+	RestoreSignatures(ctx context.Context, in *RestoreSignaturesRequest, opts ...grpc.CallOption) (*RestoreSignaturesReply, error)
+	ListBlockDevices(ctx context.Context, in *ListBlockDevicesRequest, opts ...grpc.CallOption) (*ListBlockDevicesReply, error)
+	Watch(ctx context.Context, in *WatchRequest, opts ...grpc.CallOption) (LVM_WatchClient, error)
 }
 
 type lVMClient struct {
@@ -2685,6 +5107,24 @@ func (c *lVMClient) CreateThinLV(ctx context.Context, in *CreateThinLVRequest, o
 	return out, nil
 }
 
+func (c *lVMClient) GetThinPoolStatus(ctx context.Context, in *GetThinPoolStatusRequest, opts ...grpc.CallOption) (*GetThinPoolStatusReply, error) {
+	out := new(GetThinPoolStatusReply)
+	err := c.cc.Invoke(ctx, "/lvm.LVM/GetThinPoolStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lVMClient) SetThinPoolPolicy(ctx context.Context, in *SetThinPoolPolicyRequest, opts ...grpc.CallOption) (*SetThinPoolPolicyReply, error) {
+	out := new(SetThinPoolPolicyReply)
+	err := c.cc.Invoke(ctx, "/lvm.LVM/SetThinPoolPolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *lVMClient) ChangeLV(ctx context.Context, in *ChangeLVRequest, opts ...grpc.CallOption) (*ChangeLVReply, error) {
 	out := new(ChangeLVReply)
 	err := c.cc.Invoke(ctx, "/lvm.LVM/ChangeLV", in, out, opts...)
@@ -2703,13 +5143,36 @@ func (c *lVMClient) RemoveLV(ctx context.Context, in *RemoveLVRequest, opts ...g
 	return out, nil
 }
 
-func (c *lVMClient) CloneLV(ctx context.Context, in *CloneLVRequest, opts ...grpc.CallOption) (*CloneLVReply, error) {
-	out := new(CloneLVReply)
-	err := c.cc.Invoke(ctx, "/lvm.LVM/CloneLV", in, out, opts...)
+func (c *lVMClient) CloneLV(ctx context.Context, in *CloneLVRequest, opts ...grpc.CallOption) (LVM_CloneLVClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_LVM_serviceDesc.Streams[0], "/lvm.LVM/CloneLV", opts...)
 	if err != nil {
 		return nil, err
 	}
-	return out, nil
+	x := &lVMCloneLVClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type LVM_CloneLVClient interface {
+	Recv() (*CloneLVProgress, error)
+	grpc.ClientStream
+}
+
+type lVMCloneLVClient struct {
+	grpc.ClientStream
+}
+
+func (x *lVMCloneLVClient) Recv() (*CloneLVProgress, error) {
+	m := new(CloneLVProgress)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
 }
 
 func (c *lVMClient) ResizeLV(ctx context.Context, in *ResizeLVRequest, opts ...grpc.CallOption) (*ResizeLVReply, error) {
@@ -2721,6 +5184,33 @@ func (c *lVMClient) ResizeLV(ctx context.Context, in *ResizeLVRequest, opts ...g
 	return out, nil
 }
 
+func (c *lVMClient) CreateSnapshot(ctx context.Context, in *CreateSnapshotRequest, opts ...grpc.CallOption) (*CreateSnapshotReply, error) {
+	out := new(CreateSnapshotReply)
+	err := c.cc.Invoke(ctx, "/lvm.LVM/CreateSnapshot", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lVMClient) ListSnapshots(ctx context.Context, in *ListSnapshotsRequest, opts ...grpc.CallOption) (*ListSnapshotsReply, error) {
+	out := new(ListSnapshotsReply)
+	err := c.cc.Invoke(ctx, "/lvm.LVM/ListSnapshots", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lVMClient) MergeSnapshot(ctx context.Context, in *MergeSnapshotRequest, opts ...grpc.CallOption) (*MergeSnapshotReply, error) {
+	out := new(MergeSnapshotReply)
+	err := c.cc.Invoke(ctx, "/lvm.LVM/MergeSnapshot", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *lVMClient) AddTagLV(ctx context.Context, in *AddTagLVRequest, opts ...grpc.CallOption) (*AddTagLVReply, error) {
 	out := new(AddTagLVReply)
 	err := c.cc.Invoke(ctx, "/lvm.LVM/AddTagLV", in, out, opts...)
@@ -2847,16 +5337,71 @@ func (c *lVMClient) Destory(ctx context.Context, in *DestoryRequest, opts ...grp
 	return out, nil
 }
 
+func (c *lVMClient) RestoreSignatures(ctx context.Context, in *RestoreSignaturesRequest, opts ...grpc.CallOption) (*RestoreSignaturesReply, error) {
+	out := new(RestoreSignaturesReply)
+	err := c.cc.Invoke(ctx, "/lvm.LVM/RestoreSignatures", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lVMClient) ListBlockDevices(ctx context.Context, in *ListBlockDevicesRequest, opts ...grpc.CallOption) (*ListBlockDevicesReply, error) {
+	out := new(ListBlockDevicesReply)
+	err := c.cc.Invoke(ctx, "/lvm.LVM/ListBlockDevices", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lVMClient) Watch(ctx context.Context, in *WatchRequest, opts ...grpc.CallOption) (LVM_WatchClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_LVM_serviceDesc.Streams[1], "/lvm.LVM/Watch", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &lVMWatchClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type LVM_WatchClient interface {
+	Recv() (*WatchEvent, error)
+	grpc.ClientStream
+}
+
+type lVMWatchClient struct {
+	grpc.ClientStream
+}
+
+func (x *lVMWatchClient) Recv() (*WatchEvent, error) {
+	m := new(WatchEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // LVMServer is the server API for LVM service.
 type LVMServer interface {
 	ListLV(context.Context, *ListLVRequest) (*ListLVReply, error)
 	CreateLV(context.Context, *CreateLVRequest) (*CreateLVReply, error)
 	CreateThinPool(context.Context, *CreateThinPoolRequest) (*CreateThinPoolReply, error)
 	CreateThinLV(context.Context, *CreateThinLVRequest) (*CreateThinLVReply, error)
+	GetThinPoolStatus(context.Context, *GetThinPoolStatusRequest) (*GetThinPoolStatusReply, error)
+	SetThinPoolPolicy(context.Context, *SetThinPoolPolicyRequest) (*SetThinPoolPolicyReply, error)
 	ChangeLV(context.Context, *ChangeLVRequest) (*ChangeLVReply, error)
 	RemoveLV(context.Context, *RemoveLVRequest) (*RemoveLVReply, error)
-	CloneLV(context.Context, *CloneLVRequest) (*CloneLVReply, error)
+	CloneLV(*CloneLVRequest, LVM_CloneLVServer) error
 	ResizeLV(context.Context, *ResizeLVRequest) (*ResizeLVReply, error)
+	CreateSnapshot(context.Context, *CreateSnapshotRequest) (*CreateSnapshotReply, error)
+	ListSnapshots(context.Context, *ListSnapshotsRequest) (*ListSnapshotsReply, error)
+	MergeSnapshot(context.Context, *MergeSnapshotRequest) (*MergeSnapshotReply, error)
 	AddTagLV(context.Context, *AddTagLVRequest) (*AddTagLVReply, error)
 	RemoveTagLV(context.Context, *RemoveTagLVRequest) (*RemoveTagLVReply, error)
 	ListVG(context.Context, *ListVGRequest) (*ListVGReply, error)
@@ -2871,6 +5416,9 @@ type LVMServer interface {
 	GetPVNum(context.Context, *CreateVGRequest) (*GetPVNumReply, error)
 	Validate(context.Context, *ValidateRequest) (*ValidateReply, error)
 	Destory(context.Context, *DestoryRequest) (*DestoryReply, error)
+	RestoreSignatures(context.Context, *RestoreSignaturesRequest) (*RestoreSignaturesReply, error)
+	ListBlockDevices(context.Context, *ListBlockDevicesRequest) (*ListBlockDevicesReply, error)
+	Watch(*WatchRequest, LVM_WatchServer) error
 }
 
 // UnimplementedLVMServer can be embedded to have forward compatible implementations.
@@ -2889,18 +5437,33 @@ func (*UnimplementedLVMServer) CreateThinPool(ctx context.Context, req *CreateTh
 func (*UnimplementedLVMServer) CreateThinLV(ctx context.Context, req *CreateThinLVRequest) (*CreateThinLVReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateThinLV not implemented")
 }
+func (*UnimplementedLVMServer) GetThinPoolStatus(ctx context.Context, req *GetThinPoolStatusRequest) (*GetThinPoolStatusReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetThinPoolStatus not implemented")
+}
+func (*UnimplementedLVMServer) SetThinPoolPolicy(ctx context.Context, req *SetThinPoolPolicyRequest) (*SetThinPoolPolicyReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetThinPoolPolicy not implemented")
+}
 func (*UnimplementedLVMServer) ChangeLV(ctx context.Context, req *ChangeLVRequest) (*ChangeLVReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ChangeLV not implemented")
 }
 func (*UnimplementedLVMServer) RemoveLV(ctx context.Context, req *RemoveLVRequest) (*RemoveLVReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RemoveLV not implemented")
 }
-func (*UnimplementedLVMServer) CloneLV(ctx context.Context, req *CloneLVRequest) (*CloneLVReply, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CloneLV not implemented")
+func (*UnimplementedLVMServer) CloneLV(req *CloneLVRequest, srv LVM_CloneLVServer) error {
+	return status.Errorf(codes.Unimplemented, "method CloneLV not implemented")
 }
 func (*UnimplementedLVMServer) ResizeLV(ctx context.Context, req *ResizeLVRequest) (*ResizeLVReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ResizeLV not implemented")
 }
+func (*UnimplementedLVMServer) CreateSnapshot(ctx context.Context, req *CreateSnapshotRequest) (*CreateSnapshotReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateSnapshot not implemented")
+}
+func (*UnimplementedLVMServer) ListSnapshots(ctx context.Context, req *ListSnapshotsRequest) (*ListSnapshotsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSnapshots not implemented")
+}
+func (*UnimplementedLVMServer) MergeSnapshot(ctx context.Context, req *MergeSnapshotRequest) (*MergeSnapshotReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MergeSnapshot not implemented")
+}
 func (*UnimplementedLVMServer) AddTagLV(ctx context.Context, req *AddTagLVRequest) (*AddTagLVReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AddTagLV not implemented")
 }
@@ -2943,6 +5506,15 @@ func (*UnimplementedLVMServer) Validate(ctx context.Context, req *ValidateReques
 func (*UnimplementedLVMServer) Destory(ctx context.Context, req *DestoryRequest) (*DestoryReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Destory not implemented")
 }
+func (*UnimplementedLVMServer) RestoreSignatures(ctx context.Context, req *RestoreSignaturesRequest) (*RestoreSignaturesReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreSignatures not implemented")
+}
+func (*UnimplementedLVMServer) ListBlockDevices(ctx context.Context, req *ListBlockDevicesRequest) (*ListBlockDevicesReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListBlockDevices not implemented")
+}
+func (*UnimplementedLVMServer) Watch(req *WatchRequest, srv LVM_WatchServer) error {
+	return status.Errorf(codes.Unimplemented, "method Watch not implemented")
+}
 
 func RegisterLVMServer(s *grpc.Server, srv LVMServer) {
 	s.RegisterService(&_LVM_serviceDesc, srv)
@@ -3020,6 +5592,42 @@ func _LVM_CreateThinLV_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _LVM_GetThinPoolStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetThinPoolStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LVMServer).GetThinPoolStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lvm.LVM/GetThinPoolStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LVMServer).GetThinPoolStatus(ctx, req.(*GetThinPoolStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LVM_SetThinPoolPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetThinPoolPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LVMServer).SetThinPoolPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lvm.LVM/SetThinPoolPolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LVMServer).SetThinPoolPolicy(ctx, req.(*SetThinPoolPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _LVM_ChangeLV_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ChangeLVRequest)
 	if err := dec(in); err != nil {
@@ -3056,38 +5664,95 @@ func _LVM_RemoveLV_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
-func _LVM_CloneLV_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CloneLVRequest)
+func _LVM_CloneLV_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(CloneLVRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(LVMServer).CloneLV(m, &lVMCloneLVServer{stream})
+}
+
+type LVM_CloneLVServer interface {
+	Send(*CloneLVProgress) error
+	grpc.ServerStream
+}
+
+type lVMCloneLVServer struct {
+	grpc.ServerStream
+}
+
+func (x *lVMCloneLVServer) Send(m *CloneLVProgress) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _LVM_ResizeLV_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResizeLVRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LVMServer).ResizeLV(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lvm.LVM/ResizeLV",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LVMServer).ResizeLV(ctx, req.(*ResizeLVRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LVM_CreateSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateSnapshotRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(LVMServer).CloneLV(ctx, in)
+		return srv.(LVMServer).CreateSnapshot(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/lvm.LVM/CloneLV",
+		FullMethod: "/lvm.LVM/CreateSnapshot",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LVMServer).CloneLV(ctx, req.(*CloneLVRequest))
+		return srv.(LVMServer).CreateSnapshot(ctx, req.(*CreateSnapshotRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _LVM_ResizeLV_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ResizeLVRequest)
+func _LVM_ListSnapshots_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSnapshotsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(LVMServer).ResizeLV(ctx, in)
+		return srv.(LVMServer).ListSnapshots(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/lvm.LVM/ResizeLV",
+		FullMethod: "/lvm.LVM/ListSnapshots",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LVMServer).ResizeLV(ctx, req.(*ResizeLVRequest))
+		return srv.(LVMServer).ListSnapshots(ctx, req.(*ListSnapshotsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LVM_MergeSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MergeSnapshotRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LVMServer).MergeSnapshot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lvm.LVM/MergeSnapshot",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LVMServer).MergeSnapshot(ctx, req.(*MergeSnapshotRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -3344,6 +6009,63 @@ func _LVM_Destory_Handler(srv interface{}, ctx context.Context, dec func(interfa
 	return interceptor(ctx, in, info, handler)
 }
 
+func _LVM_RestoreSignatures_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreSignaturesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LVMServer).RestoreSignatures(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lvm.LVM/RestoreSignatures",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LVMServer).RestoreSignatures(ctx, req.(*RestoreSignaturesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LVM_ListBlockDevices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListBlockDevicesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LVMServer).ListBlockDevices(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lvm.LVM/ListBlockDevices",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LVMServer).ListBlockDevices(ctx, req.(*ListBlockDevicesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LVM_Watch_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(LVMServer).Watch(m, &lVMWatchServer{stream})
+}
+
+type LVM_WatchServer interface {
+	Send(*WatchEvent) error
+	grpc.ServerStream
+}
+
+type lVMWatchServer struct {
+	grpc.ServerStream
+}
+
+func (x *lVMWatchServer) Send(m *WatchEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 var _LVM_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "lvm.LVM",
 	HandlerType: (*LVMServer)(nil),
@@ -3364,6 +6086,14 @@ var _LVM_serviceDesc = grpc.ServiceDesc{
 			MethodName: "CreateThinLV",
 			Handler:    _LVM_CreateThinLV_Handler,
 		},
+		{
+			MethodName: "GetThinPoolStatus",
+			Handler:    _LVM_GetThinPoolStatus_Handler,
+		},
+		{
+			MethodName: "SetThinPoolPolicy",
+			Handler:    _LVM_SetThinPoolPolicy_Handler,
+		},
 		{
 			MethodName: "ChangeLV",
 			Handler:    _LVM_ChangeLV_Handler,
@@ -3372,14 +6102,22 @@ var _LVM_serviceDesc = grpc.ServiceDesc{
 			MethodName: "RemoveLV",
 			Handler:    _LVM_RemoveLV_Handler,
 		},
-		{
-			MethodName: "CloneLV",
-			Handler:    _LVM_CloneLV_Handler,
-		},
 		{
 			MethodName: "ResizeLV",
 			Handler:    _LVM_ResizeLV_Handler,
 		},
+		{
+			MethodName: "CreateSnapshot",
+			Handler:    _LVM_CreateSnapshot_Handler,
+		},
+		{
+			MethodName: "ListSnapshots",
+			Handler:    _LVM_ListSnapshots_Handler,
+		},
+		{
+			MethodName: "MergeSnapshot",
+			Handler:    _LVM_MergeSnapshot_Handler,
+		},
 		{
 			MethodName: "AddTagLV",
 			Handler:    _LVM_AddTagLV_Handler,
@@ -3436,7 +6174,26 @@ var _LVM_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Destory",
 			Handler:    _LVM_Destory_Handler,
 		},
+		{
+			MethodName: "RestoreSignatures",
+			Handler:    _LVM_RestoreSignatures_Handler,
+		},
+		{
+			MethodName: "ListBlockDevices",
+			Handler:    _LVM_ListBlockDevices_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "CloneLV",
+			Handler:       _LVM_CloneLV_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "Watch",
+			Handler:       _LVM_Watch_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "lvm.proto",
 }
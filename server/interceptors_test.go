@@ -0,0 +1,46 @@
+package server
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+)
+
+var _ = Describe("Interceptors", func() {
+	It("should run chained unary interceptors in order", func() {
+		var calls []string
+		interceptor := func(name string) grpc.UnaryServerInterceptor {
+			return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+				calls = append(calls, name+":"+info.FullMethod)
+				return handler(ctx, req)
+			}
+		}
+		chain := ChainUnaryInterceptors(interceptor("first"), interceptor("second"))
+		resp, err := chain(context.Background(), "req", &grpc.UnaryServerInfo{FullMethod: "/lvm.LVM/ListVG"},
+			func(ctx context.Context, req interface{}) (interface{}, error) {
+				calls = append(calls, "handler")
+				return req, nil
+			})
+		Expect(err).To(BeNil())
+		Expect(resp).To(Equal("req"))
+		Expect(calls).To(Equal([]string{"first:/lvm.LVM/ListVG", "second:/lvm.LVM/ListVG", "handler"}))
+	})
+
+	It("should run chained stream interceptors in order", func() {
+		var calls []string
+		interceptor := func(name string) grpc.StreamServerInterceptor {
+			return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+				calls = append(calls, name)
+				return handler(srv, ss)
+			}
+		}
+		chain := ChainStreamInterceptors(interceptor("first"), interceptor("second"))
+		err := chain(nil, nil, &grpc.StreamServerInfo{}, func(srv interface{}, ss grpc.ServerStream) error {
+			calls = append(calls, "handler")
+			return nil
+		})
+		Expect(err).To(BeNil())
+		Expect(calls).To(Equal([]string{"first", "second", "handler"}))
+	})
+})
@@ -0,0 +1,322 @@
+package server
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/zdnscloud/cement/log"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/zdnscloud/lvmd/commands"
+	pb "github.com/zdnscloud/lvmd/proto"
+)
+
+// historySize is the number of events kept for watchers to resume from
+const historySize = 1024
+
+// Kinds of objects in the inventory, in the order they are added
+const (
+	kindPV = iota
+	kindVG
+	kindLV
+)
+
+type inventoryKey struct {
+	kind int
+	vg   string
+	name string
+}
+
+// inventoryObject is an object of the inventory with the volume group of
+// logical volumes
+type inventoryObject struct {
+	vg     string
+	object proto.Message
+}
+
+// inventory tracks the physical volumes, volume groups and logical volumes
+// for Watch. Every change found by a rescan is recorded as an event with the
+// next resource version. Versions start at the time lvmd starts, so the
+// versions of a previous run are never mistaken for current ones
+type inventory struct {
+	// scanLock serializes rescans, lock guards the fields below
+	scanLock sync.Mutex
+	lock     sync.Mutex
+	scanned  bool
+	objects  map[inventoryKey]inventoryObject
+	version  uint64
+	// base is the version before the oldest event in history, watchers
+	// can resume from any version between base and version
+	base        uint64
+	history     []*pb.WatchEvent
+	subscribers map[chan struct{}]struct{}
+	// triggers requests a rescan from run
+	triggers chan struct{}
+}
+
+// RunInventory keeps the inventory streamed by Watch up to date until ctx
+// is done. It rescans after the changes made through the server, every
+// interval and, with udev, on the udev events of block devices
+func (s Server) RunInventory(ctx context.Context, interval time.Duration, udev bool) {
+	if udev {
+		go func() {
+			if err := commands.MonitorUdev(ctx, s.inventory.trigger); err != nil && ctx.Err() == nil {
+				log.Warnf("monitor udev events failed, rely on periodic rescans:%s", err.Error())
+			}
+		}()
+	}
+	s.inventory.run(ctx, interval)
+}
+
+func (s Server) Watch(in *pb.WatchRequest, stream pb.LVM_WatchServer) error {
+	return s.inventory.watch(stream.Context(), in.ResourceVersion, stream.Send)
+}
+
+func newInventory() *inventory {
+	version := uint64(time.Now().UnixNano())
+	return &inventory{
+		objects:     make(map[inventoryKey]inventoryObject),
+		version:     version,
+		base:        version,
+		subscribers: make(map[chan struct{}]struct{}),
+		triggers:    make(chan struct{}, 1),
+	}
+}
+
+// trigger requests a rescan without waiting for it
+func (inv *inventory) trigger() {
+	select {
+	case inv.triggers <- struct{}{}:
+	default:
+	}
+}
+
+// run rescans on triggers and every interval until ctx is done
+func (inv *inventory) run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		if err := inv.rescan(ctx); err != nil && ctx.Err() == nil {
+			log.Warnf("rescan inventory failed:%s", err.Error())
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		case <-inv.triggers:
+		}
+	}
+}
+
+func (inv *inventory) list(ctx context.Context) (map[inventoryKey]inventoryObject, error) {
+	objects := make(map[inventoryKey]inventoryObject)
+	pvs, err := commands.ListPV(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, pv := range pvs {
+		if pv.Name != "" {
+			objects[inventoryKey{kind: kindPV, name: pv.Name}] = inventoryObject{object: pv.ToProto()}
+		}
+	}
+	vgs, err := commands.ListVG(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, vg := range vgs {
+		if vg.Name == "" {
+			continue
+		}
+		objects[inventoryKey{kind: kindVG, name: vg.Name}] = inventoryObject{object: vg.ToProto()}
+		lvs, err := commands.ListLV(ctx, vg.Name)
+		if err != nil {
+			return nil, err
+		}
+		for _, lv := range lvs {
+			if lv.Name != "" {
+				objects[inventoryKey{kind: kindLV, vg: vg.Name, name: lv.Name}] = inventoryObject{vg: vg.Name, object: lv.ToProto()}
+			}
+		}
+	}
+	return objects, nil
+}
+
+// rescan lists the inventory and records its changes since the last rescan
+func (inv *inventory) rescan(ctx context.Context) error {
+	inv.scanLock.Lock()
+	defer inv.scanLock.Unlock()
+	objects, err := inv.list(ctx)
+	if err != nil {
+		return err
+	}
+
+	inv.lock.Lock()
+	defer inv.lock.Unlock()
+	var changed, deleted []inventoryKey
+	for key, obj := range objects {
+		if old, ok := inv.objects[key]; !ok || !proto.Equal(old.object, obj.object) {
+			changed = append(changed, key)
+		}
+	}
+	for key := range inv.objects {
+		if _, ok := objects[key]; !ok {
+			deleted = append(deleted, key)
+		}
+	}
+	// objects are added before the objects in them and deleted after them
+	sortKeys(changed, false)
+	sortKeys(deleted, true)
+	for _, key := range changed {
+		typ := pb.WatchEvent_MODIFIED
+		if _, ok := inv.objects[key]; !ok {
+			typ = pb.WatchEvent_ADDED
+		}
+		inv.record(newWatchEvent(typ, objects[key]))
+	}
+	for _, key := range deleted {
+		inv.record(newWatchEvent(pb.WatchEvent_DELETED, inv.objects[key]))
+	}
+	inv.objects = objects
+	inv.scanned = true
+
+	if len(changed)+len(deleted) != 0 {
+		for ch := range inv.subscribers {
+			select {
+			case ch <- struct{}{}:
+			default:
+			}
+		}
+	}
+	return nil
+}
+
+func sortKeys(keys []inventoryKey, reverse bool) {
+	sort.Slice(keys, func(i, j int) bool {
+		a, b := keys[i], keys[j]
+		if reverse {
+			a, b = b, a
+		}
+		if a.kind != b.kind {
+			return a.kind < b.kind
+		}
+		if a.vg != b.vg {
+			return a.vg < b.vg
+		}
+		return a.name < b.name
+	})
+}
+
+// record appends event to the history with the next version, inv.lock must
+// be held
+func (inv *inventory) record(event *pb.WatchEvent) {
+	inv.version++
+	event.ResourceVersion = inv.version
+	inv.history = append(inv.history, event)
+	if len(inv.history) > historySize {
+		dropped := len(inv.history) - historySize
+		inv.base = inv.history[dropped-1].ResourceVersion
+		inv.history = append([]*pb.WatchEvent(nil), inv.history[dropped:]...)
+	}
+}
+
+func newWatchEvent(typ pb.WatchEvent_Type, obj inventoryObject) *pb.WatchEvent {
+	event := &pb.WatchEvent{Type: typ, VolumeGroupName: obj.vg}
+	switch o := obj.object.(type) {
+	case *pb.LogicalVolume:
+		event.Object = &pb.WatchEvent_LogicalVolume{LogicalVolume: o}
+	case *pb.VolumeGroup:
+		event.Object = &pb.WatchEvent_VolumeGroup{VolumeGroup: o}
+	case *pb.PVInfo:
+		event.Object = &pb.WatchEvent_PhysicalVolume{PhysicalVolume: o}
+	}
+	return event
+}
+
+// watch sends the events after version until ctx is done, version 0 sends
+// the current inventory as ADDED events first. A watcher which falls behind
+// the history fails like one resuming from a version which is too old
+func (inv *inventory) watch(ctx context.Context, version uint64, send func(*pb.WatchEvent) error) error {
+	notify := make(chan struct{}, 1)
+	inv.lock.Lock()
+	inv.subscribers[notify] = struct{}{}
+	inv.lock.Unlock()
+	defer func() {
+		inv.lock.Lock()
+		delete(inv.subscribers, notify)
+		inv.lock.Unlock()
+	}()
+
+	if version == 0 {
+		var err error
+		if version, err = inv.sendCurrent(ctx, send); err != nil {
+			return err
+		}
+	}
+	for {
+		events, err := inv.since(version)
+		if err != nil {
+			return err
+		}
+		for _, event := range events {
+			if err := send(event); err != nil {
+				return err
+			}
+			version = event.ResourceVersion
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-notify:
+		}
+	}
+}
+
+// sendCurrent sends the current inventory and returns its version
+func (inv *inventory) sendCurrent(ctx context.Context, send func(*pb.WatchEvent) error) (uint64, error) {
+	inv.lock.Lock()
+	scanned := inv.scanned
+	inv.lock.Unlock()
+	if !scanned {
+		if err := inv.rescan(ctx); err != nil {
+			return 0, statusError(err, "failed to list inventory")
+		}
+	}
+
+	inv.lock.Lock()
+	version := inv.version
+	keys := make([]inventoryKey, 0, len(inv.objects))
+	for key := range inv.objects {
+		keys = append(keys, key)
+	}
+	sortKeys(keys, false)
+	events := make([]*pb.WatchEvent, len(keys))
+	for i, key := range keys {
+		events[i] = newWatchEvent(pb.WatchEvent_ADDED, inv.objects[key])
+		events[i].ResourceVersion = version
+	}
+	inv.lock.Unlock()
+
+	for _, event := range events {
+		if err := send(event); err != nil {
+			return 0, err
+		}
+	}
+	return version, nil
+}
+
+// since returns the events after version
+func (inv *inventory) since(version uint64) ([]*pb.WatchEvent, error) {
+	inv.lock.Lock()
+	defer inv.lock.Unlock()
+	if version < inv.base || version > inv.version {
+		return nil, status.Errorf(codes.OutOfRange, "resource version %d is too old or unknown, watch from 0", version)
+	}
+	start := sort.Search(len(inv.history), func(i int) bool {
+		return inv.history[i].ResourceVersion > version
+	})
+	return inv.history[start:], nil
+}
@@ -0,0 +1,151 @@
+package server
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/zdnscloud/lvmd/commands"
+	"github.com/zdnscloud/lvmd/commands/fake"
+	pb "github.com/zdnscloud/lvmd/proto"
+)
+
+var _ = Describe("Inventory", func() {
+	var svr Server
+	var ctx context.Context
+	var cancel context.CancelFunc
+
+	BeforeEach(func() {
+		lvm := fake.NewLVM()
+		lvm.AddBlock("/dev/sdb", 10*gib)
+		commands.SetExecutor(lvm)
+		svr = NewServer()
+		ctx, cancel = context.WithCancel(context.Background())
+
+		_, err := svr.CreateVG(ctx, &pb.CreateVGRequest{Name: "k8s", PhysicalVolume: "/dev/sdb"})
+		Expect(err).To(BeNil())
+		_, err = svr.CreateLV(ctx, &pb.CreateLVRequest{VolumeGroup: "k8s", Name: "data", Size: gib})
+		Expect(err).To(BeNil())
+	})
+
+	AfterEach(func() {
+		cancel()
+	})
+
+	// watch streams the events from version into the returned channel
+	watch := func(version uint64) (<-chan *pb.WatchEvent, <-chan error) {
+		events := make(chan *pb.WatchEvent, 100)
+		done := make(chan error, 1)
+		go func() {
+			done <- svr.inventory.watch(ctx, version, func(e *pb.WatchEvent) error {
+				events <- e
+				return nil
+			})
+		}()
+		return events, done
+	}
+
+	describe := func(e *pb.WatchEvent) string {
+		switch o := e.Object.(type) {
+		case *pb.WatchEvent_LogicalVolume:
+			return e.Type.String() + " lv " + e.VolumeGroupName + "/" + o.LogicalVolume.Name
+		case *pb.WatchEvent_VolumeGroup:
+			return e.Type.String() + " vg " + o.VolumeGroup.Name
+		case *pb.WatchEvent_PhysicalVolume:
+			return e.Type.String() + " pv " + o.PhysicalVolume.Name
+		}
+		return ""
+	}
+
+	receive := func(events <-chan *pb.WatchEvent, n int) []*pb.WatchEvent {
+		var received []*pb.WatchEvent
+		for i := 0; i < n; i++ {
+			var e *pb.WatchEvent
+			Eventually(events).Should(Receive(&e))
+			received = append(received, e)
+		}
+		Consistently(events, 50*time.Millisecond).ShouldNot(Receive())
+		return received
+	}
+
+	It("should start with the current inventory", func() {
+		events, _ := watch(0)
+		received := receive(events, 3)
+		Expect(describe(received[0])).To(Equal("ADDED pv /dev/sdb"))
+		Expect(describe(received[1])).To(Equal("ADDED vg k8s"))
+		Expect(describe(received[2])).To(Equal("ADDED lv k8s/data"))
+		Expect(received[2].ResourceVersion).To(Equal(received[0].ResourceVersion))
+	})
+
+	describeAll := func(events []*pb.WatchEvent) []string {
+		descriptions := make([]string, len(events))
+		for i, e := range events {
+			descriptions[i] = describe(e)
+		}
+		return descriptions
+	}
+
+	It("should stream the changes made through the server", func() {
+		go svr.RunInventory(ctx, time.Hour, false)
+		events, _ := watch(0)
+		version := receive(events, 3)[0].ResourceVersion
+
+		_, err := svr.CreateLV(ctx, &pb.CreateLVRequest{VolumeGroup: "k8s", Name: "logs", Size: gib})
+		Expect(err).To(BeNil())
+		received := receive(events, 3)
+		Expect(describeAll(received)).To(Equal([]string{"MODIFIED pv /dev/sdb", "MODIFIED vg k8s", "ADDED lv k8s/logs"}))
+		Expect(received[0].ResourceVersion).To(Equal(version + 1))
+		Expect(received[2].ResourceVersion).To(Equal(version + 3))
+	})
+
+	It("should delete the objects in a volume group before it", func() {
+		Expect(svr.inventory.rescan(ctx)).To(Succeed())
+		events, _ := watch(svr.inventory.version)
+		_, err := svr.RemoveLV(ctx, &pb.RemoveLVRequest{VolumeGroup: "k8s", Name: "data"})
+		Expect(err).To(BeNil())
+		_, err = svr.RemoveVG(ctx, &pb.CreateVGRequest{Name: "k8s"})
+		Expect(err).To(BeNil())
+		Expect(svr.inventory.rescan(ctx)).To(Succeed())
+		Expect(describeAll(receive(events, 3))).To(Equal([]string{"MODIFIED pv /dev/sdb", "DELETED lv k8s/data", "DELETED vg k8s"}))
+	})
+
+	It("should resume after a version", func() {
+		Expect(svr.inventory.rescan(ctx)).To(Succeed())
+		version := svr.inventory.version
+		_, err := svr.AddTagLV(ctx, &pb.AddTagLVRequest{VolumeGroup: "k8s", Name: "data", Tags: []string{"backup"}})
+		Expect(err).To(BeNil())
+		Expect(svr.inventory.rescan(ctx)).To(Succeed())
+
+		events, _ := watch(version)
+		received := receive(events, 1)
+		Expect(describe(received[0])).To(Equal("MODIFIED lv k8s/data"))
+		Expect(received[0].GetLogicalVolume().Tags).To(ContainElement("backup"))
+	})
+
+	It("should reject versions it doesn't have the events for", func() {
+		Expect(svr.inventory.rescan(ctx)).To(Succeed())
+		for _, version := range []uint64{1, svr.inventory.version + 1} {
+			_, done := watch(version)
+			var err error
+			Eventually(done).Should(Receive(&err))
+			Expect(status.Code(err)).To(Equal(codes.OutOfRange))
+		}
+
+		version := svr.inventory.version
+		svr.inventory.lock.Lock()
+		for i := 0; i <= historySize; i++ {
+			svr.inventory.record(&pb.WatchEvent{})
+		}
+		svr.inventory.lock.Unlock()
+		_, done := watch(version)
+		var err error
+		Eventually(done).Should(Receive(&err))
+		Expect(status.Code(err)).To(Equal(codes.OutOfRange))
+		_, done = watch(version + 1)
+		Consistently(done, 50*time.Millisecond).ShouldNot(Receive())
+	})
+})
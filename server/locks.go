@@ -0,0 +1,150 @@
+package server
+
+import (
+	"path/filepath"
+	"sort"
+	"sync"
+
+	"golang.org/x/net/context"
+	"golang.org/x/sync/semaphore"
+)
+
+// maxSharedHolders bounds the number of operations holding a lock shared,
+// an exclusive holder takes all of them
+const maxSharedHolders = 1 << 20
+
+type refLock struct {
+	sem  *semaphore.Weighted
+	refs int
+}
+
+// lockManager serializes conflicting operations on volume groups, logical
+// volumes and blocks. Volume groups are locked by their name, volumes by
+// "vg/lv" and blocks by their path, which can't collide as volume group
+// names can't contain a '/' and only block paths start with one. Locks
+// are dropped once nobody holds or waits for them, so the manager doesn't
+// grow with the volumes created over time
+type lockManager struct {
+	lock  sync.Mutex
+	locks map[string]*refLock
+}
+
+func newLockManager() *lockManager {
+	return &lockManager{locks: make(map[string]*refLock)}
+}
+
+type lockRequest struct {
+	key    string
+	weight int64
+}
+
+// lockVG takes the volume group exclusively, for operations which allocate
+// extents from it or change its pvs. The volumes given are taken as well,
+// for operations which also change them, so they wait for the long running
+// operations holding the volumes only
+func (m *lockManager) lockVG(ctx context.Context, vg string, names ...string) (func(), error) {
+	reqs := []lockRequest{{vg, maxSharedHolders}}
+	for _, name := range names {
+		reqs = append(reqs, lockRequest{vg + "/" + name, maxSharedHolders})
+	}
+	return m.acquire(ctx, reqs...)
+}
+
+// lockBlock takes the block exclusively, and the volume group when vg isn't
+// empty, for operations on pvs and the signatures of blocks. Links to a
+// block share its lock
+func (m *lockManager) lockBlock(ctx context.Context, vg string, block string) (func(), error) {
+	var reqs []lockRequest
+	if vg != "" {
+		reqs = append(reqs, lockRequest{vg, maxSharedHolders})
+	}
+	if block != "" {
+		reqs = append(reqs, lockRequest{blockKey(block), maxSharedHolders})
+	}
+	return m.acquire(ctx, reqs...)
+}
+
+func blockKey(block string) string {
+	if resolved, err := filepath.EvalSymlinks(block); err == nil {
+		return resolved
+	}
+	return filepath.Clean(block)
+}
+
+// lockLV takes the volume group shared and the volumes exclusively, for
+// operations which only change existing volumes, operations on different
+// volumes of a volume group run concurrently
+func (m *lockManager) lockLV(ctx context.Context, vg string, names ...string) (func(), error) {
+	reqs := []lockRequest{{vg, 1}}
+	for _, name := range names {
+		reqs = append(reqs, lockRequest{vg + "/" + name, maxSharedHolders})
+	}
+	return m.acquire(ctx, reqs...)
+}
+
+// lockLVOnly takes the volumes exclusively without the volume groups, for
+// long running operations which shouldn't block allocations in the volume
+// groups while they run
+func (m *lockManager) lockLVOnly(ctx context.Context, vgs []string, names []string) (func(), error) {
+	var reqs []lockRequest
+	for i := range vgs {
+		reqs = append(reqs, lockRequest{vgs[i] + "/" + names[i], maxSharedHolders})
+	}
+	return m.acquire(ctx, reqs...)
+}
+
+// acquire takes all locks in key order, so operations taking several locks
+// don't deadlock, and gives up when ctx is done
+func (m *lockManager) acquire(ctx context.Context, reqs ...lockRequest) (func(), error) {
+	sort.Slice(reqs, func(i, j int) bool { return reqs[i].key < reqs[j].key })
+
+	var held []lockRequest
+	release := func() {
+		for i := len(held) - 1; i >= 0; i-- {
+			m.release(held[i])
+		}
+	}
+	for i, req := range reqs {
+		if i > 0 && req.key == reqs[i-1].key {
+			continue
+		}
+		l := m.ref(req.key)
+		if err := l.sem.Acquire(ctx, req.weight); err != nil {
+			m.unref(req.key)
+			release()
+			return nil, err
+		}
+		held = append(held, req)
+	}
+	return release, nil
+}
+
+func (m *lockManager) ref(key string) *refLock {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	l, ok := m.locks[key]
+	if !ok {
+		l = &refLock{sem: semaphore.NewWeighted(maxSharedHolders)}
+		m.locks[key] = l
+	}
+	l.refs++
+	return l
+}
+
+func (m *lockManager) unref(key string) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	l := m.locks[key]
+	l.refs--
+	if l.refs == 0 {
+		delete(m.locks, key)
+	}
+}
+
+func (m *lockManager) release(req lockRequest) {
+	m.lock.Lock()
+	l := m.locks[req.key]
+	m.lock.Unlock()
+	l.sem.Release(req.weight)
+	m.unref(req.key)
+}
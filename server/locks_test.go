@@ -0,0 +1,169 @@
+package server
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/zdnscloud/lvmd/commands"
+	"github.com/zdnscloud/lvmd/commands/fake"
+	pb "github.com/zdnscloud/lvmd/proto"
+)
+
+var _ = Describe("lockManager", func() {
+	var m *lockManager
+	var ctx context.Context
+
+	BeforeEach(func() {
+		m = newLockManager()
+		ctx = context.Background()
+	})
+
+	blocked := func(lock func(ctx context.Context) (func(), error)) bool {
+		timeout, cancel := context.WithTimeout(ctx, 20*time.Millisecond)
+		defer cancel()
+		unlock, err := lock(timeout)
+		if err != nil {
+			Expect(err).To(Equal(context.DeadlineExceeded))
+			return true
+		}
+		unlock()
+		return false
+	}
+	lockVG := func(vg string) func(ctx context.Context) (func(), error) {
+		return func(ctx context.Context) (func(), error) { return m.lockVG(ctx, vg) }
+	}
+	lockLV := func(vg, name string) func(ctx context.Context) (func(), error) {
+		return func(ctx context.Context) (func(), error) { return m.lockLV(ctx, vg, name) }
+	}
+
+	It("should serialize allocations in a volume group", func() {
+		unlock, err := m.lockVG(ctx, "k8s")
+		Expect(err).To(BeNil())
+		Expect(blocked(lockVG("k8s"))).To(BeTrue())
+		Expect(blocked(lockLV("k8s", "data"))).To(BeTrue())
+		Expect(blocked(lockVG("other"))).To(BeFalse())
+
+		unlock()
+		Expect(blocked(lockVG("k8s"))).To(BeFalse())
+	})
+
+	It("should run operations on different volumes concurrently", func() {
+		unlock, err := m.lockLV(ctx, "k8s", "data")
+		Expect(err).To(BeNil())
+		defer unlock()
+		Expect(blocked(lockLV("k8s", "data"))).To(BeTrue())
+		Expect(blocked(lockLV("k8s", "other"))).To(BeFalse())
+		Expect(blocked(lockVG("k8s"))).To(BeTrue())
+	})
+
+	It("should only lock the volumes for long running operations", func() {
+		unlock, err := m.lockLVOnly(ctx, []string{"k8s", "backup"}, []string{"data", "copy"})
+		Expect(err).To(BeNil())
+		defer unlock()
+		Expect(blocked(lockVG("k8s"))).To(BeFalse())
+		Expect(blocked(lockLV("k8s", "data"))).To(BeTrue())
+		Expect(blocked(lockLV("backup", "copy"))).To(BeTrue())
+	})
+
+	It("should make changes to volumes wait for long running operations", func() {
+		unlock, err := m.lockLVOnly(ctx, []string{"k8s"}, []string{"data"})
+		Expect(err).To(BeNil())
+		defer unlock()
+		lockVGAndLV := func(ctx context.Context) (func(), error) { return m.lockVG(ctx, "k8s", "data") }
+		Expect(blocked(lockVGAndLV)).To(BeTrue())
+		Expect(blocked(lockVG("k8s"))).To(BeFalse())
+	})
+
+	It("should serialize operations on a block and its links", func() {
+		dir, err := ioutil.TempDir("", "locks")
+		Expect(err).To(BeNil())
+		defer os.RemoveAll(dir)
+		block := filepath.Join(dir, "sdb")
+		Expect(ioutil.WriteFile(block, nil, 0600)).To(Succeed())
+		link := filepath.Join(dir, "wwn-0x5000c500a1b2c3d4")
+		Expect(os.Symlink(block, link)).To(Succeed())
+		lockBlock := func(vg, block string) func(ctx context.Context) (func(), error) {
+			return func(ctx context.Context) (func(), error) { return m.lockBlock(ctx, vg, block) }
+		}
+
+		unlock, err := m.lockBlock(ctx, "", block)
+		Expect(err).To(BeNil())
+		Expect(blocked(lockBlock("k8s", block))).To(BeTrue())
+		Expect(blocked(lockBlock("", link))).To(BeTrue())
+		Expect(blocked(lockBlock("", filepath.Join(dir, "sdc")))).To(BeFalse())
+		Expect(blocked(lockVG("k8s"))).To(BeFalse())
+		unlock()
+		Expect(blocked(lockBlock("k8s", link))).To(BeFalse())
+	})
+
+	It("should drop released locks", func() {
+		unlock, err := m.lockLV(ctx, "k8s", "data")
+		Expect(err).To(BeNil())
+		Expect(blocked(lockVG("k8s"))).To(BeTrue())
+		unlock()
+		Expect(m.locks).To(BeEmpty())
+	})
+
+	It("should make the server wait for conflicting operations", func() {
+		lvm := fake.NewLVM()
+		lvm.AddBlock("/dev/sdb", 10*gib)
+		commands.SetExecutor(lvm)
+		svr := NewServer()
+		_, err := svr.CreateVG(ctx, &pb.CreateVGRequest{Name: "k8s", PhysicalVolume: "/dev/sdb"})
+		Expect(err).To(BeNil())
+
+		unlock, err := svr.locks.lockVG(ctx, "k8s")
+		Expect(err).To(BeNil())
+		timeout, cancel := context.WithTimeout(ctx, 20*time.Millisecond)
+		defer cancel()
+		_, err = svr.CreateLV(timeout, &pb.CreateLVRequest{VolumeGroup: "k8s", Name: "data", Size: gib})
+		Expect(status.Code(err)).To(Equal(codes.DeadlineExceeded))
+
+		done := make(chan error)
+		go func() {
+			_, err := svr.CreateLV(ctx, &pb.CreateLVRequest{VolumeGroup: "k8s", Name: "data", Size: gib})
+			done <- err
+		}()
+		Consistently(done).ShouldNot(Receive())
+		unlock()
+		Eventually(done).Should(Receive(BeNil()))
+	})
+
+	It("should make the server wait for clones and operations on the same block", func() {
+		lvm := fake.NewLVM()
+		lvm.AddBlock("/dev/sdb", 10*gib)
+		lvm.AddBlock("/dev/sdc", 10*gib)
+		commands.SetExecutor(lvm)
+		svr := NewServer()
+		_, err := svr.CreateVG(ctx, &pb.CreateVGRequest{Name: "k8s", PhysicalVolume: "/dev/sdb"})
+		Expect(err).To(BeNil())
+		_, err = svr.CreateLV(ctx, &pb.CreateLVRequest{VolumeGroup: "k8s", Name: "data", Size: gib})
+		Expect(err).To(BeNil())
+
+		unlock, err := svr.locks.lockLVOnly(ctx, []string{"k8s"}, []string{"data"})
+		Expect(err).To(BeNil())
+		timeout, cancel := context.WithTimeout(ctx, 20*time.Millisecond)
+		defer cancel()
+		_, err = svr.ResizeLV(timeout, &pb.ResizeLVRequest{VolumeGroup: "k8s", Name: "data", Size: 2 * gib})
+		Expect(status.Code(err)).To(Equal(codes.DeadlineExceeded))
+		unlock()
+
+		unlock, err = svr.locks.lockBlock(ctx, "", "/dev/sdc")
+		Expect(err).To(BeNil())
+		defer unlock()
+		timeout, cancel = context.WithTimeout(ctx, 20*time.Millisecond)
+		defer cancel()
+		_, err = svr.ExtendVG(timeout, &pb.ExtendVGRequest{Name: "k8s", PhysicalVolume: "/dev/sdc"})
+		Expect(status.Code(err)).To(Equal(codes.DeadlineExceeded))
+		_, err = svr.Destory(timeout, &pb.DestoryRequest{Block: "/dev/sdc"})
+		Expect(status.Code(err)).To(Equal(codes.DeadlineExceeded))
+	})
+})
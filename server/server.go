@@ -19,27 +19,40 @@ limitations under the License.
 package server
 
 import (
+	"errors"
 	"fmt"
-	"strings"
+	"strconv"
 
 	"golang.org/x/net/context"
-	"google.golang.org/grpc"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/zdnscloud/lvmd/commands"
 	pb "github.com/zdnscloud/lvmd/proto"
 )
 
-type Server struct{}
+// Server implements the LVM service, conflicting operations on the same
+// volume group, volume or block are serialized while reads run unlocked
+type Server struct {
+	locks     *lockManager
+	inventory *inventory
+}
 
 func NewServer() Server {
-	return Server{}
+	return Server{locks: newLockManager(), inventory: newInventory()}
+}
+
+// LockVG takes the volume group exclusively like the calls changing it, so
+// changes made by the daemon itself don't interfere with them
+func (s Server) LockVG(ctx context.Context, vg string) (func(), error) {
+	return s.locks.lockVG(ctx, vg)
 }
 
 func (s Server) ListLV(ctx context.Context, in *pb.ListLVRequest) (*pb.ListLVReply, error) {
 	lvs, err := commands.ListLV(ctx, in.VolumeGroup)
 	if err != nil {
-		return nil, grpc.Errorf(codes.Internal, "failed to list LV: %v\nCommandOutput: %v", err, lvs)
+		return nil, statusError(err, "failed to list LV")
 	}
 
 	pblvs := make([]*pb.LogicalVolume, len(lvs))
@@ -50,74 +63,252 @@ func (s Server) ListLV(ctx context.Context, in *pb.ListLVRequest) (*pb.ListLVRep
 }
 
 func (s Server) CreateLV(ctx context.Context, in *pb.CreateLVRequest) (*pb.CreateLVReply, error) {
+	defer s.inventory.trigger()
+	unlock, err := s.locks.lockVG(ctx, in.VolumeGroup)
+	if err != nil {
+		return nil, statusError(err, "failed to lock vg")
+	}
+	defer unlock()
 	log, err := commands.CreateLV(ctx, in.VolumeGroup, in.Name, in.Size, in.Mirrors, in.Tags)
 	if err != nil {
-		return nil, grpc.Errorf(codes.Internal, "failed to create lv: %v\nCommandOutput: %v", err, streamline(log))
+		return nil, statusError(err, "failed to create lv")
 	}
-	return &pb.CreateLVReply{CommandOutput: log}, nil
+	lv, err := getLV(ctx, in.VolumeGroup, in.Name)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.CreateLVReply{CommandOutput: log, Volume: lv}, nil
 }
 
 func (s Server) CreateThinPool(ctx context.Context, in *pb.CreateThinPoolRequest) (*pb.CreateThinPoolReply, error) {
-	log, err := commands.CreateThinPoolUseAllSize(ctx, in.VolumeGroup, in.Pool)
+	defer s.inventory.trigger()
+	unlock, err := s.locks.lockVG(ctx, in.VolumeGroup)
 	if err != nil {
-		return nil, grpc.Errorf(codes.Internal, "failed to create thin pool: %v\nCommandOutput: %v", err, streamline(log))
+		return nil, statusError(err, "failed to lock vg")
+	}
+	defer unlock()
+	opts := commands.ThinPoolOptions{
+		Size:         in.Size,
+		PercentFree:  in.PercentFree,
+		MetadataSize: in.MetadataSize,
+		ChunkSize:    in.ChunkSize,
+		Discards:     discardModes[in.Discards],
 	}
-	return &pb.CreateThinPoolReply{CommandOutput: log}, nil
+	if in.Zeroing != pb.CreateThinPoolRequest_ZEROING_DEFAULT {
+		zeroing := in.Zeroing == pb.CreateThinPoolRequest_ZEROING_ENABLED
+		opts.Zeroing = &zeroing
+	}
+	log, err := commands.CreateThinPool(ctx, in.VolumeGroup, in.Pool, opts)
+	if err != nil {
+		return nil, statusError(err, "failed to create thin pool")
+	}
+	lv, err := getLV(ctx, in.VolumeGroup, in.Pool)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.CreateThinPoolReply{CommandOutput: log, Volume: lv}, nil
+}
+
+var discardModes = map[pb.CreateThinPoolRequest_Discards]string{
+	pb.CreateThinPoolRequest_DISCARDS_PASSDOWN:   commands.DiscardsPassdown,
+	pb.CreateThinPoolRequest_DISCARDS_NOPASSDOWN: commands.DiscardsNoPassdown,
+	pb.CreateThinPoolRequest_DISCARDS_IGNORE:     commands.DiscardsIgnore,
 }
 
 func (s Server) ChangeLV(ctx context.Context, in *pb.ChangeLVRequest) (*pb.ChangeLVReply, error) {
+	defer s.inventory.trigger()
+	unlock, err := s.locks.lockLV(ctx, in.VolumeGroup, in.Name)
+	if err != nil {
+		return nil, statusError(err, "failed to lock lv")
+	}
+	defer unlock()
 	log, err := commands.ChangeLV(ctx, in.VolumeGroup, in.Name)
 	if err != nil {
-		return nil, grpc.Errorf(codes.Internal, "failed to change lv: %v\nCommandOutput: %v", err, streamline(log))
+		return nil, statusError(err, "failed to change lv")
+	}
+	lv, err := getLV(ctx, in.VolumeGroup, in.Name)
+	if err != nil {
+		return nil, err
 	}
-	return &pb.ChangeLVReply{CommandOutput: log}, nil
+	return &pb.ChangeLVReply{CommandOutput: log, Volume: lv}, nil
 }
 
 func (s Server) CreateThinLV(ctx context.Context, in *pb.CreateThinLVRequest) (*pb.CreateThinLVReply, error) {
+	defer s.inventory.trigger()
+	unlock, err := s.locks.lockVG(ctx, in.VolumeGroup)
+	if err != nil {
+		return nil, statusError(err, "failed to lock vg")
+	}
+	defer unlock()
 	vg := fmt.Sprintf("%s/%s", in.VolumeGroup, in.Pool)
 	log, err := commands.CreateThinLV(ctx, vg, in.Name, in.Size, in.Mirrors, in.Tags)
 	if err != nil {
-		return nil, grpc.Errorf(codes.Internal, "failed to create thin lv: %v\nCommandOutput: %v", err, streamline(log))
+		return nil, statusError(err, "failed to create thin lv")
+	}
+	lv, err := getLV(ctx, in.VolumeGroup, in.Name)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.CreateThinLVReply{CommandOutput: log, Volume: lv}, nil
+}
+
+func (s Server) GetThinPoolStatus(ctx context.Context, in *pb.GetThinPoolStatusRequest) (*pb.GetThinPoolStatusReply, error) {
+	status, err := getThinPoolStatus(ctx, in.VolumeGroup, in.Pool)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.GetThinPoolStatusReply{Status: status}, nil
+}
+
+func (s Server) SetThinPoolPolicy(ctx context.Context, in *pb.SetThinPoolPolicyRequest) (*pb.SetThinPoolPolicyReply, error) {
+	defer s.inventory.trigger()
+	unlock, err := s.locks.lockLV(ctx, in.VolumeGroup, in.Pool)
+	if err != nil {
+		return nil, statusError(err, "failed to lock lv")
 	}
-	return &pb.CreateThinLVReply{CommandOutput: log}, nil
+	defer unlock()
+	policy := commands.ThinPoolPolicy{
+		Threshold: in.GetPolicy().GetThreshold(),
+		Percent:   in.GetPolicy().GetExtendPercent(),
+	}
+	log, err := commands.SetThinPoolPolicy(ctx, in.VolumeGroup, in.Pool, policy)
+	if err != nil {
+		return nil, statusError(err, "failed to set thin pool policy")
+	}
+	status, err := getThinPoolStatus(ctx, in.VolumeGroup, in.Pool)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.SetThinPoolPolicyReply{CommandOutput: log, Status: status}, nil
 }
 
 func (s Server) RemoveLV(ctx context.Context, in *pb.RemoveLVRequest) (*pb.RemoveLVReply, error) {
+	defer s.inventory.trigger()
+	unlock, err := s.locks.lockLV(ctx, in.VolumeGroup, in.Name)
+	if err != nil {
+		return nil, statusError(err, "failed to lock lv")
+	}
+	defer unlock()
 	log, err := commands.RemoveLV(ctx, in.VolumeGroup, in.Name)
 	if err != nil {
-		return nil, grpc.Errorf(codes.Internal, "failed to remove lv: %v\nCommandOutput: %v", err, streamline(log))
+		return nil, statusError(err, "failed to remove lv")
 	}
 	return &pb.RemoveLVReply{CommandOutput: log}, nil
 }
 
-func (s Server) CloneLV(ctx context.Context, in *pb.CloneLVRequest) (*pb.CloneLVReply, error) {
-	log, err := commands.CloneLV(ctx, in.SourceName, in.DestName)
+func (s Server) CloneLV(in *pb.CloneLVRequest, stream pb.LVM_CloneLVServer) error {
+	defer s.inventory.trigger()
+	destVG := in.DestVolumeGroup
+	if destVG == "" {
+		destVG = in.VolumeGroup
+	}
+	// cloning takes long, so only the volumes are locked and allocations
+	// in the volume groups go on meanwhile
+	unlock, err := s.locks.lockLVOnly(stream.Context(), []string{in.VolumeGroup, destVG}, []string{in.SourceName, in.DestName})
+	if err != nil {
+		return statusError(err, "failed to lock lv")
+	}
+	defer unlock()
+
+	err = commands.CloneLV(stream.Context(), in.VolumeGroup, in.SourceName, destVG, in.DestName, func(p commands.CloneProgress) error {
+		return stream.Send(&pb.CloneLVProgress{
+			BytesCopied:    p.Copied,
+			TotalBytes:     p.Total,
+			BytesSkipped:   p.Skipped,
+			BytesPerSecond: p.BytesPerSecond,
+			Done:           p.Done,
+		})
+	})
+	if err != nil {
+		return statusError(err, "failed to clone lv")
+	}
+	return nil
+}
+
+func (s Server) CreateSnapshot(ctx context.Context, in *pb.CreateSnapshotRequest) (*pb.CreateSnapshotReply, error) {
+	defer s.inventory.trigger()
+	unlock, err := s.locks.lockVG(ctx, in.VolumeGroup)
+	if err != nil {
+		return nil, statusError(err, "failed to lock vg")
+	}
+	defer unlock()
+	log, err := commands.CreateSnapshot(ctx, in.VolumeGroup, in.Origin, in.Name, in.Size, in.Tags)
+	if err != nil {
+		return nil, statusError(err, "failed to create snapshot")
+	}
+	lv, err := getLV(ctx, in.VolumeGroup, in.Name)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.CreateSnapshotReply{CommandOutput: log, Volume: lv}, nil
+}
+
+func (s Server) ListSnapshots(ctx context.Context, in *pb.ListSnapshotsRequest) (*pb.ListSnapshotsReply, error) {
+	lvs, err := commands.ListSnapshots(ctx, in.VolumeGroup, in.Origin)
+	if err != nil {
+		return nil, statusError(err, "failed to list snapshots")
+	}
+
+	pblvs := make([]*pb.LogicalVolume, len(lvs))
+	for i, v := range lvs {
+		pblvs[i] = v.ToProto()
+	}
+	return &pb.ListSnapshotsReply{Snapshots: pblvs}, nil
+}
+
+func (s Server) MergeSnapshot(ctx context.Context, in *pb.MergeSnapshotRequest) (*pb.MergeSnapshotReply, error) {
+	defer s.inventory.trigger()
+	// the origin is looked up before locking so all locks are taken at once,
+	// and checked again once they are held
+	snapshot, err := commands.GetLV(ctx, in.VolumeGroup, in.Name)
+	if err != nil {
+		return nil, statusError(err, "failed to get snapshot")
+	}
+	unlock, err := s.locks.lockVG(ctx, in.VolumeGroup, in.Name, snapshot.Origin)
 	if err != nil {
-		return nil, grpc.Errorf(codes.Internal, "failed to clone lv: %v\nCommandOutput: %v", err, streamline(log))
+		return nil, statusError(err, "failed to lock vg")
 	}
-	return &pb.CloneLVReply{CommandOutput: log}, nil
+	defer unlock()
+	locked, err := commands.GetLV(ctx, in.VolumeGroup, in.Name)
+	if err != nil {
+		return nil, statusError(err, "failed to get snapshot")
+	}
+	if locked.Origin != snapshot.Origin {
+		return nil, status.Errorf(codes.Aborted, "snapshot %s/%s changed while locking it", in.VolumeGroup, in.Name)
+	}
+	log, err := commands.MergeSnapshot(ctx, in.VolumeGroup, in.Name)
+	if err != nil {
+		return nil, statusError(err, "failed to merge snapshot")
+	}
+	origin, err := getLV(ctx, in.VolumeGroup, snapshot.Origin)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.MergeSnapshotReply{CommandOutput: log, Origin: origin}, nil
 }
 
 func (s Server) ResizeLV(ctx context.Context, in *pb.ResizeLVRequest) (*pb.ResizeLVReply, error) {
-	log1, err := commands.ResizeLV(ctx, in.VolumeGroup, in.Name, in.Size)
+	defer s.inventory.trigger()
+	unlock, err := s.locks.lockVG(ctx, in.VolumeGroup, in.Name)
 	if err != nil {
-		return nil, grpc.Errorf(codes.Internal, "failed to resize lv: %v\nCommandOutput: %v", err, streamline(log1))
+		return nil, statusError(err, "failed to lock vg")
 	}
-	log2, err := commands.ResizeLVe2fsck(ctx, in.VolumeGroup, in.Name)
+	defer unlock()
+	log, err := commands.ResizeLV(ctx, in.VolumeGroup, in.Name, in.Size, in.BlockOnly)
 	if err != nil {
-		return nil, grpc.Errorf(codes.Internal, "failed to e2fsck lv: %v\nCommandOutput: %v", err, streamline(log2))
+		return nil, statusError(err, "failed to resize lv")
 	}
-	log3, err := commands.ResizeLV2fs(ctx, in.VolumeGroup, in.Name)
+	lv, err := getLV(ctx, in.VolumeGroup, in.Name)
 	if err != nil {
-		return nil, grpc.Errorf(codes.Internal, "failed to resize2fs lv: %v\nCommandOutput: %v", err, streamline(log3))
+		return nil, err
 	}
-	return &pb.ResizeLVReply{CommandOutput: log1 + "|" + log2 + "|" + log3}, nil
+	return &pb.ResizeLVReply{CommandOutput: log, Volume: lv}, nil
 }
 
 func (s Server) ListVG(ctx context.Context, in *pb.ListVGRequest) (*pb.ListVGReply, error) {
 	vgs, err := commands.ListVG(ctx)
 	if err != nil {
-		return nil, grpc.Errorf(codes.Internal, "failed to list vg: %v\nCommandOutput: %v", err, vgs)
+		return nil, statusError(err, "failed to list vg")
 	}
 
 	pbvgs := make([]*pb.VolumeGroup, len(vgs))
@@ -128,65 +319,137 @@ func (s Server) ListVG(ctx context.Context, in *pb.ListVGRequest) (*pb.ListVGRep
 }
 
 func (s Server) CreateVG(ctx context.Context, in *pb.CreateVGRequest) (*pb.CreateVGReply, error) {
+	defer s.inventory.trigger()
+	unlock, err := s.locks.lockBlock(ctx, in.Name, in.PhysicalVolume)
+	if err != nil {
+		return nil, statusError(err, "failed to lock vg")
+	}
+	defer unlock()
 	log, err := commands.CreateVG(ctx, in.Name, in.PhysicalVolume, in.Tags)
 	if err != nil {
-		return nil, grpc.Errorf(codes.Internal, "failed to create vg: %v\nCommandOutput: %v", err, streamline(log))
+		return nil, statusError(err, "failed to create vg")
 	}
-	return &pb.CreateVGReply{CommandOutput: log}, nil
+	vg, err := getVG(ctx, in.Name)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.CreateVGReply{CommandOutput: log, VolumeGroup: vg}, nil
 }
 
 func (s Server) ExtendVG(ctx context.Context, in *pb.ExtendVGRequest) (*pb.ExtendVGReply, error) {
+	defer s.inventory.trigger()
+	unlock, err := s.locks.lockBlock(ctx, in.Name, in.PhysicalVolume)
+	if err != nil {
+		return nil, statusError(err, "failed to lock vg")
+	}
+	defer unlock()
 	log, err := commands.ExtendVG(ctx, in.Name, in.PhysicalVolume)
 	if err != nil {
-		return nil, grpc.Errorf(codes.Internal, "failed to extend vg: %v\nCommandOutput: %v", err, streamline(log))
+		return nil, statusError(err, "failed to extend vg")
+	}
+	vg, err := getVG(ctx, in.Name)
+	if err != nil {
+		return nil, err
 	}
-	return &pb.ExtendVGReply{CommandOutput: log}, nil
+	return &pb.ExtendVGReply{CommandOutput: log, VolumeGroup: vg}, nil
 }
 
 func (s Server) ReduceVG(ctx context.Context, in *pb.ExtendVGRequest) (*pb.ExtendVGReply, error) {
+	defer s.inventory.trigger()
+	unlock, err := s.locks.lockBlock(ctx, in.Name, in.PhysicalVolume)
+	if err != nil {
+		return nil, statusError(err, "failed to lock vg")
+	}
+	defer unlock()
 	log, err := commands.ReduceVG(ctx, in.Name, in.PhysicalVolume)
 	if err != nil {
-		return nil, grpc.Errorf(codes.Internal, "failed to reduce vg: %v\nCommandOutput: %v", err, streamline(log))
+		return nil, statusError(err, "failed to reduce vg")
+	}
+	vg, err := getVG(ctx, in.Name)
+	if err != nil {
+		return nil, err
 	}
-	return &pb.ExtendVGReply{CommandOutput: log}, nil
+	return &pb.ExtendVGReply{CommandOutput: log, VolumeGroup: vg}, nil
 }
 
 func (s Server) RemoveVG(ctx context.Context, in *pb.CreateVGRequest) (*pb.RemoveVGReply, error) {
+	defer s.inventory.trigger()
+	unlock, err := s.locks.lockVG(ctx, in.Name)
+	if err != nil {
+		return nil, statusError(err, "failed to lock vg")
+	}
+	defer unlock()
 	log, err := commands.RemoveVG(ctx, in.Name)
 	if err != nil {
-		return nil, grpc.Errorf(codes.Internal, "failed to remove vg: %v\nCommandOutput: %v", err, streamline(log))
+		return nil, statusError(err, "failed to remove vg")
 	}
 	return &pb.RemoveVGReply{CommandOutput: log}, nil
 }
 
 func (s Server) AddTagLV(ctx context.Context, in *pb.AddTagLVRequest) (*pb.AddTagLVReply, error) {
+	defer s.inventory.trigger()
+	unlock, err := s.locks.lockLV(ctx, in.VolumeGroup, in.Name)
+	if err != nil {
+		return nil, statusError(err, "failed to lock lv")
+	}
+	defer unlock()
 	log, err := commands.AddTagLV(ctx, in.VolumeGroup, in.Name, in.Tags)
 	if err != nil {
-		return nil, grpc.Errorf(codes.Internal, "failed to add tags to lv: %v\nCommandOutput: %v", err, streamline(log))
+		return nil, statusError(err, "failed to add tags to lv")
 	}
-	return &pb.AddTagLVReply{CommandOutput: log}, nil
+	lv, err := getLV(ctx, in.VolumeGroup, in.Name)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.AddTagLVReply{CommandOutput: log, Volume: lv}, nil
 }
 
 func (s Server) RemoveTagLV(ctx context.Context, in *pb.RemoveTagLVRequest) (*pb.RemoveTagLVReply, error) {
+	defer s.inventory.trigger()
+	unlock, err := s.locks.lockLV(ctx, in.VolumeGroup, in.Name)
+	if err != nil {
+		return nil, statusError(err, "failed to lock lv")
+	}
+	defer unlock()
 	log, err := commands.RemoveTagLV(ctx, in.VolumeGroup, in.Name, in.Tags)
 	if err != nil {
-		return nil, grpc.Errorf(codes.Internal, "failed to remove tags from lv: %v\nCommandOutput: %v", err, streamline(log))
+		return nil, statusError(err, "failed to remove tags from lv")
 	}
-	return &pb.RemoveTagLVReply{CommandOutput: log}, nil
+	lv, err := getLV(ctx, in.VolumeGroup, in.Name)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.RemoveTagLVReply{CommandOutput: log, Volume: lv}, nil
 }
 
 func (s Server) CreatePV(ctx context.Context, in *pb.CreatePVRequest) (*pb.CreatePVReply, error) {
+	defer s.inventory.trigger()
+	unlock, err := s.locks.lockBlock(ctx, "", in.Block)
+	if err != nil {
+		return nil, statusError(err, "failed to lock block")
+	}
+	defer unlock()
 	log, err := commands.CreatePV(ctx, in.Block)
 	if err != nil {
-		return nil, grpc.Errorf(codes.Internal, "failed to create pv: %v\nCommandOutput: %v", err, streamline(log))
+		return nil, statusError(err, "failed to create pv")
+	}
+	pv, err := commands.GetPV(ctx, in.Block)
+	if err != nil {
+		return nil, statusError(err, "failed to get pv")
 	}
-	return &pb.CreatePVReply{CommandOutput: log}, nil
+	return &pb.CreatePVReply{CommandOutput: log, Pvinfo: pv.ToProto()}, nil
 }
 
 func (s Server) RemovePV(ctx context.Context, in *pb.RemovePVRequest) (*pb.RemovePVReply, error) {
+	defer s.inventory.trigger()
+	unlock, err := s.locks.lockBlock(ctx, "", in.Block)
+	if err != nil {
+		return nil, statusError(err, "failed to lock block")
+	}
+	defer unlock()
 	log, err := commands.RemovePV(ctx, in.Block)
 	if err != nil {
-		return nil, grpc.Errorf(codes.Internal, "failed to remove pv: %v\nCommandOutput: %v", err, streamline(log))
+		return nil, statusError(err, "failed to remove pv")
 	}
 	return &pb.RemovePVReply{CommandOutput: log}, nil
 }
@@ -194,7 +457,7 @@ func (s Server) RemovePV(ctx context.Context, in *pb.RemovePVRequest) (*pb.Remov
 func (s Server) ListPV(ctx context.Context, in *pb.ListPVRequest) (*pb.ListPVReply, error) {
 	pvs, err := commands.ListPV(ctx)
 	if err != nil {
-		return nil, grpc.Errorf(codes.Internal, "failed to list pv: %v\nCommandOutput: %v", err, pvs)
+		return nil, statusError(err, "failed to list pv")
 	}
 	pbpvs := make([]*pb.PVInfo, len(pvs))
 	for i, v := range pvs {
@@ -204,43 +467,192 @@ func (s Server) ListPV(ctx context.Context, in *pb.ListPVRequest) (*pb.ListPVRep
 }
 
 func (s Server) Validate(ctx context.Context, in *pb.ValidateRequest) (*pb.ValidateReply, error) {
-	v, err := commands.Validate(ctx, in.Block)
+	reasons, err := commands.Validate(ctx, in.Block)
+	if err != nil {
+		return nil, statusError(err, "failed to validate block")
+	}
+	reply := &pb.ValidateReply{Validate: len(reasons) == 0}
+	for _, r := range reasons {
+		reason := &pb.ValidateReply_Reason{
+			Kind:    unusableKinds[r.Kind],
+			Detail:  r.Detail,
+			Message: r.String(),
+		}
+		if r.Signature != nil {
+			reason.Signature = r.Signature.ToProto()
+		}
+		reply.Reasons = append(reply.Reasons, reason)
+	}
+	return reply, nil
+}
+
+var unusableKinds = map[commands.UnusableKind]pb.ValidateReply_Reason_Kind{
+	commands.UnusableSignature:      pb.ValidateReply_Reason_SIGNATURE,
+	commands.UnusablePartitionTable: pb.ValidateReply_Reason_PARTITION_TABLE,
+	commands.UnusableMounted:        pb.ValidateReply_Reason_MOUNTED,
+	commands.UnusableHolder:         pb.ValidateReply_Reason_HOLDER,
+	commands.UnusableSwap:           pb.ValidateReply_Reason_SWAP,
+	commands.UnusableRaidMember:     pb.ValidateReply_Reason_RAID_MEMBER,
+	commands.UnusablePhysicalVolume: pb.ValidateReply_Reason_PHYSICAL_VOLUME,
+	commands.UnusableOpen:           pb.ValidateReply_Reason_OPEN,
+}
+
+func (s Server) ListBlockDevices(ctx context.Context, in *pb.ListBlockDevicesRequest) (*pb.ListBlockDevicesReply, error) {
+	devices, err := commands.ListBlockDevices(ctx, commands.BlockDeviceFilter{
+		MinSize:          in.MinSize,
+		ExcludeRemovable: in.ExcludeRemovable,
+		PathGlobs:        in.PathGlobs,
+	})
 	if err != nil {
-		return nil, grpc.Errorf(codes.Internal, "failed to validate block: %v\nCommandOutput: %v", err, v)
+		return nil, statusError(err, "failed to list block devices")
 	}
-	return &pb.ValidateReply{Validate: v}, nil
+	pbdevices := make([]*pb.BlockDevice, len(devices))
+	for i, d := range devices {
+		pbdevices[i] = d.ToProto()
+	}
+	return &pb.ListBlockDevicesReply{Devices: pbdevices}, nil
 }
 
 func (s Server) Destory(ctx context.Context, in *pb.DestoryRequest) (*pb.DestoryReply, error) {
-	log, err := commands.Destory(ctx, in.Block)
+	defer s.inventory.trigger()
+	unlock, err := s.locks.lockBlock(ctx, "", in.Block)
 	if err != nil {
-		return nil, grpc.Errorf(codes.Internal, "failed to destory block: %v\nCommandOutput: %v", err, streamline(log))
+		return nil, statusError(err, "failed to lock block")
 	}
-	return &pb.DestoryReply{CommandOutput: log}, nil
+	defer unlock()
+	signatures, log, err := commands.Destory(ctx, in.Block, in.DryRun)
+	if err != nil {
+		return nil, statusError(err, "failed to destory block")
+	}
+	reply := &pb.DestoryReply{CommandOutput: log}
+	for _, sig := range signatures {
+		reply.Signatures = append(reply.Signatures, sig.ToProto())
+	}
+	if !in.DryRun && len(signatures) != 0 {
+		reply.BackupDir = commands.SignatureBackupDir(in.Block)
+	}
+	return reply, nil
+}
+
+func (s Server) RestoreSignatures(ctx context.Context, in *pb.RestoreSignaturesRequest) (*pb.RestoreSignaturesReply, error) {
+	defer s.inventory.trigger()
+	unlock, err := s.locks.lockBlock(ctx, "", in.Block)
+	if err != nil {
+		return nil, statusError(err, "failed to lock block")
+	}
+	defer unlock()
+	signatures, log, err := commands.RestoreSignatures(ctx, in.Block)
+	if err != nil {
+		return nil, statusError(err, "failed to restore signatures")
+	}
+	reply := &pb.RestoreSignaturesReply{CommandOutput: log}
+	for _, sig := range signatures {
+		reply.Signatures = append(reply.Signatures, sig.ToProto())
+	}
+	return reply, nil
 }
 
 func (s Server) Match(ctx context.Context, in *pb.MatchRequest) (*pb.MatchReply, error) {
-	log := commands.Match(ctx, in.Block)
-	return &pb.MatchReply{CommandOutput: log}, nil
+	vg, err := commands.Match(ctx, in.Block)
+	if err != nil {
+		return nil, statusError(err, "failed to match block")
+	}
+	return &pb.MatchReply{CommandOutput: vg, VolumeGroup: vg}, nil
 }
 
 func (s Server) GetPVNum(ctx context.Context, in *pb.CreateVGRequest) (*pb.GetPVNumReply, error) {
-	log, err := commands.GetPVNum(ctx, in.Name)
+	num, err := commands.GetPVNum(ctx, in.Name)
 	if err != nil {
-		return nil, grpc.Errorf(codes.Internal, "failed to get vg's pv num: %v\nCommandOutput: %v", err, streamline(log))
+		return nil, statusError(err, "failed to get vg's pv num")
 	}
-	return &pb.GetPVNumReply{CommandOutput: log}, nil
+	return &pb.GetPVNumReply{CommandOutput: strconv.FormatUint(uint64(num), 10), PvCount: num}, nil
 }
 
-func streamline(out string) string {
-	var res string
-	for _, l := range strings.Split(out, "\n") {
-		if len(l) == 0 {
-			continue
-		}
-		if !strings.Contains(l, "/etc/lvm/cache/.cache") {
-			res = res + l + "\n"
+// getLV reads back a volume after it was changed, for the reply
+func getLV(ctx context.Context, vg string, name string) (*pb.LogicalVolume, error) {
+	lv, err := commands.GetLV(ctx, vg, name)
+	if err != nil {
+		return nil, statusError(err, "failed to get lv")
+	}
+	return lv.ToProto(), nil
+}
+
+// getThinPoolStatus reads the usage of a thin pool along with its policy
+func getThinPoolStatus(ctx context.Context, vg string, name string) (*pb.ThinPoolStatus, error) {
+	pool, err := commands.GetThinPoolStatus(ctx, vg, name)
+	if err != nil {
+		return nil, statusError(err, "failed to get thin pool status")
+	}
+	status := pool.ToProto()
+	if policy, ok := commands.ThinPoolPolicyFromTags(pool.Tags); ok {
+		status.Policy = &pb.ThinPoolPolicy{Threshold: policy.Threshold, ExtendPercent: policy.Percent}
+	}
+	return status, nil
+}
+
+// getVG reads back a volume group after it was changed, for the reply
+func getVG(ctx context.Context, name string) (*pb.VolumeGroup, error) {
+	vg, err := commands.GetVG(ctx, name)
+	if err != nil {
+		return nil, statusError(err, "failed to get vg")
+	}
+	return vg.ToProto(), nil
+}
+
+var errorCodes = map[commands.ErrorKind]codes.Code{
+	commands.ErrUnknown:           codes.Internal,
+	commands.ErrNotFound:          codes.NotFound,
+	commands.ErrAlreadyExists:     codes.AlreadyExists,
+	commands.ErrInsufficientSpace: codes.ResourceExhausted,
+	commands.ErrProtected:         codes.FailedPrecondition,
+	commands.ErrBusy:              codes.FailedPrecondition,
+	commands.ErrInvalidArgument:   codes.InvalidArgument,
+	commands.ErrUnsupported:       codes.FailedPrecondition,
+}
+
+// errorDomain is the domain of the ErrorInfo details attached to errors
+const errorDomain = "lvmd"
+
+// statusError builds the error returned by a handler for operation op,
+// the code is derived from the classified failure of the commands package
+// or from the caller's deadline and cancellation. The message of a failed
+// command only names its kind, the output of the command stays out of it:
+// ErrorInfo with the exit status and DebugInfo with stderr are attached as
+// details
+func statusError(err error, op string) error {
+	code := codes.Internal
+	var cmdErr *commands.Error
+	switch {
+	case errors.Is(err, context.DeadlineExceeded):
+		code = codes.DeadlineExceeded
+	case errors.Is(err, context.Canceled):
+		code = codes.Canceled
+	case errors.As(err, &cmdErr):
+		code = errorCodes[cmdErr.Kind]
+	}
+
+	msg := fmt.Sprintf("%s: %v", op, err)
+	if cmdErr != nil && cmdErr.Command != "" {
+		msg = fmt.Sprintf("%s: %s failed: %s", op, cmdErr.Command, cmdErr.Kind)
+	}
+	st := status.New(code, msg)
+	if cmdErr == nil {
+		return st.Err()
+	}
+
+	info := &errdetails.ErrorInfo{
+		Type:   cmdErr.Kind.String(),
+		Domain: errorDomain,
+	}
+	if cmdErr.Command != "" {
+		info.Metadata = map[string]string{
+			"command":     cmdErr.Command,
+			"exit_status": strconv.Itoa(cmdErr.ExitStatus),
 		}
 	}
-	return strings.TrimSpace(res)
+	detailed, err := st.WithDetails(info, &errdetails.DebugInfo{Detail: cmdErr.Stderr})
+	if err != nil {
+		return st.Err()
+	}
+	return detailed.Err()
 }
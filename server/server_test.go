@@ -0,0 +1,1042 @@
+package server
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"golang.org/x/net/context"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/zdnscloud/lvmd/commands"
+	"github.com/zdnscloud/lvmd/commands/fake"
+	pb "github.com/zdnscloud/lvmd/proto"
+)
+
+const gib uint64 = 1024 * 1024 * 1024
+
+func TestServer(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Server Suite")
+}
+
+type cloneStream struct {
+	grpc.ServerStream
+	ctx     context.Context
+	reports []*pb.CloneLVProgress
+}
+
+func (s *cloneStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *cloneStream) Send(p *pb.CloneLVProgress) error {
+	s.reports = append(s.reports, p)
+	return nil
+}
+
+var _ = Describe("Server", func() {
+	var lvm *fake.LVM
+	var svr Server
+	var ctx context.Context
+
+	BeforeEach(func() {
+		lvm = fake.NewLVM()
+		lvm.AddBlock("/dev/sdb", 10*gib)
+		lvm.AddBlock("/dev/sdc", 10*gib)
+		lvm.AddBlock("/dev/sdd", 10*gib, "ext4")
+		commands.SetExecutor(lvm)
+		svr = NewServer()
+		ctx = context.Background()
+	})
+
+	createVG := func(name string, blocks ...string) {
+		for _, b := range blocks {
+			_, err := svr.CreatePV(ctx, &pb.CreatePVRequest{Block: b})
+			Expect(err).To(BeNil())
+		}
+		_, err := svr.CreateVG(ctx, &pb.CreateVGRequest{Name: name, PhysicalVolume: blocks[0]})
+		Expect(err).To(BeNil())
+		for _, b := range blocks[1:] {
+			_, err := svr.ExtendVG(ctx, &pb.ExtendVGRequest{Name: name, PhysicalVolume: b})
+			Expect(err).To(BeNil())
+		}
+	}
+
+	listLV := func(spec string) []*pb.LogicalVolume {
+		reply, err := svr.ListLV(ctx, &pb.ListLVRequest{VolumeGroup: spec})
+		Expect(err).To(BeNil())
+		return reply.Volumes
+	}
+
+	Context("physical volumes and volume groups", func() {
+		It("should create a volume group over several pvs", func() {
+			createVG("k8s", "/dev/sdb", "/dev/sdc")
+
+			reply, err := svr.ListVG(ctx, &pb.ListVGRequest{})
+			Expect(err).To(BeNil())
+			Expect(reply.VolumeGroups).To(HaveLen(1))
+			vg := reply.VolumeGroups[0]
+			Expect(vg.Name).To(Equal("k8s"))
+			Expect(vg.Size).To(Equal(2 * (10*gib - 4*1024*1024)))
+			Expect(vg.FreeSize).To(Equal(vg.Size))
+			Expect(vg.ExtentSize).To(Equal(fake.ExtentSize))
+			Expect(vg.ExtentCount).To(Equal(vg.Size / fake.ExtentSize))
+			Expect(vg.FreeExtentCount).To(Equal(vg.ExtentCount))
+			Expect(vg.PvCount).To(Equal(uint32(2)))
+			Expect(vg.Attributes.Writeable).To(BeTrue())
+			Expect(vg.Attributes.Resizeable).To(BeTrue())
+			Expect(vg.Attributes.Partial).To(BeFalse())
+
+			pvs, err := svr.ListPV(ctx, &pb.ListPVRequest{})
+			Expect(err).To(BeNil())
+			Expect(pvs.Pvinfos).To(HaveLen(2))
+
+			num, err := svr.GetPVNum(ctx, &pb.CreateVGRequest{Name: "k8s"})
+			Expect(err).To(BeNil())
+			Expect(num.CommandOutput).To(Equal("2"))
+			Expect(num.PvCount).To(Equal(uint32(2)))
+
+			match, err := svr.Match(ctx, &pb.MatchRequest{Block: "/dev/sdc"})
+			Expect(err).To(BeNil())
+			Expect(match.CommandOutput).To(Equal("k8s"))
+			Expect(match.VolumeGroup).To(Equal("k8s"))
+			match, err = svr.Match(ctx, &pb.MatchRequest{Block: "/dev/sdd"})
+			Expect(err).To(BeNil())
+			Expect(match.VolumeGroup).To(BeEmpty())
+
+			pv := pvs.Pvinfos[0]
+			Expect(pv.VgName).To(Equal("k8s"))
+			Expect(pv.PeCount).To(Equal(vg.ExtentCount / 2))
+			Expect(pv.PeAllocCount).To(BeZero())
+			Expect(pv.Attributes.Allocatable).To(BeTrue())
+			Expect(pv.Attributes.Missing).To(BeFalse())
+			Expect(pv.DevSize).To(Equal(10 * gib))
+			Expect(pv.MdaCount).To(Equal(uint32(1)))
+		})
+
+		It("should return the resulting pv and volume group", func() {
+			pv, err := svr.CreatePV(ctx, &pb.CreatePVRequest{Block: "/dev/sdb"})
+			Expect(err).To(BeNil())
+			Expect(pv.Pvinfo.Name).To(Equal("/dev/sdb"))
+			Expect(pv.Pvinfo.Size).To(Equal(10*gib - 4*1024*1024))
+
+			vg, err := svr.CreateVG(ctx, &pb.CreateVGRequest{Name: "k8s", PhysicalVolume: "/dev/sdb"})
+			Expect(err).To(BeNil())
+			Expect(vg.VolumeGroup.Name).To(Equal("k8s"))
+			Expect(vg.VolumeGroup.Size).To(Equal(pv.Pvinfo.Size))
+
+			_, err = svr.CreatePV(ctx, &pb.CreatePVRequest{Block: "/dev/sdc"})
+			Expect(err).To(BeNil())
+			extended, err := svr.ExtendVG(ctx, &pb.ExtendVGRequest{Name: "k8s", PhysicalVolume: "/dev/sdc"})
+			Expect(err).To(BeNil())
+			Expect(extended.VolumeGroup.Size).To(Equal(2 * vg.VolumeGroup.Size))
+			reduced, err := svr.ReduceVG(ctx, &pb.ExtendVGRequest{Name: "k8s", PhysicalVolume: "/dev/sdc"})
+			Expect(err).To(BeNil())
+			Expect(reduced.VolumeGroup.Size).To(Equal(vg.VolumeGroup.Size))
+		})
+
+		It("should reject a block which is already used by a volume group", func() {
+			createVG("k8s", "/dev/sdb")
+			_, err := svr.CreateVG(ctx, &pb.CreateVGRequest{Name: "other", PhysicalVolume: "/dev/sdb"})
+			Expect(err).NotTo(BeNil())
+		})
+
+		It("should remove a volume group and its pvs", func() {
+			createVG("k8s", "/dev/sdb")
+			_, err := svr.RemoveVG(ctx, &pb.CreateVGRequest{Name: "k8s"})
+			Expect(err).To(BeNil())
+			_, err = svr.RemovePV(ctx, &pb.RemovePVRequest{Block: "/dev/sdb"})
+			Expect(err).To(BeNil())
+
+			reply, err := svr.ListVG(ctx, &pb.ListVGRequest{})
+			Expect(err).To(BeNil())
+			for _, vg := range reply.VolumeGroups {
+				Expect(vg.Name).NotTo(Equal("k8s"))
+			}
+		})
+
+		It("should validate blocks without signatures only", func() {
+			reply, err := svr.Validate(ctx, &pb.ValidateRequest{Block: "/dev/sdb"})
+			Expect(err).To(BeNil())
+			Expect(reply.Validate).To(BeTrue())
+
+			reply, err = svr.Validate(ctx, &pb.ValidateRequest{Block: "/dev/sdd"})
+			Expect(err).To(BeNil())
+			Expect(reply.Validate).To(BeFalse())
+			Expect(reply.Reasons).To(HaveLen(1))
+			Expect(reply.Reasons[0].Kind).To(Equal(pb.ValidateReply_Reason_SIGNATURE))
+			Expect(reply.Reasons[0].Detail).To(Equal("ext4"))
+			Expect(reply.Reasons[0].Signature.Offset).To(Equal(uint64(0x438)))
+			Expect(reply.Reasons[0].Message).To(Equal("ext4 signature at offset 0x438"))
+
+			_, err = svr.Destory(ctx, &pb.DestoryRequest{Block: "/dev/sdd"})
+			Expect(err).To(BeNil())
+			reply, err = svr.Validate(ctx, &pb.ValidateRequest{Block: "/dev/sdd"})
+			Expect(err).To(BeNil())
+			Expect(reply.Validate).To(BeTrue())
+		})
+	})
+
+	Context("block devices", func() {
+		BeforeEach(func() {
+			lvm.AddBlockDevice(fake.Block{
+				Path:       "/dev/sde",
+				Size:       100 * gib,
+				Rotational: true,
+				Model:      "ST1000",
+				Serial:     "Z1W0",
+				WWN:        "0x5000c500a1b2c3d4",
+				Transport:  "sata",
+				Links:      []string{"disk/by-id/wwn-0x5000c500a1b2c3d4", "disk/by-path/pci-0000:00:1f.2-ata-1"},
+			})
+			lvm.AddBlockDevice(fake.Block{Path: "/dev/sdf", Size: 100 * gib, Removable: true, Transport: "usb"})
+		})
+
+		list := func(in *pb.ListBlockDevicesRequest) map[string]*pb.BlockDevice {
+			reply, err := svr.ListBlockDevices(ctx, in)
+			Expect(err).To(BeNil())
+			devices := make(map[string]*pb.BlockDevice)
+			for _, d := range reply.Devices {
+				devices[d.Path] = d
+			}
+			return devices
+		}
+
+		It("should list the devices with their udev information", func() {
+			createVG("k8s", "/dev/sdb")
+			_, err := svr.CreateLV(ctx, &pb.CreateLVRequest{VolumeGroup: "k8s", Name: "data", Size: gib})
+			Expect(err).To(BeNil())
+
+			devices := list(&pb.ListBlockDevicesRequest{})
+			Expect(devices).To(HaveKey("/dev/sde"))
+			sde := devices["/dev/sde"]
+			Expect(sde.Name).To(Equal("sde"))
+			Expect(sde.Size).To(Equal(100 * gib))
+			Expect(sde.Rotational).To(BeTrue())
+			Expect(sde.Model).To(Equal("ST1000"))
+			Expect(sde.Serial).To(Equal("Z1W0"))
+			Expect(sde.Wwn).To(Equal("0x5000c500a1b2c3d4"))
+			Expect(sde.Transport).To(Equal("sata"))
+			Expect(sde.Links).To(ContainElement("/dev/disk/by-id/wwn-0x5000c500a1b2c3d4"))
+			Expect(sde.PhysicalVolume).To(BeFalse())
+
+			Expect(devices["/dev/sdd"].Filesystem).To(Equal("ext4"))
+			sdb := devices["/dev/sdb"]
+			Expect(sdb.PhysicalVolume).To(BeTrue())
+			Expect(sdb.VolumeGroup).To(Equal("k8s"))
+			Expect(sdb.Holders).To(Equal([]string{"k8s-data"}))
+			Expect(devices["/dev/mapper/k8s-data"].Type).To(Equal("lvm"))
+			Expect(devices["/dev/mapper/k8s-data"].Links).To(Equal([]string{"/dev/k8s/data"}))
+		})
+
+		It("should filter the devices", func() {
+			devices := list(&pb.ListBlockDevicesRequest{MinSize: 50 * gib, ExcludeRemovable: true})
+			Expect(devices).To(HaveLen(1))
+			Expect(devices).To(HaveKey("/dev/sde"))
+
+			devices = list(&pb.ListBlockDevicesRequest{PathGlobs: []string{"/dev/disk/by-id/wwn-*", "/dev/sdf"}})
+			Expect(devices).To(HaveLen(2))
+			Expect(devices).To(HaveKey("/dev/sde"))
+			Expect(devices).To(HaveKey("/dev/sdf"))
+
+			_, err := svr.ListBlockDevices(ctx, &pb.ListBlockDevicesRequest{PathGlobs: []string{"/dev/[sd"}})
+			Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+		})
+	})
+
+	Context("validate", func() {
+		validate := func(block string) []*pb.ValidateReply_Reason {
+			reply, err := svr.Validate(ctx, &pb.ValidateRequest{Block: block})
+			Expect(err).To(BeNil())
+			Expect(reply.Validate).To(Equal(len(reply.Reasons) == 0))
+			return reply.Reasons
+		}
+
+		kinds := func(reasons []*pb.ValidateReply_Reason) []pb.ValidateReply_Reason_Kind {
+			var res []pb.ValidateReply_Reason_Kind
+			for _, r := range reasons {
+				res = append(res, r.Kind)
+			}
+			return res
+		}
+
+		It("should explain why a physical volume is in use", func() {
+			createVG("k8s", "/dev/sdb")
+			Expect(kinds(validate("/dev/sdb"))).To(Equal([]pb.ValidateReply_Reason_Kind{pb.ValidateReply_Reason_PHYSICAL_VOLUME}))
+			Expect(validate("/dev/sdb")[0].Detail).To(Equal("k8s"))
+
+			_, err := svr.CreateLV(ctx, &pb.CreateLVRequest{VolumeGroup: "k8s", Name: "data", Size: gib})
+			Expect(err).To(BeNil())
+			Expect(kinds(validate("/dev/sdb"))).To(Equal([]pb.ValidateReply_Reason_Kind{
+				pb.ValidateReply_Reason_HOLDER,
+				pb.ValidateReply_Reason_PHYSICAL_VOLUME,
+				pb.ValidateReply_Reason_OPEN,
+			}))
+		})
+
+		It("should refuse mounted, open and partitioned devices", func() {
+			lvm.AddBlockDevice(fake.Block{Path: "/dev/sde", Size: 10 * gib, Signatures: []string{"ext4"}, MountPoint: "/var"})
+			lvm.AddBlockDevice(fake.Block{Path: "/dev/sdf", Size: 10 * gib, Open: true})
+			lvm.AddBlockDevice(fake.Block{Path: "/dev/sdg", Size: 10 * gib, Signatures: []string{"PTTYPE=gpt"}})
+			lvm.AddBlockDevice(fake.Block{Path: "/dev/sdh", Size: 10 * gib, Signatures: []string{"swap"}, MountPoint: "[SWAP]"})
+
+			reasons := validate("/dev/sde")
+			Expect(kinds(reasons)).To(Equal([]pb.ValidateReply_Reason_Kind{
+				pb.ValidateReply_Reason_MOUNTED,
+				pb.ValidateReply_Reason_SIGNATURE,
+				pb.ValidateReply_Reason_OPEN,
+			}))
+			Expect(reasons[0].Detail).To(Equal("/var"))
+			Expect(kinds(validate("/dev/sdf"))).To(Equal([]pb.ValidateReply_Reason_Kind{pb.ValidateReply_Reason_OPEN}))
+			reasons = validate("/dev/sdg")
+			Expect(kinds(reasons)).To(Equal([]pb.ValidateReply_Reason_Kind{pb.ValidateReply_Reason_PARTITION_TABLE}))
+			Expect(reasons[0].Message).To(Equal("gpt partition table at offset 0x200"))
+			Expect(kinds(validate("/dev/sdh"))).To(Equal([]pb.ValidateReply_Reason_Kind{
+				pb.ValidateReply_Reason_SWAP,
+				pb.ValidateReply_Reason_SWAP,
+				pb.ValidateReply_Reason_OPEN,
+			}))
+		})
+
+		It("should fail for unknown devices", func() {
+			_, err := svr.Validate(ctx, &pb.ValidateRequest{Block: "/dev/sdz"})
+			Expect(err).NotTo(BeNil())
+		})
+	})
+
+	Context("destory", func() {
+		var backupDir string
+
+		BeforeEach(func() {
+			var err error
+			backupDir, err = ioutil.TempDir("", "lvmd-signatures")
+			Expect(err).To(BeNil())
+			commands.SetSignatureBackupDir(backupDir)
+			lvm.AddBlock("/dev/sde", 10*gib, "PTTYPE=gpt", "ext4")
+		})
+
+		AfterEach(func() {
+			commands.SetSignatureBackupDir("")
+			os.RemoveAll(backupDir)
+		})
+
+		signatures := func(block string) []string {
+			reply, err := svr.Destory(ctx, &pb.DestoryRequest{Block: block, DryRun: true})
+			Expect(err).To(BeNil())
+			var types []string
+			for _, sig := range reply.Signatures {
+				types = append(types, sig.Type)
+			}
+			return types
+		}
+
+		It("should only list the signatures in a dry run", func() {
+			reply, err := svr.Destory(ctx, &pb.DestoryRequest{Block: "/dev/sde", DryRun: true})
+			Expect(err).To(BeNil())
+			Expect(reply.Signatures).To(HaveLen(2))
+			Expect(reply.Signatures[1].Offset).To(Equal(uint64(0x438)))
+			Expect(reply.BackupDir).To(BeEmpty())
+			Expect(signatures("/dev/sde")).To(Equal([]string{"gpt", "ext4"}))
+		})
+
+		It("should refuse blocks in use", func() {
+			createVG("k8s", "/dev/sdb")
+			_, err := svr.Destory(ctx, &pb.DestoryRequest{Block: "/dev/sdb"})
+			Expect(status.Code(err)).To(Equal(codes.FailedPrecondition))
+			Expect(err.Error()).To(ContainSubstring("physical volume of volume group k8s"))
+
+			lvm.AddBlockDevice(fake.Block{Path: "/dev/sdf", Size: 10 * gib, Signatures: []string{"ext4"}, MountPoint: "/"})
+			_, err = svr.Destory(ctx, &pb.DestoryRequest{Block: "/dev/sdf", DryRun: true})
+			Expect(status.Code(err)).To(Equal(codes.FailedPrecondition))
+			Expect(err.Error()).To(ContainSubstring("mounted at /"))
+			Expect(signatures("/dev/sdd")).To(Equal([]string{"ext4"}))
+		})
+
+		It("should back up the erased signatures and restore them", func() {
+			reply, err := svr.Destory(ctx, &pb.DestoryRequest{Block: "/dev/sde"})
+			Expect(err).To(BeNil())
+			Expect(reply.Signatures).To(HaveLen(2))
+			Expect(reply.BackupDir).To(Equal(filepath.Join(backupDir, "sde")))
+			Expect(signatures("/dev/sde")).To(BeEmpty())
+			backups, err := filepath.Glob(filepath.Join(reply.BackupDir, "*.bak"))
+			Expect(err).To(BeNil())
+			Expect(backups).To(ConsistOf(
+				filepath.Join(reply.BackupDir, "wipefs-sde-0x00000200.bak"),
+				filepath.Join(reply.BackupDir, "wipefs-sde-0x00000438.bak"),
+			))
+			Expect(lvm.Calls()).To(ContainElement([]string{"wipefs", "--all", "--force", "--backup", "/dev/sde"}))
+
+			restored, err := svr.RestoreSignatures(ctx, &pb.RestoreSignaturesRequest{Block: "/dev/sde"})
+			Expect(err).To(BeNil())
+			Expect(restored.Signatures).To(HaveLen(2))
+			Expect(signatures("/dev/sde")).To(ConsistOf("gpt", "ext4"))
+
+			_, err = svr.RestoreSignatures(ctx, &pb.RestoreSignaturesRequest{Block: "/dev/sde"})
+			Expect(status.Code(err)).To(Equal(codes.NotFound))
+		})
+
+		It("should keep the older backups when the wipe fails", func() {
+			reply, err := svr.Destory(ctx, &pb.DestoryRequest{Block: "/dev/sde"})
+			Expect(err).To(BeNil())
+			lvm.AddBlockDevice(fake.Block{Path: "/dev/sde", Size: 10 * gib, Signatures: []string{"xfs"}, ReadOnly: true})
+			_, err = svr.Destory(ctx, &pb.DestoryRequest{Block: "/dev/sde"})
+			Expect(err).NotTo(BeNil())
+			backups, err := filepath.Glob(filepath.Join(reply.BackupDir, "*"))
+			Expect(err).To(BeNil())
+			Expect(backups).To(ConsistOf(
+				filepath.Join(reply.BackupDir, "wipefs-sde-0x00000200.bak"),
+				filepath.Join(reply.BackupDir, "wipefs-sde-0x00000438.bak"),
+			))
+		})
+
+		It("should not restore over new signatures", func() {
+			_, err := svr.Destory(ctx, &pb.DestoryRequest{Block: "/dev/sde"})
+			Expect(err).To(BeNil())
+			_, err = svr.CreatePV(ctx, &pb.CreatePVRequest{Block: "/dev/sde"})
+			Expect(err).To(BeNil())
+			_, err = svr.RestoreSignatures(ctx, &pb.RestoreSignaturesRequest{Block: "/dev/sde"})
+			Expect(status.Code(err)).To(Equal(codes.AlreadyExists))
+		})
+
+		It("should not restore without backups", func() {
+			commands.SetSignatureBackupDir("")
+			_, err := svr.Destory(ctx, &pb.DestoryRequest{Block: "/dev/sde"})
+			Expect(err).To(BeNil())
+			_, err = svr.RestoreSignatures(ctx, &pb.RestoreSignaturesRequest{Block: "/dev/sde"})
+			Expect(status.Code(err)).To(Equal(codes.FailedPrecondition))
+		})
+	})
+
+	Context("logical volumes", func() {
+		BeforeEach(func() {
+			createVG("k8s", "/dev/sdb")
+		})
+
+		It("should create, resize and remove a volume", func() {
+			created, err := svr.CreateLV(ctx, &pb.CreateLVRequest{VolumeGroup: "k8s", Name: "data", Size: gib, Tags: []string{"app"}})
+			Expect(err).To(BeNil())
+			Expect(created.Volume.Name).To(Equal("data"))
+			Expect(created.Volume.Size).To(Equal(gib))
+			Expect(created.Volume.Uuid).NotTo(BeEmpty())
+
+			lvs := listLV("k8s/data")
+			Expect(lvs).To(HaveLen(1))
+			Expect(lvs[0]).To(Equal(created.Volume))
+			Expect(lvs[0].Tags).To(Equal([]string{"app"}))
+
+			resized, err := svr.ResizeLV(ctx, &pb.ResizeLVRequest{VolumeGroup: "k8s", Name: "data", Size: 2 * gib})
+			Expect(err).To(BeNil())
+			Expect(resized.Volume.Size).To(Equal(2 * gib))
+			Expect(listLV("k8s/data")[0].Size).To(Equal(2 * gib))
+
+			_, err = svr.RemoveLV(ctx, &pb.RemoveLVRequest{VolumeGroup: "k8s", Name: "data"})
+			Expect(err).To(BeNil())
+			_, err = svr.ListLV(ctx, &pb.ListLVRequest{VolumeGroup: "k8s/data"})
+			Expect(err).NotTo(BeNil())
+		})
+
+		It("should round the size up to the extent size", func() {
+			_, err := svr.CreateLV(ctx, &pb.CreateLVRequest{VolumeGroup: "k8s", Name: "data", Size: 1})
+			Expect(err).To(BeNil())
+			Expect(listLV("k8s/data")[0].Size).To(Equal(fake.ExtentSize))
+		})
+
+		It("should fail when the volume group is out of space", func() {
+			_, err := svr.CreateLV(ctx, &pb.CreateLVRequest{VolumeGroup: "k8s", Name: "data", Size: 20 * gib})
+			Expect(err).NotTo(BeNil())
+		})
+
+		It("should refuse to remove a protected volume", func() {
+			_, err := svr.CreateLV(ctx, &pb.CreateLVRequest{VolumeGroup: "k8s", Name: "data", Size: gib})
+			Expect(err).To(BeNil())
+			tagged, err := svr.AddTagLV(ctx, &pb.AddTagLVRequest{VolumeGroup: "k8s", Name: "data", Tags: []string{commands.ProtectedTagName}})
+			Expect(err).To(BeNil())
+			Expect(tagged.Volume.Tags).To(ContainElement(commands.ProtectedTagName))
+
+			_, err = svr.RemoveLV(ctx, &pb.RemoveLVRequest{VolumeGroup: "k8s", Name: "data"})
+			Expect(err).NotTo(BeNil())
+			Expect(err.Error()).To(ContainSubstring("volume is protected"))
+
+			_, err = svr.RemoveTagLV(ctx, &pb.RemoveTagLVRequest{VolumeGroup: "k8s", Name: "data", Tags: []string{commands.ProtectedTagName}})
+			Expect(err).To(BeNil())
+			_, err = svr.RemoveLV(ctx, &pb.RemoveLVRequest{VolumeGroup: "k8s", Name: "data"})
+			Expect(err).To(BeNil())
+		})
+
+		It("should create thin volumes in a thin pool", func() {
+			pool, err := svr.CreateThinPool(ctx, &pb.CreateThinPoolRequest{VolumeGroup: "k8s", Pool: "pool"})
+			Expect(err).To(BeNil())
+			Expect(pool.Volume.Name).To(Equal("pool"))
+			thin, err := svr.CreateThinLV(ctx, &pb.CreateThinLVRequest{VolumeGroup: "k8s", Pool: "pool", Name: "thin", Size: 50 * gib})
+			Expect(err).To(BeNil())
+			Expect(thin.Volume.Name).To(Equal("thin"))
+
+			lvs := listLV("k8s/thin")
+			Expect(lvs).To(HaveLen(1))
+			Expect(lvs[0].Size).To(Equal(50 * gib))
+			Expect(lvs[0].Attributes.Type).To(Equal(pb.LogicalVolume_Attributes_THIN))
+			Expect(listLV("k8s/pool")[0].Attributes.Type).To(Equal(pb.LogicalVolume_Attributes_THIN_POOL))
+		})
+
+		It("should report the segments and usage of volumes", func() {
+			_, err := svr.CreateLV(ctx, &pb.CreateLVRequest{VolumeGroup: "k8s", Name: "data", Size: gib})
+			Expect(err).To(BeNil())
+			_, err = svr.CreateThinPool(ctx, &pb.CreateThinPoolRequest{VolumeGroup: "k8s", Pool: "pool", Size: 2 * gib})
+			Expect(err).To(BeNil())
+			_, err = svr.CreateThinLV(ctx, &pb.CreateThinLVRequest{VolumeGroup: "k8s", Pool: "pool", Name: "thin", Size: gib})
+			Expect(err).To(BeNil())
+			Expect(lvm.SetPoolUsage("k8s", "pool", 12.5, 3)).To(Succeed())
+
+			data := listLV("k8s/data")[0]
+			Expect(data.Segtype).To(Equal("linear"))
+			Expect(data.Stripes).To(Equal(uint32(1)))
+			Expect(data.Devices).To(Equal([]string{"/dev/sdb"}))
+			Expect(data.Segments).To(HaveLen(1))
+			Expect(data.Segments[0].Size).To(Equal(gib))
+			Expect(data.Segments[0].Devices[0].Name).To(Equal("/dev/sdb"))
+
+			pool := listLV("k8s/pool")[0]
+			Expect(pool.Segtype).To(Equal("thin-pool"))
+			Expect(pool.Devices).To(Equal([]string{"pool_tdata"}))
+			Expect(pool.DataPercent).To(Equal(12.5))
+			Expect(pool.MetadataPercent).To(Equal(3.0))
+
+			thin := listLV("k8s/thin")[0]
+			Expect(thin.Segtype).To(Equal("thin"))
+			Expect(thin.PoolLv).To(Equal("pool"))
+			Expect(thin.Devices).To(BeEmpty())
+		})
+	})
+
+	Context("thin pools", func() {
+		BeforeEach(func() {
+			createVG("k8s", "/dev/sdb")
+		})
+
+		lvcreate := func() []string {
+			var last []string
+			for _, call := range lvm.Calls() {
+				if call[0] == "lvcreate" {
+					last = call
+				}
+			}
+			return last
+		}
+
+		It("should create a pool of the given size with its settings", func() {
+			reply, err := svr.CreateThinPool(ctx, &pb.CreateThinPoolRequest{
+				VolumeGroup:  "k8s",
+				Pool:         "pool",
+				Size:         2 * gib,
+				MetadataSize: 64 * 1024 * 1024,
+				ChunkSize:    256 * 1024,
+				Zeroing:      pb.CreateThinPoolRequest_ZEROING_DISABLED,
+				Discards:     pb.CreateThinPoolRequest_DISCARDS_NOPASSDOWN,
+			})
+			Expect(err).To(BeNil())
+			Expect(reply.Volume.Size).To(Equal(2 * gib))
+			Expect(reply.Volume.Attributes.Zeroing).To(BeFalse())
+			Expect(lvcreate()).To(ContainElement("--poolmetadatasize"))
+			Expect(lvcreate()).To(ContainElement("262144b"))
+			Expect(lvcreate()).To(ContainElement("nopassdown"))
+
+			// thick volumes fit beside the pool
+			_, err = svr.CreateLV(ctx, &pb.CreateLVRequest{VolumeGroup: "k8s", Name: "data", Size: 4 * gib})
+			Expect(err).To(BeNil())
+		})
+
+		It("should take a percentage of the free space", func() {
+			reply, err := svr.CreateThinPool(ctx, &pb.CreateThinPoolRequest{VolumeGroup: "k8s", Pool: "pool", PercentFree: 50})
+			Expect(err).To(BeNil())
+			Expect(reply.Volume.Size).To(BeNumerically("~", 5*gib, 8*1024*1024))
+			Expect(lvcreate()).To(ContainElement("50%FREE"))
+		})
+
+		It("should validate the options before creating the pool", func() {
+			requests := []*pb.CreateThinPoolRequest{
+				{VolumeGroup: "k8s", Pool: "pool", Size: gib, PercentFree: 50},
+				{VolumeGroup: "k8s", Pool: "pool", PercentFree: 101},
+				{VolumeGroup: "k8s", Pool: "pool", ChunkSize: 100 * 1024},
+				{VolumeGroup: "k8s", Pool: "pool", MetadataSize: 1024},
+			}
+			for _, req := range requests {
+				_, err := svr.CreateThinPool(ctx, req)
+				Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+			}
+
+			_, err := svr.CreateThinPool(ctx, &pb.CreateThinPoolRequest{VolumeGroup: "k8s", Pool: "pool", Size: 10 * gib})
+			Expect(status.Code(err)).To(Equal(codes.ResourceExhausted))
+			Expect(lvcreate()).To(BeNil())
+		})
+
+		It("should accept an existing pool of the same size only", func() {
+			_, err := svr.CreateThinPool(ctx, &pb.CreateThinPoolRequest{VolumeGroup: "k8s", Pool: "pool", Size: gib})
+			Expect(err).To(BeNil())
+			_, err = svr.CreateThinPool(ctx, &pb.CreateThinPoolRequest{VolumeGroup: "k8s", Pool: "pool", Size: gib})
+			Expect(err).To(BeNil())
+			_, err = svr.CreateThinPool(ctx, &pb.CreateThinPoolRequest{VolumeGroup: "k8s", Pool: "pool", Size: 2 * gib})
+			Expect(status.Code(err)).To(Equal(codes.AlreadyExists))
+		})
+
+		It("should report the usage of a pool", func() {
+			_, err := svr.CreateThinPool(ctx, &pb.CreateThinPoolRequest{VolumeGroup: "k8s", Pool: "pool", Size: 2 * gib, ChunkSize: 128 * 1024})
+			Expect(err).To(BeNil())
+			for _, name := range []string{"a", "b"} {
+				_, err = svr.CreateThinLV(ctx, &pb.CreateThinLVRequest{VolumeGroup: "k8s", Pool: "pool", Name: name, Size: 3 * gib})
+				Expect(err).To(BeNil())
+			}
+			Expect(lvm.SetPoolUsage("k8s", "pool", 42.5, 7.25)).To(Succeed())
+
+			reply, err := svr.GetThinPoolStatus(ctx, &pb.GetThinPoolStatusRequest{VolumeGroup: "k8s", Pool: "pool"})
+			Expect(err).To(BeNil())
+			Expect(reply.Status.Size).To(Equal(2 * gib))
+			Expect(reply.Status.DataPercent).To(Equal(42.5))
+			Expect(reply.Status.MetadataPercent).To(Equal(7.25))
+			Expect(reply.Status.ChunkSize).To(Equal(uint64(128 * 1024)))
+			Expect(reply.Status.TransactionId).To(Equal(uint64(2)))
+			Expect(reply.Status.VirtualSize).To(Equal(6 * gib))
+			Expect(reply.Status.ThinVolumes).To(Equal(uint32(2)))
+			Expect(reply.Status.OvercommitRatio).To(Equal(3.0))
+		})
+
+		It("should store the autoextend policy of a pool", func() {
+			_, err := svr.CreateThinPool(ctx, &pb.CreateThinPoolRequest{VolumeGroup: "k8s", Pool: "pool", Size: gib})
+			Expect(err).To(BeNil())
+			status, err := svr.GetThinPoolStatus(ctx, &pb.GetThinPoolStatusRequest{VolumeGroup: "k8s", Pool: "pool"})
+			Expect(err).To(BeNil())
+			Expect(status.Status.Policy).To(BeNil())
+
+			for _, percent := range []uint32{20, 50} {
+				policy := &pb.ThinPoolPolicy{Threshold: 80, ExtendPercent: percent}
+				reply, err := svr.SetThinPoolPolicy(ctx, &pb.SetThinPoolPolicyRequest{VolumeGroup: "k8s", Pool: "pool", Policy: policy})
+				Expect(err).To(BeNil())
+				Expect(reply.Status.Policy).To(Equal(policy))
+			}
+			lv, err := commands.GetLV(ctx, "k8s", "pool")
+			Expect(err).To(BeNil())
+			Expect(lv.Tags).To(ConsistOf(commands.ManagedTag, "lvmd.autoextend=80:50"))
+		})
+
+		It("should validate autoextend policies", func() {
+			_, err := svr.CreateThinPool(ctx, &pb.CreateThinPoolRequest{VolumeGroup: "k8s", Pool: "pool", Size: gib})
+			Expect(err).To(BeNil())
+			for _, policy := range []*pb.ThinPoolPolicy{{Threshold: 101, ExtendPercent: 20}, {Threshold: 80}} {
+				_, err := svr.SetThinPoolPolicy(ctx, &pb.SetThinPoolPolicyRequest{VolumeGroup: "k8s", Pool: "pool", Policy: policy})
+				Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+			}
+			_, err = svr.SetThinPoolPolicy(ctx, &pb.SetThinPoolPolicyRequest{VolumeGroup: "k8s", Pool: "missing"})
+			Expect(status.Code(err)).To(Equal(codes.NotFound))
+		})
+
+		It("should only report thin pools", func() {
+			_, err := svr.GetThinPoolStatus(ctx, &pb.GetThinPoolStatusRequest{VolumeGroup: "k8s", Pool: "pool"})
+			Expect(status.Code(err)).To(Equal(codes.NotFound))
+			_, err = svr.CreateLV(ctx, &pb.CreateLVRequest{VolumeGroup: "k8s", Name: "data", Size: gib})
+			Expect(err).To(BeNil())
+			_, err = svr.GetThinPoolStatus(ctx, &pb.GetThinPoolStatusRequest{VolumeGroup: "k8s", Pool: "data"})
+			Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+		})
+	})
+
+	Context("snapshots", func() {
+		BeforeEach(func() {
+			createVG("k8s", "/dev/sdb")
+			_, err := svr.CreateLV(ctx, &pb.CreateLVRequest{VolumeGroup: "k8s", Name: "data", Size: gib})
+			Expect(err).To(BeNil())
+			_, err = svr.CreateThinPool(ctx, &pb.CreateThinPoolRequest{VolumeGroup: "k8s", Pool: "pool"})
+			Expect(err).To(BeNil())
+			_, err = svr.CreateThinLV(ctx, &pb.CreateThinLVRequest{VolumeGroup: "k8s", Pool: "pool", Name: "thin", Size: gib})
+			Expect(err).To(BeNil())
+		})
+
+		It("should create a thick snapshot with copy-on-write space", func() {
+			_, err := svr.CreateSnapshot(ctx, &pb.CreateSnapshotRequest{VolumeGroup: "k8s", Origin: "data", Name: "snap"})
+			Expect(err).NotTo(BeNil())
+
+			_, err = svr.ExtendVG(ctx, &pb.ExtendVGRequest{Name: "k8s", PhysicalVolume: "/dev/sdc"})
+			Expect(err).To(BeNil())
+			_, err = svr.CreateSnapshot(ctx, &pb.CreateSnapshotRequest{VolumeGroup: "k8s", Origin: "data", Name: "snap", Size: gib / 4})
+			Expect(err).To(BeNil())
+
+			reply, err := svr.ListSnapshots(ctx, &pb.ListSnapshotsRequest{VolumeGroup: "k8s"})
+			Expect(err).To(BeNil())
+			Expect(reply.Snapshots).To(HaveLen(1))
+			snap := reply.Snapshots[0]
+			Expect(snap.Name).To(Equal("snap"))
+			Expect(snap.Origin).To(Equal("data"))
+			Expect(snap.SnapPercent).To(Equal("0.00"))
+			Expect(snap.Attributes.Type).To(Equal(pb.LogicalVolume_Attributes_SNAPSHOT))
+			Expect(listLV("k8s/data")[0].Attributes.Type).To(Equal(pb.LogicalVolume_Attributes_ORIGIN))
+		})
+
+		It("should create sizeless snapshots of thin volumes", func() {
+			_, err := svr.CreateSnapshot(ctx, &pb.CreateSnapshotRequest{VolumeGroup: "k8s", Origin: "thin", Name: "snap", Size: gib})
+			Expect(err).NotTo(BeNil())
+			_, err = svr.CreateSnapshot(ctx, &pb.CreateSnapshotRequest{VolumeGroup: "k8s", Origin: "thin", Name: "snap"})
+			Expect(err).To(BeNil())
+
+			reply, err := svr.ListSnapshots(ctx, &pb.ListSnapshotsRequest{VolumeGroup: "k8s", Origin: "thin"})
+			Expect(err).To(BeNil())
+			Expect(reply.Snapshots).To(HaveLen(1))
+			Expect(reply.Snapshots[0].Attributes.Type).To(Equal(pb.LogicalVolume_Attributes_THIN))
+
+			reply, err = svr.ListSnapshots(ctx, &pb.ListSnapshotsRequest{VolumeGroup: "k8s", Origin: "data"})
+			Expect(err).To(BeNil())
+			Expect(reply.Snapshots).To(BeEmpty())
+		})
+
+		It("should merge a snapshot into its origin", func() {
+			_, err := svr.MergeSnapshot(ctx, &pb.MergeSnapshotRequest{VolumeGroup: "k8s", Name: "thin"})
+			Expect(err).NotTo(BeNil())
+
+			snap, err := svr.CreateSnapshot(ctx, &pb.CreateSnapshotRequest{VolumeGroup: "k8s", Origin: "thin", Name: "snap"})
+			Expect(err).To(BeNil())
+			Expect(snap.Volume.Origin).To(Equal("thin"))
+			merged, err := svr.MergeSnapshot(ctx, &pb.MergeSnapshotRequest{VolumeGroup: "k8s", Name: "snap"})
+			Expect(err).To(BeNil())
+			Expect(merged.Origin.Name).To(Equal("thin"))
+
+			reply, err := svr.ListSnapshots(ctx, &pb.ListSnapshotsRequest{VolumeGroup: "k8s"})
+			Expect(err).To(BeNil())
+			Expect(reply.Snapshots).To(BeEmpty())
+			Expect(listLV("k8s/thin")).To(HaveLen(1))
+		})
+	})
+
+	Context("clone", func() {
+		var devDir string
+
+		BeforeEach(func() {
+			createVG("k8s", "/dev/sdb")
+			var err error
+			devDir, err = ioutil.TempDir("", "lvmd-dev")
+			Expect(err).To(BeNil())
+			Expect(os.Mkdir(filepath.Join(devDir, "k8s"), 0755)).To(Succeed())
+			commands.DevDir = devDir
+		})
+
+		AfterEach(func() {
+			os.RemoveAll(devDir)
+			commands.DevDir = "/dev"
+		})
+
+		createDevice := func(name string, size uint64, content string) {
+			_, err := svr.CreateLV(ctx, &pb.CreateLVRequest{VolumeGroup: "k8s", Name: name, Size: size})
+			Expect(err).To(BeNil())
+			path := filepath.Join(devDir, "k8s", name)
+			Expect(ioutil.WriteFile(path, []byte(content), 0644)).To(Succeed())
+			Expect(os.Truncate(path, int64(size))).To(Succeed())
+		}
+
+		It("should copy the source into the destination and report progress", func() {
+			createDevice("src", 2*fake.ExtentSize, "content")
+			createDevice("dst", 3*fake.ExtentSize, "garbage")
+
+			stream := &cloneStream{ctx: ctx}
+			err := svr.CloneLV(&pb.CloneLVRequest{VolumeGroup: "k8s", SourceName: "src", DestName: "dst"}, stream)
+			Expect(err).To(BeNil())
+			last := stream.reports[len(stream.reports)-1]
+			Expect(last.Done).To(BeTrue())
+			Expect(last.BytesCopied).To(Equal(2 * fake.ExtentSize))
+			Expect(last.TotalBytes).To(Equal(2 * fake.ExtentSize))
+
+			data, err := ioutil.ReadFile(filepath.Join(devDir, "k8s", "dst"))
+			Expect(err).To(BeNil())
+			Expect(string(data[:7])).To(Equal("content"))
+		})
+
+		It("should write the zeroes to thin volumes of pools ignoring discards", func() {
+			createDevice("src", 2*fake.ExtentSize, "content")
+			_, err := svr.CreateThinPool(ctx, &pb.CreateThinPoolRequest{VolumeGroup: "k8s", Pool: "pool", Size: gib,
+				Discards: pb.CreateThinPoolRequest_DISCARDS_IGNORE})
+			Expect(err).To(BeNil())
+			_, err = svr.CreateThinLV(ctx, &pb.CreateThinLVRequest{VolumeGroup: "k8s", Pool: "pool", Name: "dst", Size: 2 * fake.ExtentSize})
+			Expect(err).To(BeNil())
+			garbage := bytes.Repeat([]byte("garbage"), int(2*fake.ExtentSize/7))
+			Expect(ioutil.WriteFile(filepath.Join(devDir, "k8s", "dst"), garbage, 0644)).To(Succeed())
+
+			stream := &cloneStream{ctx: ctx}
+			err = svr.CloneLV(&pb.CloneLVRequest{VolumeGroup: "k8s", SourceName: "src", DestName: "dst"}, stream)
+			Expect(err).To(BeNil())
+			Expect(stream.reports[len(stream.reports)-1].BytesSkipped).To(BeZero())
+
+			data, err := ioutil.ReadFile(filepath.Join(devDir, "k8s", "dst"))
+			Expect(err).To(BeNil())
+			Expect(string(data[:7])).To(Equal("content"))
+			Expect(bytes.Count(data, []byte("garbage"))).To(BeZero())
+		})
+
+		It("should refuse a destination smaller than the source", func() {
+			createDevice("src", 2*fake.ExtentSize, "content")
+			createDevice("dst", fake.ExtentSize, "")
+
+			err := svr.CloneLV(&pb.CloneLVRequest{VolumeGroup: "k8s", SourceName: "src", DestName: "dst"}, &cloneStream{ctx: ctx})
+			Expect(err).NotTo(BeNil())
+			err = svr.CloneLV(&pb.CloneLVRequest{VolumeGroup: "k8s", SourceName: "src", DestName: "src"}, &cloneStream{ctx: ctx})
+			Expect(err).NotTo(BeNil())
+		})
+	})
+
+	Context("retried creates", func() {
+		It("should accept existing pvs and volume groups", func() {
+			createVG("k8s", "/dev/sdb")
+			_, err := svr.CreatePV(ctx, &pb.CreatePVRequest{Block: "/dev/sdb"})
+			Expect(err).To(BeNil())
+			_, err = svr.CreateVG(ctx, &pb.CreateVGRequest{Name: "k8s", PhysicalVolume: "/dev/sdb"})
+			Expect(err).To(BeNil())
+
+			_, err = svr.CreatePV(ctx, &pb.CreatePVRequest{Block: "/dev/sdc"})
+			Expect(err).To(BeNil())
+			_, err = svr.CreateVG(ctx, &pb.CreateVGRequest{Name: "k8s", PhysicalVolume: "/dev/sdc"})
+			Expect(status.Code(err)).To(Equal(codes.AlreadyExists))
+			_, err = svr.CreateVG(ctx, &pb.CreateVGRequest{Name: "k8s", PhysicalVolume: "/dev/sdb", Tags: []string{"other"}})
+			Expect(status.Code(err)).To(Equal(codes.AlreadyExists))
+		})
+
+		It("should accept an existing volume with the same spec", func() {
+			createVG("k8s", "/dev/sdb")
+			req := &pb.CreateLVRequest{VolumeGroup: "k8s", Name: "data", Size: gib - 1, Tags: []string{"app"}}
+			_, err := svr.CreateLV(ctx, req)
+			Expect(err).To(BeNil())
+			_, err = svr.CreateLV(ctx, req)
+			Expect(err).To(BeNil())
+			Expect(listLV("k8s")).To(HaveLen(1))
+
+			_, err = svr.CreateLV(ctx, &pb.CreateLVRequest{VolumeGroup: "k8s", Name: "data", Size: 2 * gib, Tags: []string{"app"}})
+			Expect(status.Code(err)).To(Equal(codes.AlreadyExists))
+			_, err = svr.CreateLV(ctx, &pb.CreateLVRequest{VolumeGroup: "k8s", Name: "data", Size: gib, Tags: []string{"other"}})
+			Expect(status.Code(err)).To(Equal(codes.AlreadyExists))
+		})
+
+		It("should accept an existing thin pool and thin volume", func() {
+			createVG("k8s", "/dev/sdb")
+			pool := &pb.CreateThinPoolRequest{VolumeGroup: "k8s", Pool: "pool"}
+			_, err := svr.CreateThinPool(ctx, pool)
+			Expect(err).To(BeNil())
+			_, err = svr.CreateThinPool(ctx, pool)
+			Expect(err).To(BeNil())
+
+			thin := &pb.CreateThinLVRequest{VolumeGroup: "k8s", Pool: "pool", Name: "thin", Size: gib}
+			_, err = svr.CreateThinLV(ctx, thin)
+			Expect(err).To(BeNil())
+			_, err = svr.CreateThinLV(ctx, thin)
+			Expect(err).To(BeNil())
+
+			_, err = svr.CreateLV(ctx, &pb.CreateLVRequest{VolumeGroup: "k8s", Name: "thin", Size: gib})
+			Expect(status.Code(err)).To(Equal(codes.AlreadyExists))
+			_, err = svr.CreateThinPool(ctx, &pb.CreateThinPoolRequest{VolumeGroup: "k8s", Pool: "thin"})
+			Expect(status.Code(err)).To(Equal(codes.AlreadyExists))
+		})
+	})
+
+	Context("resize", func() {
+		BeforeEach(func() {
+			createVG("k8s", "/dev/sdb")
+			_, err := svr.CreateLV(ctx, &pb.CreateLVRequest{VolumeGroup: "k8s", Name: "data", Size: gib})
+			Expect(err).To(BeNil())
+		})
+
+		ran := func(name string) bool {
+			for _, call := range lvm.Calls() {
+				if call[0] == name {
+					return true
+				}
+			}
+			return false
+		}
+
+		resize := func(size uint64, blockOnly bool) (*pb.ResizeLVReply, error) {
+			return svr.ResizeLV(ctx, &pb.ResizeLVRequest{VolumeGroup: "k8s", Name: "data", Size: size, BlockOnly: blockOnly})
+		}
+
+		It("should check and grow an unmounted ext4 filesystem offline", func() {
+			Expect(lvm.Format("k8s", "data", "ext4")).To(Succeed())
+			reply, err := resize(2*gib, false)
+			Expect(err).To(BeNil())
+			Expect(reply.Volume.Size).To(Equal(2 * gib))
+			Expect(ran("e2fsck")).To(BeTrue())
+			Expect(ran("resize2fs")).To(BeTrue())
+		})
+
+		It("should grow mounted filesystems online", func() {
+			Expect(lvm.Format("k8s", "data", "ext4")).To(Succeed())
+			Expect(lvm.Mount("k8s", "data", "/mnt/data")).To(Succeed())
+			_, err := resize(2*gib, false)
+			Expect(err).To(BeNil())
+			Expect(ran("e2fsck")).To(BeFalse())
+			Expect(ran("resize2fs")).To(BeTrue())
+
+			Expect(lvm.Format("k8s", "data", "xfs")).To(Succeed())
+			reply, err := resize(3*gib, false)
+			Expect(err).To(BeNil())
+			Expect(reply.CommandOutput).To(ContainSubstring("xfs_growfs: resized /mnt/data"))
+
+			Expect(lvm.Format("k8s", "data", "btrfs")).To(Succeed())
+			reply, err = resize(4*gib, false)
+			Expect(err).To(BeNil())
+			Expect(reply.CommandOutput).To(ContainSubstring("btrfs: resized /mnt/data"))
+		})
+
+		It("should refuse to grow an unmounted xfs filesystem before resizing the volume", func() {
+			Expect(lvm.Format("k8s", "data", "xfs")).To(Succeed())
+			_, err := resize(2*gib, false)
+			Expect(status.Code(err)).To(Equal(codes.FailedPrecondition))
+			Expect(listLV("k8s/data")[0].Size).To(Equal(gib))
+			Expect(ran("lvresize")).To(BeFalse())
+		})
+
+		It("should only shrink volumes without a filesystem", func() {
+			Expect(lvm.Format("k8s", "data", "ext4")).To(Succeed())
+			_, err := resize(gib/2, false)
+			Expect(status.Code(err)).To(Equal(codes.FailedPrecondition))
+			_, err = resize(gib/2, true)
+			Expect(status.Code(err)).To(Equal(codes.FailedPrecondition))
+
+			Expect(lvm.Format("k8s", "data", "")).To(Succeed())
+			reply, err := resize(gib/2, false)
+			Expect(err).To(BeNil())
+			Expect(reply.Volume.Size).To(Equal(gib / 2))
+		})
+
+		It("should leave the filesystem alone in block only mode", func() {
+			Expect(lvm.Format("k8s", "data", "xfs")).To(Succeed())
+			reply, err := resize(2*gib, true)
+			Expect(err).To(BeNil())
+			Expect(reply.Volume.Size).To(Equal(2 * gib))
+			Expect(ran("blkid")).To(BeFalse())
+			Expect(ran("xfs_growfs")).To(BeFalse())
+		})
+
+		It("should grow the filesystem when the volume already has the size", func() {
+			Expect(lvm.Format("k8s", "data", "ext4")).To(Succeed())
+			_, err := resize(gib, false)
+			Expect(err).To(BeNil())
+			Expect(ran("lvresize")).To(BeFalse())
+			Expect(ran("resize2fs")).To(BeTrue())
+		})
+	})
+
+	Context("report formats", func() {
+		BeforeEach(func() {
+			createVG("k8s", "/dev/sdb")
+			_, err := svr.CreateThinPool(ctx, &pb.CreateThinPoolRequest{VolumeGroup: "k8s", Pool: "pool", Size: 2 * gib})
+			Expect(err).To(BeNil())
+			_, err = svr.CreateThinLV(ctx, &pb.CreateThinLVRequest{VolumeGroup: "k8s", Pool: "pool", Name: "thin", Size: gib, Tags: []string{"a", "b"}})
+			Expect(err).To(BeNil())
+		})
+
+		AfterEach(func() {
+			commands.SetReportFormat(commands.ReportFormatBasic)
+		})
+
+		It("should detect whether lvm reports in json", func() {
+			format, err := commands.DetectReportFormat(ctx)
+			Expect(err).To(BeNil())
+			Expect(format).To(Equal(commands.ReportFormatJSON))
+
+			lvm.SetLegacyReports(true)
+			format, err = commands.DetectReportFormat(ctx)
+			Expect(err).To(BeNil())
+			Expect(format).To(Equal(commands.ReportFormatBasic))
+		})
+
+		It("should parse the reports despite warnings on stderr", func() {
+			lvm.SetWarning("Failed to connect to lvmetad. Falling back to device scanning.")
+			format, err := commands.DetectReportFormat(ctx)
+			Expect(err).To(BeNil())
+			Expect(format).To(Equal(commands.ReportFormatJSON))
+
+			commands.SetReportFormat(commands.ReportFormatJSON)
+			vgs, err := svr.ListVG(ctx, &pb.ListVGRequest{})
+			Expect(err).To(BeNil())
+			Expect(vgs.VolumeGroups).NotTo(BeEmpty())
+		})
+
+		It("should report the same inventory in both formats", func() {
+			list := func() []interface{} {
+				lvs, err := svr.ListLV(ctx, &pb.ListLVRequest{VolumeGroup: "k8s"})
+				Expect(err).To(BeNil())
+				vgs, err := svr.ListVG(ctx, &pb.ListVGRequest{})
+				Expect(err).To(BeNil())
+				pvs, err := svr.ListPV(ctx, &pb.ListPVRequest{})
+				Expect(err).To(BeNil())
+				pool, err := svr.GetThinPoolStatus(ctx, &pb.GetThinPoolStatusRequest{VolumeGroup: "k8s", Pool: "pool"})
+				Expect(err).To(BeNil())
+				return []interface{}{lvs, vgs, pvs, pool}
+			}
+			basic := list()
+			commands.SetReportFormat(commands.ReportFormatJSON)
+			json := list()
+			Expect(json).To(Equal(basic))
+			Expect(lvm.Calls()[len(lvm.Calls())-1]).To(ContainElement("json"))
+
+			lv, err := commands.GetLV(ctx, "k8s", "thin")
+			Expect(err).To(BeNil())
+			Expect(lv.Tags).To(Equal([]string{"a", "b"}))
+		})
+	})
+
+	Context("errors", func() {
+		BeforeEach(func() {
+			createVG("k8s", "/dev/sdb")
+		})
+
+		It("should map lvm failures to grpc codes", func() {
+			_, err := svr.CreateLV(ctx, &pb.CreateLVRequest{VolumeGroup: "k8s", Name: "data", Size: 20 * gib})
+			Expect(status.Code(err)).To(Equal(codes.ResourceExhausted))
+			_, err = svr.CreateLV(ctx, &pb.CreateLVRequest{VolumeGroup: "k8s", Name: "data"})
+			Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+			_, err = svr.CreateLV(ctx, &pb.CreateLVRequest{VolumeGroup: "none", Name: "data", Size: gib})
+			Expect(status.Code(err)).To(Equal(codes.NotFound))
+
+			_, err = svr.CreateLV(ctx, &pb.CreateLVRequest{VolumeGroup: "k8s", Name: "data", Size: gib, Tags: []string{commands.ProtectedTagName}})
+			Expect(err).To(BeNil())
+			_, err = svr.RemoveLV(ctx, &pb.RemoveLVRequest{VolumeGroup: "k8s", Name: "data"})
+			Expect(status.Code(err)).To(Equal(codes.FailedPrecondition))
+			_, err = svr.RemoveLV(ctx, &pb.RemoveLVRequest{VolumeGroup: "k8s", Name: "missing"})
+			Expect(status.Code(err)).To(Equal(codes.NotFound))
+			_, err = svr.RemovePV(ctx, &pb.RemovePVRequest{Block: "/dev/sdb"})
+			Expect(status.Code(err)).To(Equal(codes.FailedPrecondition))
+		})
+
+		It("should attach the exit status and stderr of the failed command", func() {
+			_, err := svr.CreateLV(ctx, &pb.CreateLVRequest{VolumeGroup: "k8s", Name: "data", Size: 20 * gib})
+			Expect(status.Convert(err).Message()).To(Equal("failed to create lv: lvcreate failed: INSUFFICIENT_SPACE"))
+			details := status.Convert(err).Details()
+			Expect(details).To(HaveLen(2))
+
+			info, ok := details[0].(*errdetails.ErrorInfo)
+			Expect(ok).To(BeTrue())
+			Expect(info.Type).To(Equal("INSUFFICIENT_SPACE"))
+			Expect(info.Metadata).To(Equal(map[string]string{"command": "lvcreate", "exit_status": "5"}))
+
+			debug, ok := details[1].(*errdetails.DebugInfo)
+			Expect(ok).To(BeTrue())
+			Expect(debug.Detail).To(ContainSubstring("insufficient free space"))
+		})
+	})
+
+	Context("cancellation", func() {
+		It("should report the deadline and cancellation of the caller", func() {
+			deadline, cancel := context.WithTimeout(ctx, time.Millisecond)
+			defer cancel()
+			<-deadline.Done()
+			_, err := svr.CreatePV(deadline, &pb.CreatePVRequest{Block: "/dev/sdb"})
+			Expect(status.Code(err)).To(Equal(codes.DeadlineExceeded))
+
+			canceled, cancel := context.WithCancel(ctx)
+			cancel()
+			_, err = svr.ListLV(canceled, &pb.ListLVRequest{})
+			Expect(status.Code(err)).To(Equal(codes.Canceled))
+		})
+	})
+})
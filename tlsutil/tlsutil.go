@@ -0,0 +1,193 @@
+// Package tlsutil loads the certificates of the lvmd server and clients and
+// reloads them when the files are rotated, so long running processes pick
+// up renewed certificates without a restart.
+package tlsutil
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/zdnscloud/cement/log"
+)
+
+// ReloadInterval is the minimum time between two checks of the files for
+// changes, the check runs on the next handshake once it elapsed
+var ReloadInterval = 10 * time.Second
+
+// Credentials holds a certificate and the CAs used to verify the peer,
+// both are optional for clients
+type Credentials struct {
+	certFile string
+	keyFile  string
+	caFile   string
+
+	lock     sync.Mutex
+	checked  time.Time
+	modTimes []time.Time
+	cert     *tls.Certificate
+	caPool   *x509.CertPool
+}
+
+// Load reads the certificate and key in PEM format and the CA bundle used
+// to verify peers, certFile and keyFile must be given together
+func Load(certFile, keyFile, caFile string) (*Credentials, error) {
+	if (certFile == "") != (keyFile == "") {
+		return nil, errors.New("certificate and key must be given together")
+	}
+	c := &Credentials{certFile: certFile, keyFile: keyFile, caFile: caFile}
+	if err := c.reload(); err != nil {
+		return nil, err
+	}
+	c.checked = time.Now()
+	return c, nil
+}
+
+func (c *Credentials) files() []string {
+	var files []string
+	for _, f := range []string{c.certFile, c.keyFile, c.caFile} {
+		if f != "" {
+			files = append(files, f)
+		}
+	}
+	return files
+}
+
+func (c *Credentials) reload() error {
+	var modTimes []time.Time
+	for _, f := range c.files() {
+		info, err := os.Stat(f)
+		if err != nil {
+			return err
+		}
+		modTimes = append(modTimes, info.ModTime())
+	}
+
+	var cert *tls.Certificate
+	if c.certFile != "" {
+		pair, err := tls.LoadX509KeyPair(c.certFile, c.keyFile)
+		if err != nil {
+			return fmt.Errorf("failed to load certificate: %v", err)
+		}
+		cert = &pair
+	}
+	var pool *x509.CertPool
+	if c.caFile != "" {
+		pem, err := ioutil.ReadFile(c.caFile)
+		if err != nil {
+			return err
+		}
+		pool = x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(pem) {
+			return fmt.Errorf("no certificate found in %s", c.caFile)
+		}
+	}
+
+	c.cert, c.caPool, c.modTimes = cert, pool, modTimes
+	return nil
+}
+
+func (c *Credentials) changed() bool {
+	for i, f := range c.files() {
+		info, err := os.Stat(f)
+		if err != nil || !info.ModTime().Equal(c.modTimes[i]) {
+			return true
+		}
+	}
+	return false
+}
+
+// current returns the loaded certificate and CAs, reloading them when the
+// files changed. A failed reload keeps the previous ones, a rotation may
+// replace the files one by one and the next check picks up the complete set
+func (c *Credentials) current() (*tls.Certificate, *x509.CertPool) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if time.Since(c.checked) >= ReloadInterval {
+		c.checked = time.Now()
+		if c.changed() {
+			if err := c.reload(); err != nil {
+				log.Warnf("reload tls credentials failed, keep the previous ones:%s", err.Error())
+			}
+		}
+	}
+	return c.cert, c.caPool
+}
+
+// ServerConfig returns the tls config of a server, clients must present a
+// certificate signed by the CAs when a CA bundle was loaded
+func (c *Credentials) ServerConfig() (*tls.Config, error) {
+	if c.certFile == "" {
+		return nil, errors.New("server requires a certificate")
+	}
+	config := &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		GetCertificate: func(*tls.ClientHelloInfo) (*tls.Certificate, error) {
+			cert, _ := c.current()
+			return cert, nil
+		},
+	}
+	if c.caFile != "" {
+		// the client certificate is verified against the current CAs by
+		// hand, tls.Config.ClientCAs can't be swapped once serving
+		config.ClientAuth = tls.RequireAnyClientCert
+		config.VerifyPeerCertificate = c.verifier("", x509.ExtKeyUsageClientAuth)
+	}
+	return config, nil
+}
+
+// ClientConfig returns the tls config of a client connecting to serverName,
+// the server is verified against the loaded CAs, or the system CAs when no
+// CA bundle was loaded
+func (c *Credentials) ClientConfig(serverName string) *tls.Config {
+	config := &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		ServerName: serverName,
+		GetClientCertificate: func(*tls.CertificateRequestInfo) (*tls.Certificate, error) {
+			if cert, _ := c.current(); cert != nil {
+				return cert, nil
+			}
+			return &tls.Certificate{}, nil
+		},
+	}
+	if c.caFile != "" {
+		// verified by hand against the current CAs, see ServerConfig
+		config.InsecureSkipVerify = true
+		config.VerifyPeerCertificate = c.verifier(serverName, x509.ExtKeyUsageServerAuth)
+	}
+	return config
+}
+
+func (c *Credentials) verifier(dnsName string, usage x509.ExtKeyUsage) func([][]byte, [][]*x509.Certificate) error {
+	return func(rawCerts [][]byte, _ [][]*x509.Certificate) error {
+		if len(rawCerts) == 0 {
+			return errors.New("peer presented no certificate")
+		}
+		certs := make([]*x509.Certificate, len(rawCerts))
+		for i, raw := range rawCerts {
+			cert, err := x509.ParseCertificate(raw)
+			if err != nil {
+				return fmt.Errorf("failed to parse peer certificate: %v", err)
+			}
+			certs[i] = cert
+		}
+
+		_, roots := c.current()
+		opts := x509.VerifyOptions{
+			Roots:         roots,
+			DNSName:       dnsName,
+			Intermediates: x509.NewCertPool(),
+			KeyUsages:     []x509.ExtKeyUsage{usage},
+		}
+		for _, cert := range certs[1:] {
+			opts.Intermediates.AddCert(cert)
+		}
+		_, err := certs[0].Verify(opts)
+		return err
+	}
+}
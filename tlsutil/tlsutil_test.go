@@ -0,0 +1,211 @@
+package tlsutil
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"io/ioutil"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/zdnscloud/cement/log"
+)
+
+func TestTLSUtil(t *testing.T) {
+	log.InitLogger(log.Debug)
+	defer log.CloseLogger()
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "TLSUtil Suite")
+}
+
+type issuer struct {
+	cert *x509.Certificate
+	key  *ecdsa.PrivateKey
+}
+
+var serial int64
+
+// issue creates a certificate signed by ca, or a self signed CA when ca is
+// nil, and writes it and its key to dir/name.crt and dir/name.key
+func issue(dir, name string, ca *issuer, usage x509.ExtKeyUsage, dnsNames ...string) *issuer {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	Expect(err).To(BeNil())
+	serial++
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(serial),
+		Subject:      pkix.Name{CommonName: name},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		DNSNames:     dnsNames,
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{usage},
+	}
+	parent, signer := template, key
+	if ca == nil {
+		template.IsCA = true
+		template.BasicConstraintsValid = true
+		template.KeyUsage |= x509.KeyUsageCertSign
+		template.ExtKeyUsage = nil
+	} else {
+		parent, signer = ca.cert, ca.key
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, parent, &key.PublicKey, signer)
+	Expect(err).To(BeNil())
+	cert, err := x509.ParseCertificate(der)
+	Expect(err).To(BeNil())
+
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	Expect(err).To(BeNil())
+	write(filepath.Join(dir, name+".crt"), pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}))
+	write(filepath.Join(dir, name+".key"), pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}))
+	return &issuer{cert: cert, key: key}
+}
+
+// write replaces a file and moves its modification time forward, so the
+// change is seen even within the resolution of the file system clock
+func write(path string, data []byte) {
+	Expect(ioutil.WriteFile(path, data, 0600)).To(Succeed())
+	modTime := time.Now().Add(time.Duration(serial) * time.Second)
+	Expect(os.Chtimes(path, modTime, modTime)).To(Succeed())
+}
+
+func handshake(server, client *tls.Config) (*x509.Certificate, error) {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	Expect(err).To(BeNil())
+	defer lis.Close()
+
+	done := make(chan error, 1)
+	go func() {
+		conn, err := lis.Accept()
+		if err != nil {
+			done <- err
+			return
+		}
+		defer conn.Close()
+		done <- tls.Server(conn, server).Handshake()
+	}()
+
+	conn, err := tls.Dial("tcp", lis.Addr().String(), client)
+	if err != nil {
+		<-done
+		return nil, err
+	}
+	defer conn.Close()
+	// tls 1.3 clients finish their handshake before the server verified
+	// the client certificate
+	if err := <-done; err != nil {
+		return nil, err
+	}
+	return conn.ConnectionState().PeerCertificates[0], nil
+}
+
+var _ = Describe("Credentials", func() {
+	var dir string
+	var ca *issuer
+	var interval time.Duration
+
+	BeforeEach(func() {
+		var err error
+		dir, err = ioutil.TempDir("", "tlsutil")
+		Expect(err).To(BeNil())
+		ca = issue(dir, "ca", nil, 0)
+		issue(dir, "server", ca, x509.ExtKeyUsageServerAuth, "lvmd.local")
+		issue(dir, "client", ca, x509.ExtKeyUsageClientAuth)
+		interval = ReloadInterval
+		ReloadInterval = 0
+	})
+
+	AfterEach(func() {
+		ReloadInterval = interval
+		os.RemoveAll(dir)
+	})
+
+	path := func(name string) string {
+		return filepath.Join(dir, name)
+	}
+
+	configs := func(clientCert bool, serverName string) (*tls.Config, *tls.Config) {
+		serverCreds, err := Load(path("server.crt"), path("server.key"), path("ca.crt"))
+		Expect(err).To(BeNil())
+		serverConfig, err := serverCreds.ServerConfig()
+		Expect(err).To(BeNil())
+
+		clientCreds, err := Load("", "", path("ca.crt"))
+		if clientCert {
+			clientCreds, err = Load(path("client.crt"), path("client.key"), path("ca.crt"))
+		}
+		Expect(err).To(BeNil())
+		return serverConfig, clientCreds.ClientConfig(serverName)
+	}
+
+	It("should verify both sides of the connection", func() {
+		server, client := configs(true, "lvmd.local")
+		cert, err := handshake(server, client)
+		Expect(err).To(BeNil())
+		Expect(cert.Subject.CommonName).To(Equal("server"))
+	})
+
+	It("should reject clients without a certificate", func() {
+		server, client := configs(false, "lvmd.local")
+		_, err := handshake(server, client)
+		Expect(err).NotTo(BeNil())
+	})
+
+	It("should reject a server with another name", func() {
+		server, client := configs(true, "other.local")
+		_, err := handshake(server, client)
+		Expect(err).NotTo(BeNil())
+	})
+
+	It("should reject certificates of another CA", func() {
+		server, client := configs(true, "lvmd.local")
+		other := issue(dir, "other-ca", nil, 0)
+		issue(dir, "client", other, x509.ExtKeyUsageClientAuth)
+		_, err := handshake(server, client)
+		Expect(err).NotTo(BeNil())
+	})
+
+	It("should pick up rotated certificates", func() {
+		server, client := configs(true, "lvmd.local")
+		cert, err := handshake(server, client)
+		Expect(err).To(BeNil())
+
+		rotated := issue(dir, "server", ca, x509.ExtKeyUsageServerAuth, "lvmd.local")
+		renewed, err := handshake(server, client)
+		Expect(err).To(BeNil())
+		Expect(renewed.SerialNumber).NotTo(Equal(cert.SerialNumber))
+		Expect(renewed.SerialNumber).To(Equal(rotated.cert.SerialNumber))
+	})
+
+	It("should keep the previous certificate when the new one is broken", func() {
+		server, client := configs(true, "lvmd.local")
+		cert, err := handshake(server, client)
+		Expect(err).To(BeNil())
+
+		write(path("server.crt"), []byte("broken"))
+		same, err := handshake(server, client)
+		Expect(err).To(BeNil())
+		Expect(same.SerialNumber).To(Equal(cert.SerialNumber))
+	})
+
+	It("should require a certificate for servers and complete key pairs", func() {
+		creds, err := Load("", "", path("ca.crt"))
+		Expect(err).To(BeNil())
+		_, err = creds.ServerConfig()
+		Expect(err).NotTo(BeNil())
+
+		_, err = Load(path("server.crt"), "", "")
+		Expect(err).NotTo(BeNil())
+	})
+})
@@ -0,0 +1,25 @@
+package unixsock
+
+import (
+	"net"
+	"syscall"
+)
+
+func peerCred(conn *net.UnixConn) (AuthInfo, error) {
+	raw, err := conn.SyscallConn()
+	if err != nil {
+		return AuthInfo{}, err
+	}
+	var cred *syscall.Ucred
+	var credErr error
+	err = raw.Control(func(fd uintptr) {
+		cred, credErr = syscall.GetsockoptUcred(int(fd), syscall.SOL_SOCKET, syscall.SO_PEERCRED)
+	})
+	if err != nil {
+		return AuthInfo{}, err
+	}
+	if credErr != nil {
+		return AuthInfo{}, credErr
+	}
+	return AuthInfo{PID: cred.Pid, UID: cred.Uid, GID: cred.Gid}, nil
+}
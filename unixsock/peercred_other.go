@@ -0,0 +1,13 @@
+//go:build !linux
+// +build !linux
+
+package unixsock
+
+import (
+	"errors"
+	"net"
+)
+
+func peerCred(conn *net.UnixConn) (AuthInfo, error) {
+	return AuthInfo{}, errors.New("peer credentials are only supported on linux")
+}
@@ -0,0 +1,187 @@
+// Package unixsock serves lvmd on a unix domain socket for clients on the
+// same node, callers are identified by the credentials of their process
+// which the kernel attaches to the connection.
+package unixsock
+
+import (
+	"fmt"
+	"net"
+	"os"
+	"os/user"
+	"strconv"
+	"strings"
+
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/credentials"
+)
+
+// Scheme prefixes the listen and dial addresses of unix sockets
+const Scheme = "unix://"
+
+// Path returns the socket path of addr and whether addr is a unix socket
+// address like unix:///run/lvmd.sock
+func Path(addr string) (string, bool) {
+	if !strings.HasPrefix(addr, Scheme) {
+		return "", false
+	}
+	return strings.TrimPrefix(addr, Scheme), true
+}
+
+// Listen creates the socket at path with the permission bits of mode and
+// owned by owner, which is a user and an optional group like "root:disk",
+// an empty owner keeps the owner of the daemon. A socket left behind by a
+// previous run is replaced
+func Listen(path string, mode os.FileMode, owner string) (net.Listener, error) {
+	uid, gid := -1, -1
+	if owner != "" {
+		var err error
+		if uid, gid, err = lookupOwner(owner); err != nil {
+			return nil, err
+		}
+	}
+
+	if info, err := os.Lstat(path); err == nil {
+		if info.Mode()&os.ModeSocket == 0 {
+			return nil, fmt.Errorf("%s exists and isn't a socket", path)
+		}
+		if err := os.Remove(path); err != nil {
+			return nil, err
+		}
+	}
+	lis, err := net.Listen("unix", path)
+	if err != nil {
+		return nil, err
+	}
+	if err := os.Chmod(path, mode); err != nil {
+		lis.Close()
+		return nil, err
+	}
+	if err := os.Chown(path, uid, gid); err != nil {
+		lis.Close()
+		return nil, err
+	}
+	return lis, nil
+}
+
+func lookupOwner(owner string) (int, int, error) {
+	names := strings.SplitN(owner, ":", 2)
+	uid, err := lookupID(names[0], func(name string) (string, error) {
+		u, err := user.Lookup(name)
+		if err != nil {
+			return "", err
+		}
+		return u.Uid, nil
+	})
+	if err != nil {
+		return -1, -1, err
+	}
+	gid := -1
+	if len(names) == 2 && names[1] != "" {
+		gid, err = lookupID(names[1], func(name string) (string, error) {
+			g, err := user.LookupGroup(name)
+			if err != nil {
+				return "", err
+			}
+			return g.Gid, nil
+		})
+	}
+	return uid, gid, err
+}
+
+// lookupID accepts numeric ids as they are, so an owner without an entry
+// in the passwd or group database of the container can be used
+func lookupID(name string, lookup func(string) (string, error)) (int, error) {
+	if id, err := strconv.Atoi(name); err == nil {
+		return id, nil
+	}
+	id, err := lookup(name)
+	if err != nil {
+		return -1, err
+	}
+	return strconv.Atoi(id)
+}
+
+// AuthInfo holds the credentials of the process on the other end of a unix
+// socket connection, taken when the connection was accepted
+type AuthInfo struct {
+	credentials.CommonAuthInfo
+	PID int32
+	UID uint32
+	GID uint32
+}
+
+// AuthType implements credentials.AuthInfo
+func (AuthInfo) AuthType() string {
+	return "peercred"
+}
+
+type peerCredentials struct {
+	allowed map[uint32]bool
+}
+
+// NewCredentials returns the transport credentials of a server listening
+// on a unix socket, they reject connections of processes whose uid isn't
+// in allowedUIDs, every uid is accepted when allowedUIDs is empty. The
+// AuthInfo of the accepted connections is available from the peer of the
+// requests
+func NewCredentials(allowedUIDs []uint32) credentials.TransportCredentials {
+	c := &peerCredentials{allowed: make(map[uint32]bool)}
+	for _, uid := range allowedUIDs {
+		c.allowed[uid] = true
+	}
+	return c
+}
+
+func (c *peerCredentials) ServerHandshake(conn net.Conn) (net.Conn, credentials.AuthInfo, error) {
+	uc, ok := conn.(*net.UnixConn)
+	if !ok {
+		return nil, nil, fmt.Errorf("peer credentials require a unix socket, got %s", conn.LocalAddr().Network())
+	}
+	info, err := peerCred(uc)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get peer credentials: %v", err)
+	}
+	if len(c.allowed) != 0 && !c.allowed[info.UID] {
+		return nil, nil, fmt.Errorf("uid %d of pid %d isn't allowed", info.UID, info.PID)
+	}
+	info.SecurityLevel = credentials.NoSecurity
+	return conn, info, nil
+}
+
+// ClientHandshake doesn't authenticate the server, clients trust it by the
+// permissions of the socket
+func (c *peerCredentials) ClientHandshake(ctx context.Context, authority string, conn net.Conn) (net.Conn, credentials.AuthInfo, error) {
+	return conn, AuthInfo{}, nil
+}
+
+func (c *peerCredentials) Info() credentials.ProtocolInfo {
+	return credentials.ProtocolInfo{SecurityProtocol: "peercred"}
+}
+
+func (c *peerCredentials) Clone() credentials.TransportCredentials {
+	allowed := make(map[uint32]bool, len(c.allowed))
+	for uid := range c.allowed {
+		allowed[uid] = true
+	}
+	return &peerCredentials{allowed: allowed}
+}
+
+func (c *peerCredentials) OverrideServerName(string) error {
+	return nil
+}
+
+// ParseUIDs parses a comma separated list of uids
+func ParseUIDs(list string) ([]uint32, error) {
+	var uids []uint32
+	for _, s := range strings.Split(list, ",") {
+		if s = strings.TrimSpace(s); s == "" {
+			continue
+		}
+		uid, err := strconv.ParseUint(s, 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("invalid uid %q", s)
+		}
+		uids = append(uids, uint32(uid))
+	}
+	return uids, nil
+}
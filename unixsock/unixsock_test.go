@@ -0,0 +1,123 @@
+package unixsock_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/peer"
+
+	"github.com/zdnscloud/lvmd/client"
+	"github.com/zdnscloud/lvmd/commands"
+	"github.com/zdnscloud/lvmd/commands/fake"
+	pb "github.com/zdnscloud/lvmd/proto"
+	"github.com/zdnscloud/lvmd/server"
+	"github.com/zdnscloud/lvmd/unixsock"
+)
+
+func TestUnixSock(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "UnixSock Suite")
+}
+
+var _ = Describe("unix socket", func() {
+	var dir, path string
+
+	BeforeEach(func() {
+		var err error
+		dir, err = ioutil.TempDir("", "unixsock")
+		Expect(err).To(BeNil())
+		path = filepath.Join(dir, "lvmd.sock")
+		commands.SetExecutor(fake.NewLVM())
+	})
+
+	AfterEach(func() {
+		os.RemoveAll(dir)
+	})
+
+	// serve runs lvmd on the socket and records the peer of every request
+	serve := func(allowed []uint32) (*grpc.Server, <-chan *peer.Peer) {
+		lis, err := unixsock.Listen(path, 0600, "")
+		Expect(err).To(BeNil())
+		peers := make(chan *peer.Peer, 1)
+		record := func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+			p, _ := peer.FromContext(ctx)
+			peers <- p
+			return handler(ctx, req)
+		}
+		grpcServer := grpc.NewServer(grpc.Creds(unixsock.NewCredentials(allowed)), grpc.UnaryInterceptor(record))
+		svr := server.NewServer()
+		pb.RegisterLVMServer(grpcServer, &svr)
+		go grpcServer.Serve(lis)
+		return grpcServer, peers
+	}
+
+	It("should create the socket with the given mode and replace stale sockets", func() {
+		lis, err := unixsock.Listen(path, 0600, "")
+		Expect(err).To(BeNil())
+		info, err := os.Stat(path)
+		Expect(err).To(BeNil())
+		Expect(info.Mode().Perm()).To(Equal(os.FileMode(0600)))
+
+		// a crashed daemon leaves its socket behind
+		lis.(interface{ SetUnlinkOnClose(bool) }).SetUnlinkOnClose(false)
+		lis.Close()
+		lis, err = unixsock.Listen(path, 0660, "")
+		Expect(err).To(BeNil())
+		defer lis.Close()
+		info, err = os.Stat(path)
+		Expect(err).To(BeNil())
+		Expect(info.Mode().Perm()).To(Equal(os.FileMode(0660)))
+	})
+
+	It("should refuse to replace a file which isn't a socket", func() {
+		Expect(ioutil.WriteFile(path, nil, 0600)).To(Succeed())
+		_, err := unixsock.Listen(path, 0600, "")
+		Expect(err).NotTo(BeNil())
+	})
+
+	It("should identify the calling process", func() {
+		grpcServer, peers := serve(nil)
+		defer grpcServer.Stop()
+
+		cli, err := client.New(unixsock.Scheme+path, time.Second)
+		Expect(err).To(BeNil())
+		defer cli.Close()
+		_, err = cli.ListVG(context.Background(), &pb.ListVGRequest{})
+		Expect(err).To(BeNil())
+
+		var p *peer.Peer
+		Eventually(peers).Should(Receive(&p))
+		info, ok := p.AuthInfo.(unixsock.AuthInfo)
+		Expect(ok).To(BeTrue())
+		Expect(info.UID).To(Equal(uint32(os.Getuid())))
+		Expect(info.PID).To(Equal(int32(os.Getpid())))
+	})
+
+	It("should reject processes of other users", func() {
+		grpcServer, _ := serve([]uint32{uint32(os.Getuid()) + 1})
+		defer grpcServer.Stop()
+
+		cli, err := client.New(unixsock.Scheme+path, time.Second)
+		Expect(err).To(BeNil())
+		defer cli.Close()
+		ctx, cancel := context.WithTimeout(context.Background(), 200*time.Millisecond)
+		defer cancel()
+		_, err = cli.ListVG(ctx, &pb.ListVGRequest{})
+		Expect(err).NotTo(BeNil())
+	})
+
+	It("should parse uid lists", func() {
+		uids, err := unixsock.ParseUIDs("0, 1000,")
+		Expect(err).To(BeNil())
+		Expect(uids).To(Equal([]uint32{0, 1000}))
+		_, err = unixsock.ParseUIDs("root")
+		Expect(err).NotTo(BeNil())
+	})
+})